@@ -7,6 +7,7 @@ import (
 	"code.cloudfoundry.org/lager/v3"
 	"code.cloudfoundry.org/routing-api/db"
 	"code.cloudfoundry.org/routing-api/handlers"
+	"code.cloudfoundry.org/routing-api/models"
 	"github.com/tedsuo/ifrit"
 	"github.com/tedsuo/ifrit/http_server"
 	"github.com/tedsuo/rata"
@@ -17,6 +18,7 @@ const (
 	UnlockRouterGroupReadsRoute  = "UnlockRouterGroupReads"
 	LockRouterGroupWritesRoute   = "LockRouterGroupWrites"
 	UnlockRouterGroupWritesRoute = "UnlockRouterGroupWrites"
+	TcpRoutePortReportRoute      = "TcpRoutePortReport"
 )
 
 var AdminRoutesMap = map[string]rata.Route{
@@ -24,6 +26,7 @@ var AdminRoutesMap = map[string]rata.Route{
 	UnlockRouterGroupReadsRoute:  {Path: "/unlock_router_group_reads", Method: "PUT", Name: UnlockRouterGroupReadsRoute},
 	LockRouterGroupWritesRoute:   {Path: "/lock_router_group_writes", Method: "PUT", Name: LockRouterGroupWritesRoute},
 	UnlockRouterGroupWritesRoute: {Path: "/unlock_router_group_writes", Method: "PUT", Name: UnlockRouterGroupWritesRoute},
+	TcpRoutePortReportRoute:      {Path: "/tcp_route_port_violations", Method: "GET", Name: TcpRoutePortReportRoute},
 }
 
 func AdminRoutes() rata.Routes {
@@ -34,13 +37,15 @@ func AdminRoutes() rata.Routes {
 
 	return routes
 }
-func NewServer(port uint16, db db.DB, logger lager.Logger) (ifrit.Runner, error) {
+func NewServer(port uint16, db db.DB, portPolicy models.PortPolicy, logger lager.Logger) (ifrit.Runner, error) {
 	rglHandler := NewRouterGroupLockHandler(db, logger)
+	reportHandler := NewTcpRoutePortReportHandler(db, portPolicy, logger)
 	actions := rata.Handlers{
 		LockRouterGroupReadsRoute:    http.HandlerFunc(rglHandler.LockReads),
 		UnlockRouterGroupReadsRoute:  http.HandlerFunc(rglHandler.UnlockReads),
 		LockRouterGroupWritesRoute:   http.HandlerFunc(rglHandler.LockWrites),
 		UnlockRouterGroupWritesRoute: http.HandlerFunc(rglHandler.UnlockWrites),
+		TcpRoutePortReportRoute:      http.HandlerFunc(reportHandler.Report),
 	}
 	handler, err := rata.NewRouter(AdminRoutes(), actions)
 	if err != nil {
@@ -8,6 +8,7 @@ import (
 	"code.cloudfoundry.org/lager/v3/lagertest"
 	"code.cloudfoundry.org/routing-api/admin"
 	fake_db "code.cloudfoundry.org/routing-api/db/fakes"
+	"code.cloudfoundry.org/routing-api/models"
 	"code.cloudfoundry.org/routing-api/test_helpers"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -26,7 +27,7 @@ var _ = Describe("AdminServer", func() {
 		db = new(fake_db.FakeDB)
 		logger = lagertest.NewTestLogger("routing-api-test")
 		port = test_helpers.NextAvailPort()
-		server, err := admin.NewServer(port, db, logger)
+		server, err := admin.NewServer(port, db, models.PortPolicy{}, logger)
 		Expect(err).ToNot(HaveOccurred())
 		process = ifrit.Invoke(sigmon.New(server))
 		Eventually(process.Ready(), "5s").Should(BeClosed())
@@ -0,0 +1,88 @@
+package admin
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"code.cloudfoundry.org/lager/v3"
+	"code.cloudfoundry.org/routing-api/db"
+	"code.cloudfoundry.org/routing-api/models"
+)
+
+// TcpRoutePortViolation is a tcp route mapping whose external port is not
+// forwarded to its router group, along with the reason why.
+type TcpRoutePortViolation struct {
+	TcpRouteMapping models.TcpRouteMapping `json:"tcp_route"`
+	Reason          string                 `json:"reason"`
+}
+
+type TcpRoutePortReportHandler struct {
+	db         db.DB
+	portPolicy models.PortPolicy
+	logger     lager.Logger
+}
+
+func NewTcpRoutePortReportHandler(database db.DB, portPolicy models.PortPolicy, logger lager.Logger) *TcpRoutePortReportHandler {
+	return &TcpRoutePortReportHandler{
+		db:         database,
+		portPolicy: portPolicy,
+		logger:     logger,
+	}
+}
+
+func (h *TcpRoutePortReportHandler) Report(w http.ResponseWriter, req *http.Request) {
+	log := h.logger.Session("tcp-route-port-report")
+
+	routerGroups, err := h.db.ReadRouterGroups()
+	if err != nil {
+		log.Error("failed-to-read-router-groups", err)
+		w.WriteHeader(http.StatusServiceUnavailable)
+		return
+	}
+
+	tcpRouteMappings, err := h.db.ReadTcpRouteMappings()
+	if err != nil {
+		log.Error("failed-to-read-tcp-route-mappings", err)
+		w.WriteHeader(http.StatusServiceUnavailable)
+		return
+	}
+
+	violations := tcpRoutePortViolations(tcpRouteMappings, routerGroups, h.portPolicy)
+	log.Info("found-violations", lager.Data{"count": len(violations)})
+
+	w.Header().Set("Content-Type", "application/json")
+	err = json.NewEncoder(w).Encode(violations)
+	if err != nil {
+		log.Error("failed-to-write-response", err)
+	}
+}
+
+// tcpRoutePortViolations returns the mappings whose router group does not exist
+// or does not accept all of their external ports.
+func tcpRoutePortViolations(tcpRouteMappings []models.TcpRouteMapping, routerGroups models.RouterGroups, portPolicy models.PortPolicy) []TcpRoutePortViolation {
+	routerGroupsByGuid := make(map[string]models.RouterGroup, len(routerGroups))
+	for _, routerGroup := range routerGroups {
+		routerGroupsByGuid[routerGroup.Guid] = routerGroup
+	}
+
+	violations := []TcpRoutePortViolation{}
+	for _, tcpRouteMapping := range tcpRouteMappings {
+		routerGroup, ok := routerGroupsByGuid[tcpRouteMapping.RouterGroupGuid]
+		if !ok {
+			violations = append(violations, TcpRoutePortViolation{
+				TcpRouteMapping: tcpRouteMapping,
+				Reason:          fmt.Sprintf("router group %s does not exist", tcpRouteMapping.RouterGroupGuid),
+			})
+			continue
+		}
+
+		if err := routerGroup.ValidateExternalPortRange(tcpRouteMapping.ExternalPortRange(), portPolicy); err != nil {
+			violations = append(violations, TcpRoutePortViolation{
+				TcpRouteMapping: tcpRouteMapping,
+				Reason:          err.Error(),
+			})
+		}
+	}
+	return violations
+}
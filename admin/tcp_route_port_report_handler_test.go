@@ -0,0 +1,97 @@
+package admin_test
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+
+	"code.cloudfoundry.org/lager/v3/lagertest"
+	"code.cloudfoundry.org/routing-api/admin"
+	fake_db "code.cloudfoundry.org/routing-api/db/fakes"
+	"code.cloudfoundry.org/routing-api/handlers"
+	"code.cloudfoundry.org/routing-api/models"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("TcpRoutePortReportHandler", func() {
+	var (
+		reportHandler    *admin.TcpRoutePortReportHandler
+		responseRecorder *httptest.ResponseRecorder
+		database         *fake_db.FakeDB
+		logger           *lagertest.TestLogger
+	)
+
+	BeforeEach(func() {
+		database = &fake_db.FakeDB{}
+		logger = lagertest.NewTestLogger("routing-api-test")
+		reportHandler = admin.NewTcpRoutePortReportHandler(database, models.PortPolicy{}, logger)
+		responseRecorder = httptest.NewRecorder()
+
+		database.ReadRouterGroupsReturns(models.RouterGroups{
+			{Guid: "rg-guid", Name: "default-tcp", Type: "tcp", ReservablePorts: "1024-1032"},
+		}, nil)
+		database.ReadTcpRouteMappingsReturns([]models.TcpRouteMapping{
+			models.NewTcpRouteMapping("rg-guid", 1025, "10.0.0.1", 8080, 0, "", nil, nil, 60, models.ModificationTag{}, false, ""),
+			models.NewTcpRouteMapping("rg-guid", 2048, "10.0.0.2", 8080, 0, "", nil, nil, 60, models.ModificationTag{}, false, ""),
+			models.NewTcpRouteMapping("missing-guid", 1025, "10.0.0.3", 8080, 0, "", nil, nil, 60, models.ModificationTag{}, false, ""),
+		}, nil)
+	})
+
+	It("responds with the mappings whose external port is not forwarded to their router group", func() {
+		reportHandler.Report(responseRecorder, handlers.NewTestRequest(""))
+		Expect(responseRecorder.Code).To(Equal(http.StatusOK))
+
+		var violations []admin.TcpRoutePortViolation
+		Expect(json.Unmarshal(responseRecorder.Body.Bytes(), &violations)).To(Succeed())
+		Expect(violations).To(HaveLen(2))
+		Expect(violations[0].TcpRouteMapping.HostIP).To(Equal("10.0.0.2"))
+		Expect(violations[0].Reason).To(ContainSubstring("not within the reservable ports"))
+		Expect(violations[1].TcpRouteMapping.HostIP).To(Equal("10.0.0.3"))
+		Expect(violations[1].Reason).To(Equal("router group missing-guid does not exist"))
+	})
+
+	Context("when a port range mapping is partially outside the reservable ports", func() {
+		BeforeEach(func() {
+			rangeMapping := models.NewTcpRouteMapping("rg-guid", 1030, "10.0.0.4", 8080, 0, "", nil, nil, 60, models.ModificationTag{}, false, "")
+			rangeMapping.ExternalPortEnd = 1034
+			database.ReadTcpRouteMappingsReturns([]models.TcpRouteMapping{rangeMapping}, nil)
+		})
+
+		It("responds with the mapping and its first port that is not forwarded", func() {
+			reportHandler.Report(responseRecorder, handlers.NewTestRequest(""))
+			Expect(responseRecorder.Code).To(Equal(http.StatusOK))
+
+			var violations []admin.TcpRoutePortViolation
+			Expect(json.Unmarshal(responseRecorder.Body.Bytes(), &violations)).To(Succeed())
+			Expect(violations).To(HaveLen(1))
+			Expect(violations[0].TcpRouteMapping.ExternalPortEnd).To(Equal(uint16(1034)))
+			Expect(violations[0].Reason).To(ContainSubstring("external port 1033 is not within the reservable ports"))
+		})
+	})
+
+	Context("when there are no violations", func() {
+		BeforeEach(func() {
+			database.ReadTcpRouteMappingsReturns(nil, nil)
+		})
+
+		It("responds with an empty list", func() {
+			reportHandler.Report(responseRecorder, handlers.NewTestRequest(""))
+			Expect(responseRecorder.Code).To(Equal(http.StatusOK))
+			Expect(responseRecorder.Body.String()).To(MatchJSON("[]"))
+		})
+	})
+
+	Context("when the db fails", func() {
+		BeforeEach(func() {
+			database.ReadTcpRouteMappingsReturns(nil, errors.New("db communication failed"))
+		})
+
+		It("responds with a 503", func() {
+			reportHandler.Report(responseRecorder, handlers.NewTestRequest(""))
+			Expect(responseRecorder.Code).To(Equal(http.StatusServiceUnavailable))
+		})
+	})
+})
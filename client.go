@@ -32,20 +32,43 @@ type Client interface {
 	Routes() ([]models.Route, error)
 	DeleteRoutes([]models.Route) error
 	RouterGroups() ([]models.RouterGroup, error)
+	RouterGroup(guid string) (models.RouterGroup, error)
 	RouterGroupWithName(string) (models.RouterGroup, error)
 	UpdateRouterGroup(models.RouterGroup) error
 	CreateRouterGroup(models.RouterGroup) error
 	DeleteRouterGroup(models.RouterGroup) error
+	RouterGroupPorts(guid string) (models.PortOccupancy, error)
 	ReservePort(string, string) (int, error)
+	CreatePortReservation(models.PortReservation) (models.PortReservation, error)
+	PortReservations() ([]models.PortReservation, error)
+	PortReservation(guid string) (models.PortReservation, error)
+	ReleasePortReservation(guid string) error
+	CreateTlsCertificate(models.TlsCertificate) (models.TlsCertificate, error)
+	UpdateTlsCertificate(models.TlsCertificate) (models.TlsCertificate, error)
+	TlsCertificates() ([]models.TlsCertificate, error)
+	TlsCertificate(guid string) (models.TlsCertificate, error)
+	DeleteTlsCertificate(guid string) error
+	CreateBackendTlsProfile(models.BackendTlsProfile) (models.BackendTlsProfile, error)
+	UpdateBackendTlsProfile(models.BackendTlsProfile) (models.BackendTlsProfile, error)
+	BackendTlsProfiles() ([]models.BackendTlsProfile, error)
+	BackendTlsProfile(guid string) (models.BackendTlsProfile, error)
+	DeleteBackendTlsProfile(guid string) error
 	UpsertTcpRouteMappings([]models.TcpRouteMapping) error
+	CreateTcpRouteMappings([]models.TcpRouteMapping) ([]models.TcpRouteMapping, error)
 	DeleteTcpRouteMappings([]models.TcpRouteMapping) error
 	TcpRouteMappings() ([]models.TcpRouteMapping, error)
 	FilteredTcpRouteMappings([]string) ([]models.TcpRouteMapping, error)
+	UpsertUdpRouteMappings([]models.UdpRouteMapping) error
+	DeleteUdpRouteMappings([]models.UdpRouteMapping) error
+	UdpRouteMappings() ([]models.UdpRouteMapping, error)
+	FilteredUdpRouteMappings([]string) ([]models.UdpRouteMapping, error)
 
 	SubscribeToEvents() (EventSource, error)
 	SubscribeToEventsWithMaxRetries(retries uint16) (EventSource, error)
 	SubscribeToTcpEvents() (TcpEventSource, error)
 	SubscribeToTcpEventsWithMaxRetries(retries uint16) (TcpEventSource, error)
+	SubscribeToUdpEvents() (UdpEventSource, error)
+	SubscribeToUdpEventsWithMaxRetries(retries uint16) (UdpEventSource, error)
 }
 
 func NewClient(url string, skipTLSVerification bool) Client {
@@ -119,15 +142,37 @@ func (c *client) RouterGroups() ([]models.RouterGroup, error) {
 	return routerGroups, err
 }
 
+func (c *client) RouterGroupPorts(guid string) (models.PortOccupancy, error) {
+	var occupancy models.PortOccupancy
+	err := c.doRequest(RouterGroupPorts, rata.Params{"guid": guid}, nil, nil, &occupancy)
+	return occupancy, err
+}
+
+// RouterGroup returns the router group with the guid, or an Error of type
+// ResourceNotFoundError if there is none.
+func (c *client) RouterGroup(guid string) (models.RouterGroup, error) {
+	var routerGroup models.RouterGroup
+	err := c.doRequest(GetRouterGroup, rata.Params{"guid": guid}, nil, nil, &routerGroup)
+	return routerGroup, err
+}
+
+// RouterGroupWithName returns the router group with the name, or an Error of
+// type ResourceNotFoundError if there is none.
 func (c *client) RouterGroupWithName(name string) (models.RouterGroup, error) {
 	var routerGroups []models.RouterGroup
 	err := c.doRequest(ListRouterGroups, nil, url.Values{"name": []string{name}}, nil, &routerGroups)
 	if err != nil {
 		return models.RouterGroup{}, err
 	}
-	return routerGroups[0], err
+	if len(routerGroups) == 0 {
+		return models.RouterGroup{}, NewError(ResourceNotFoundError, fmt.Sprintf("router group '%s' not found", name))
+	}
+	return routerGroups[0], nil
 }
 
+// ReservePort creates or updates a router group with a single free port picked
+// on the client. Prefer CreatePortReservation, which allocates the port on the
+// server and allows it to be released again.
 func (c *client) ReservePort(groupName string, portRange string) (int, error) {
 	reservablePorts := models.ReservablePorts(portRange)
 	ranges, err := reservablePorts.Parse()
@@ -206,6 +251,88 @@ func getNextAvailablePort(groups models.RouterGroups, portRange models.Range) (m
 	return "", Error{Type: PortRangeExhaustedError, Message: fmt.Sprintf("There are no free ports in range: %s", portRange)}
 }
 
+func (c *client) CreatePortReservation(reservation models.PortReservation) (models.PortReservation, error) {
+	var created models.PortReservation
+	err := c.doRequest(CreatePortReservation, nil, nil, reservation, &created)
+	return created, err
+}
+
+func (c *client) PortReservations() ([]models.PortReservation, error) {
+	var reservations []models.PortReservation
+	err := c.doRequest(ListPortReservations, nil, nil, nil, &reservations)
+	return reservations, err
+}
+
+func (c *client) PortReservation(guid string) (models.PortReservation, error) {
+	var reservation models.PortReservation
+	err := c.doRequest(GetPortReservation, rata.Params{"guid": guid}, nil, nil, &reservation)
+	return reservation, err
+}
+
+func (c *client) ReleasePortReservation(guid string) error {
+	return c.doRequest(DeletePortReservation, rata.Params{"guid": guid}, nil, nil, nil)
+}
+
+func (c *client) CreateTlsCertificate(certificate models.TlsCertificate) (models.TlsCertificate, error) {
+	var created models.TlsCertificate
+	err := c.doRequest(CreateTlsCertificate, nil, nil, certificate, &created)
+	return created, err
+}
+
+// UpdateTlsCertificate rotates the certificate chain and private key of the
+// tls certificate with the guid of the certificate.
+func (c *client) UpdateTlsCertificate(certificate models.TlsCertificate) (models.TlsCertificate, error) {
+	var updated models.TlsCertificate
+	err := c.doRequest(UpdateTlsCertificate, rata.Params{"guid": certificate.Guid}, nil, certificate, &updated)
+	return updated, err
+}
+
+func (c *client) TlsCertificates() ([]models.TlsCertificate, error) {
+	var certificates []models.TlsCertificate
+	err := c.doRequest(ListTlsCertificates, nil, nil, nil, &certificates)
+	return certificates, err
+}
+
+func (c *client) TlsCertificate(guid string) (models.TlsCertificate, error) {
+	var certificate models.TlsCertificate
+	err := c.doRequest(GetTlsCertificate, rata.Params{"guid": guid}, nil, nil, &certificate)
+	return certificate, err
+}
+
+func (c *client) DeleteTlsCertificate(guid string) error {
+	return c.doRequest(DeleteTlsCertificate, rata.Params{"guid": guid}, nil, nil, nil)
+}
+
+func (c *client) CreateBackendTlsProfile(profile models.BackendTlsProfile) (models.BackendTlsProfile, error) {
+	var created models.BackendTlsProfile
+	err := c.doRequest(CreateBackendTlsProfile, nil, nil, profile, &created)
+	return created, err
+}
+
+// UpdateBackendTlsProfile replaces the backend tls profile with the guid of
+// the profile.
+func (c *client) UpdateBackendTlsProfile(profile models.BackendTlsProfile) (models.BackendTlsProfile, error) {
+	var updated models.BackendTlsProfile
+	err := c.doRequest(UpdateBackendTlsProfile, rata.Params{"guid": profile.Guid}, nil, profile, &updated)
+	return updated, err
+}
+
+func (c *client) BackendTlsProfiles() ([]models.BackendTlsProfile, error) {
+	var profiles []models.BackendTlsProfile
+	err := c.doRequest(ListBackendTlsProfiles, nil, nil, nil, &profiles)
+	return profiles, err
+}
+
+func (c *client) BackendTlsProfile(guid string) (models.BackendTlsProfile, error) {
+	var profile models.BackendTlsProfile
+	err := c.doRequest(GetBackendTlsProfile, rata.Params{"guid": guid}, nil, nil, &profile)
+	return profile, err
+}
+
+func (c *client) DeleteBackendTlsProfile(guid string) error {
+	return c.doRequest(DeleteBackendTlsProfile, rata.Params{"guid": guid}, nil, nil, nil)
+}
+
 func (c *client) DeleteRoutes(routes []models.Route) error {
 	return c.doRequest(DeleteRoute, nil, nil, routes, nil)
 }
@@ -214,6 +341,22 @@ func (c *client) UpsertTcpRouteMappings(tcpRouteMappings []models.TcpRouteMappin
 	return c.doRequest(UpsertTcpRouteMapping, nil, nil, tcpRouteMappings, nil)
 }
 
+// CreateTcpRouteMappings upserts the mappings like UpsertTcpRouteMappings, and
+// returns them with the external ports that the server allocated for mappings
+// without a port.
+func (c *client) CreateTcpRouteMappings(tcpRouteMappings []models.TcpRouteMapping) ([]models.TcpRouteMapping, error) {
+	var created []models.TcpRouteMapping
+	err := c.doRequest(UpsertTcpRouteMapping, nil, nil, tcpRouteMappings, &created)
+	if err == io.EOF {
+		// servers that do not allocate ports respond without a body
+		return tcpRouteMappings, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return created, nil
+}
+
 func (c *client) TcpRouteMappings() ([]models.TcpRouteMapping, error) {
 	var tcpRouteMappings []models.TcpRouteMapping
 	err := c.doRequest(ListTcpRouteMapping, nil, nil, nil, &tcpRouteMappings)
@@ -230,6 +373,26 @@ func (c *client) DeleteTcpRouteMappings(tcpRouteMappings []models.TcpRouteMappin
 	return c.doRequest(DeleteTcpRouteMapping, nil, nil, tcpRouteMappings, nil)
 }
 
+func (c *client) UpsertUdpRouteMappings(udpRouteMappings []models.UdpRouteMapping) error {
+	return c.doRequest(UpsertUdpRouteMapping, nil, nil, udpRouteMappings, nil)
+}
+
+func (c *client) UdpRouteMappings() ([]models.UdpRouteMapping, error) {
+	var udpRouteMappings []models.UdpRouteMapping
+	err := c.doRequest(ListUdpRouteMapping, nil, nil, nil, &udpRouteMappings)
+	return udpRouteMappings, err
+}
+
+func (c *client) FilteredUdpRouteMappings(isolationSegments []string) ([]models.UdpRouteMapping, error) {
+	var udpRouteMappings []models.UdpRouteMapping
+	err := c.doRequest(ListUdpRouteMapping, nil, url.Values{"isolation_segment": isolationSegments}, nil, &udpRouteMappings)
+	return udpRouteMappings, err
+}
+
+func (c *client) DeleteUdpRouteMappings(udpRouteMappings []models.UdpRouteMapping) error {
+	return c.doRequest(DeleteUdpRouteMapping, nil, nil, udpRouteMappings, nil)
+}
+
 func (c *client) SubscribeToEvents() (EventSource, error) {
 	eventSource, err := c.doSubscribe(EventStreamRoute, defaultMaxRetries)
 	if err != nil {
@@ -262,6 +425,22 @@ func (c *client) SubscribeToTcpEventsWithMaxRetries(retries uint16) (TcpEventSou
 	return NewTcpEventSource(eventSource), nil
 }
 
+func (c *client) SubscribeToUdpEvents() (UdpEventSource, error) {
+	eventSource, err := c.doSubscribe(EventStreamUdpRoute, defaultMaxRetries)
+	if err != nil {
+		return nil, err
+	}
+	return NewUdpEventSource(eventSource), nil
+}
+
+func (c *client) SubscribeToUdpEventsWithMaxRetries(retries uint16) (UdpEventSource, error) {
+	eventSource, err := c.doSubscribe(EventStreamUdpRoute, retries)
+	if err != nil {
+		return nil, err
+	}
+	return NewUdpEventSource(eventSource), nil
+}
+
 func (c *client) doSubscribe(routeName string, retries uint16) (RawEventSource, error) {
 	config := sse.Config{
 		Client: c.streamingHTTPClient,
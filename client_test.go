@@ -33,6 +33,10 @@ var _ = Describe("Client", func() {
 		ROUTER_GROUPS_API_URL             = "/routing/v1/router_groups"
 		EVENTS_SSE_URL                    = "/routing/v1/events"
 		TCP_EVENTS_SSE_URL                = "/routing/v1/tcp_routes/events"
+		UDP_CREATE_ROUTE_MAPPINGS_API_URL = "/routing/v1/udp_routes/create"
+		UDP_DELETE_ROUTE_MAPPINGS_API_URL = "/routing/v1/udp_routes/delete"
+		UDP_ROUTES_API_URL                = "/routing/v1/udp_routes"
+		UDP_EVENTS_SSE_URL                = "/routing/v1/udp_routes/events"
 	)
 
 	var server *ghttp.Server
@@ -249,6 +253,72 @@ var _ = Describe("Client", func() {
 		})
 	})
 
+	Context("CreateTcpRouteMappings", func() {
+		var (
+			err              error
+			created          []models.TcpRouteMapping
+			tcpRouteMapping1 models.TcpRouteMapping
+			tcpRouteMapping2 models.TcpRouteMapping
+		)
+		BeforeEach(func() {
+			tcpRouteMapping1 = models.NewTcpRouteMapping("router-group-guid-001", 0, "1.2.3.4", 60000, 60002, "", nil, nil, 60, models.ModificationTag{}, false, "")
+			tcpRouteMapping2 = models.NewTcpRouteMapping("router-group-guid-001", 52001, "1.2.3.5", 60001, 60003, "", nil, nil, 60, models.ModificationTag{}, true, "alpn1,alpn2")
+		})
+
+		JustBeforeEach(func() {
+			created, err = client.CreateTcpRouteMappings([]models.TcpRouteMapping{tcpRouteMapping1, tcpRouteMapping2})
+		})
+
+		Context("when the server allocates external ports", func() {
+			BeforeEach(func() {
+				allocated := tcpRouteMapping1
+				allocated.ExternalPort = 61000
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("POST", TCP_CREATE_ROUTE_MAPPINGS_API_URL),
+						ghttp.RespondWithJSONEncoded(http.StatusCreated, []models.TcpRouteMapping{allocated, tcpRouteMapping2}),
+					),
+				)
+			})
+
+			It("returns the mappings with their allocated ports", func() {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(created).To(HaveLen(2))
+				Expect(created[0].ExternalPort).To(Equal(uint16(61000)))
+				Expect(created[1].ExternalPort).To(Equal(uint16(52001)))
+			})
+		})
+
+		Context("when the response has no body", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.VerifyRequest("POST", TCP_CREATE_ROUTE_MAPPINGS_API_URL),
+				)
+			})
+
+			It("returns the requested mappings", func() {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(created).To(Equal([]models.TcpRouteMapping{tcpRouteMapping1, tcpRouteMapping2}))
+			})
+		})
+
+		Context("when the server returns an error", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("POST", TCP_CREATE_ROUTE_MAPPINGS_API_URL),
+						ghttp.RespondWith(http.StatusBadRequest, nil),
+					),
+				)
+			})
+
+			It("receives an error", func() {
+				Expect(err).To(HaveOccurred())
+				Expect(created).To(BeNil())
+			})
+		})
+	})
+
 	Context("DeleteRoutes", func() {
 		var err error
 		JustBeforeEach(func() {
@@ -825,6 +895,70 @@ var _ = Describe("Client", func() {
 				Expect(log).NotTo(ContainSubstring(string(expectedBody)))
 			})
 		})
+
+		Context("when the server returns no router group", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", ROUTER_GROUPS_API_URL, "name=pineapple"),
+						ghttp.RespondWith(http.StatusOK, `[]`),
+					),
+				)
+			})
+
+			It("returns a resource not found error", func() {
+				routerGroup, err := client.RouterGroupWithName("pineapple")
+				Expect(err).To(Equal(routing_api.NewError(routing_api.ResourceNotFoundError, "router group 'pineapple' not found")))
+				Expect(routerGroup).To(Equal(models.RouterGroup{}))
+			})
+		})
+	})
+
+	Context("RouterGroup", func() {
+		Context("when the server returns a valid response", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", fmt.Sprintf("%s/%s", ROUTER_GROUPS_API_URL, DefaultRouterGroupGuid)),
+						ghttp.RespondWith(http.StatusOK, `{
+							"guid": "`+DefaultRouterGroupGuid+`",
+							"name": "`+DefaultRouterGroupName+`",
+							"type": "tcp",
+							"reservable_ports": "1024-65535"
+						}`),
+					),
+				)
+			})
+
+			It("gets the router group with the guid from the server", func() {
+				routerGroup, err := client.RouterGroup(DefaultRouterGroupGuid)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(server.ReceivedRequests()).Should(HaveLen(1))
+				Expect(routerGroup).To(Equal(models.RouterGroup{
+					Guid:            DefaultRouterGroupGuid,
+					Name:            DefaultRouterGroupName,
+					Type:            models.RouterGroup_TCP,
+					ReservablePorts: "1024-65535",
+				}))
+			})
+		})
+
+		Context("when the router group does not exist", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", fmt.Sprintf("%s/%s", ROUTER_GROUPS_API_URL, DefaultRouterGroupGuid)),
+						ghttp.RespondWith(http.StatusNotFound, `{"name": "ResourceNotFoundError", "message": "router group 'abc' does not exist"}`),
+					),
+				)
+			})
+
+			It("returns a resource not found error", func() {
+				routerGroup, err := client.RouterGroup(DefaultRouterGroupGuid)
+				Expect(err).To(Equal(routing_api.NewError(routing_api.ResourceNotFoundError, "router group 'abc' does not exist")))
+				Expect(routerGroup).To(Equal(models.RouterGroup{}))
+			})
+		})
 	})
 
 	Context("UpdateRouterGroup", func() {
@@ -927,6 +1061,52 @@ var _ = Describe("Client", func() {
 		})
 	})
 
+	Context("RouterGroupPorts", func() {
+		Context("when the server returns a valid response", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", fmt.Sprintf("%s/%s/ports", ROUTER_GROUPS_API_URL, DefaultRouterGroupGuid)),
+						ghttp.RespondWith(http.StatusOK, `{
+							"router_group_guid": "`+DefaultRouterGroupGuid+`",
+							"router_group_name": "`+DefaultRouterGroupName+`",
+							"summary": {"total": 2, "free": 1, "mapped": 1},
+							"ports": [
+								{"port": 4000, "state": "mapped", "backends": [{"backend_ip": "1.2.3.4", "backend_port": 8080}]},
+								{"port": 4001, "state": "free"}
+							]
+						}`),
+					),
+				)
+			})
+
+			It("returns the port occupancy of the router group", func() {
+				occupancy, err := client.RouterGroupPorts(DefaultRouterGroupGuid)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(server.ReceivedRequests()).Should(HaveLen(1))
+				Expect(occupancy.Summary).To(Equal(models.PortOccupancySummary{Total: 2, Free: 1, Mapped: 1}))
+				Expect(occupancy.Ports).To(HaveLen(2))
+				Expect(occupancy.Ports[0].Backends).To(Equal([]models.PortBackend{{HostIP: "1.2.3.4", HostPort: 8080}}))
+			})
+		})
+
+		Context("When the server returns an error", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", fmt.Sprintf("%s/%s/ports", ROUTER_GROUPS_API_URL, DefaultRouterGroupGuid)),
+						ghttp.RespondWith(http.StatusNotFound, nil),
+					),
+				)
+			})
+
+			It("returns an error", func() {
+				_, err := client.RouterGroupPorts(DefaultRouterGroupGuid)
+				Expect(err).To(HaveOccurred())
+			})
+		})
+	})
+
 	Context("CreateRouterGroup", func() {
 
 		var (
@@ -1217,6 +1397,150 @@ var _ = Describe("Client", func() {
 		})
 	})
 
+	Context("UdpRouteMappings", func() {
+		var (
+			err              error
+			udpRouteMapping1 models.UdpRouteMapping
+			udpRouteMapping2 models.UdpRouteMapping
+		)
+
+		BeforeEach(func() {
+			udpRouteMapping1 = models.NewUdpRouteMapping("router-group-guid-001", 5300, "1.2.3.4", 53, "", 60, models.ModificationTag{})
+			udpRouteMapping2 = models.NewUdpRouteMapping("router-group-guid-001", 5514, "1.2.3.5", 514, "", 60, models.ModificationTag{})
+		})
+
+		Context("UpsertUdpRouteMappings", func() {
+			BeforeEach(func() {
+				expectedBody, _ := json.Marshal([]models.UdpRouteMapping{udpRouteMapping1, udpRouteMapping2})
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("POST", UDP_CREATE_ROUTE_MAPPINGS_API_URL),
+						ghttp.VerifyJSON(string(expectedBody)),
+					),
+				)
+			})
+
+			It("sends an Upsert request to the server", func() {
+				err = client.UpsertUdpRouteMappings([]models.UdpRouteMapping{udpRouteMapping1, udpRouteMapping2})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(server.ReceivedRequests()).Should(HaveLen(1))
+			})
+		})
+
+		Context("DeleteUdpRouteMappings", func() {
+			BeforeEach(func() {
+				expectedBody, _ := json.Marshal([]models.UdpRouteMapping{udpRouteMapping1, udpRouteMapping2})
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("POST", UDP_DELETE_ROUTE_MAPPINGS_API_URL),
+						ghttp.VerifyJSON(string(expectedBody)),
+						ghttp.RespondWith(http.StatusNoContent, nil),
+					),
+				)
+			})
+
+			It("sends a Delete request to the server", func() {
+				err = client.DeleteUdpRouteMappings([]models.UdpRouteMapping{udpRouteMapping1, udpRouteMapping2})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(server.ReceivedRequests()).Should(HaveLen(1))
+			})
+		})
+
+		Context("when listing udp route mappings", func() {
+			var routes []models.UdpRouteMapping
+
+			It("sends a List request to the server", func() {
+				data, _ := json.Marshal([]models.UdpRouteMapping{udpRouteMapping1, udpRouteMapping2})
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", UDP_ROUTES_API_URL),
+						ghttp.RespondWith(http.StatusOK, data),
+					),
+				)
+
+				routes, err = client.UdpRouteMappings()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(routes).To(Equal([]models.UdpRouteMapping{udpRouteMapping1, udpRouteMapping2}))
+			})
+
+			It("can filter routes from the server", func() {
+				udpRouteMapping1.IsolationSegment = "is1"
+				data, _ := json.Marshal([]models.UdpRouteMapping{udpRouteMapping1})
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", UDP_ROUTES_API_URL, "isolation_segment=is1"),
+						ghttp.RespondWith(http.StatusOK, data),
+					),
+				)
+
+				routes, err = client.FilteredUdpRouteMappings([]string{"is1"})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(routes).To(Equal([]models.UdpRouteMapping{udpRouteMapping1}))
+			})
+
+			It("returns an error when the server returns an error", func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", UDP_ROUTES_API_URL),
+						ghttp.RespondWith(http.StatusBadRequest, nil),
+					),
+				)
+
+				routes, err = client.UdpRouteMappings()
+				Expect(err).To(HaveOccurred())
+				Expect(routes).To(BeEmpty())
+			})
+		})
+	})
+
+	Context("SubscribeToUdpEvents", func() {
+		var (
+			udpEventSource routing_api.UdpEventSource
+			err            error
+			udpRoute1      models.UdpRouteMapping
+		)
+
+		BeforeEach(func() {
+			udpRoute1 = models.NewUdpRouteMapping("rguid1", 5300, "1.1.1.1", 53, "", 60, models.ModificationTag{})
+
+			data, _ := json.Marshal(udpRoute1)
+			event := sse.Event{
+				ID:   "1",
+				Name: "Upsert",
+				Data: data,
+			}
+
+			server.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", UDP_EVENTS_SSE_URL),
+					ghttp.VerifyHeader(http.Header{
+						"Authorization": []string{"bearer"},
+					}),
+					func(w http.ResponseWriter, req *http.Request) {
+						defer GinkgoRecover()
+						writeErr := event.Write(w)
+						Expect(writeErr).ToNot(HaveOccurred())
+					},
+				),
+			)
+		})
+
+		JustBeforeEach(func() {
+			udpEventSource, err = client.SubscribeToUdpEvents()
+		})
+
+		It("Streams events from the server", func() {
+			Expect(err).NotTo(HaveOccurred())
+			Expect(udpEventSource).ToNot(BeNil())
+
+			ev, err := udpEventSource.Next()
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(ev.UdpRouteMapping).To(Equal(udpRoute1))
+			Expect(ev.Action).To(Equal("Upsert"))
+		})
+	})
+
 	Context("ReservePort", func() {
 		When("no router groups already exist", func() {
 			BeforeEach(func() {
@@ -6,6 +6,8 @@ import (
 	"fmt"
 	"net/http"
 	"os"
+	"os/signal"
+	"syscall"
 	"time"
 
 	"code.cloudfoundry.org/clock"
@@ -98,7 +100,7 @@ func main() {
 		}
 	}()
 
-	adminServer, err := admin.NewServer(cfg.AdminPort, database, logger.Session("admin-server"))
+	adminServer, err := admin.NewServer(cfg.AdminPort, database, cfg.PortPolicy(), logger.Session("admin-server"))
 	if err != nil {
 		logger.Error("failed-to-create-admin-server", err)
 		os.Exit(1)
@@ -111,9 +113,14 @@ func main() {
 	releaseLock := make(chan os.Signal)
 	lockErrChan := make(chan error)
 	metricsTicker := time.NewTicker(cfg.MetricsReportingInterval)
-	metricsReporter := metrics.NewMetricsReporter(database, statsdClient, metricsTicker, logger.Session("metrics"))
+	metricsReporter := metrics.NewMetricsReporter(database, statsdClient, metricsTicker, cfg.PortPolicy(), logger.Session("metrics"))
 	migrationProcess := runMigration(database, logger.Session("migration"))
-	routerGroupSeeder := seedRouterGroups(cfg, database, logger.Session("seeding"))
+	var routerGroupSeeder ifrit.Runner
+	if cfg.RouterGroupsMode == config.RouterGroupsModeReconcile {
+		routerGroupSeeder = reconcileRouterGroups(cfg, database, logger.Session("reconcile-router-groups"))
+	} else {
+		routerGroupSeeder = seedRouterGroups(cfg, database, logger.Session("seeding"))
+	}
 
 	locks := grouper.Members{}
 
@@ -277,6 +284,30 @@ func seedRouterGroups(cfg config.Config, database db.DB, logger lager.Logger) if
 	})
 }
 
+// reconcileRouterGroups reconciles the configured router groups at startup
+// and again each time the process receives SIGHUP, after re-reading the
+// configuration file.
+func reconcileRouterGroups(cfg config.Config, database db.DB, logger lager.Logger) ifrit.Runner {
+	reload := make(chan os.Signal, 1)
+	signal.Notify(reload, syscall.SIGHUP)
+
+	load := func() (models.RouterGroups, helpers.ReconcileOptions, error) {
+		reloaded, err := config.NewConfigFromFile(*configPath, *devMode)
+		if err != nil {
+			return nil, helpers.ReconcileOptions{}, err
+		}
+		return reloaded.RouterGroups, reconcileOptions(reloaded), nil
+	}
+	return helpers.NewRouterGroupReconciler(database, cfg.RouterGroups, reconcileOptions(cfg), load, reload, logger)
+}
+
+func reconcileOptions(cfg config.Config) helpers.ReconcileOptions {
+	return helpers.ReconcileOptions{
+		Prune: cfg.PruneRouterGroups,
+		Force: cfg.ForceRouterGroupPortChanges,
+	}
+}
+
 func runCleanupRoutes(sqlDatabase db.DB, logger lager.Logger) ifrit.Runner {
 	pruneLogger := logger.Session("prune-routes")
 	return ifrit.RunFunc(func(signals <-chan os.Signal, ready chan<- struct{}) error {
@@ -316,25 +347,53 @@ func constructRouteRegister(
 }
 
 func apiHandler(cfg config.Config, uaaClient uaaclient.TokenValidator, database db.DB, statsdClient statsd.Statter, logger lager.Logger) http.Handler {
-	validator := handlers.NewValidator()
+	validator := handlers.NewValidator(cfg.PortPolicy(), cfg.ConnectionLimitPolicy(), cfg.CustomALPNProtocolIDs, cfg.BackendNetworkPolicy())
 	routesHandler := handlers.NewRoutesHandler(uaaClient, int(cfg.MaxTTL.Seconds()), validator, database, logger)
 	eventStreamHandler := handlers.NewEventStreamHandler(uaaClient, database, logger, statsdClient)
-	routerGroupsHandler := handlers.NewRouteGroupsHandler(uaaClient, logger, database)
+	routerGroupsHandler := handlers.NewRouteGroupsHandler(uaaClient, logger, database, cfg.PortPolicy())
 	tcpMappingsHandler := handlers.NewTcpRouteMappingsHandler(uaaClient, validator, database, int(cfg.MaxTTL.Seconds()), logger)
+	udpMappingsHandler := handlers.NewUdpRouteMappingsHandler(uaaClient, validator, database, int(cfg.MaxTTL.Seconds()), logger)
+	portReservationsHandler := handlers.NewPortReservationsHandler(uaaClient, logger, database, cfg.PortPolicy())
+	tlsCertificatesHandler := handlers.NewTlsCertificatesHandler(uaaClient, logger, database)
+	backendTlsProfilesHandler := handlers.NewBackendTlsProfilesHandler(uaaClient, logger, database)
 
 	actions := rata.Handlers{
-		routing_api.UpsertRoute:           route(routesHandler.Upsert),
-		routing_api.DeleteRoute:           route(routesHandler.Delete),
-		routing_api.ListRoute:             route(routesHandler.List),
-		routing_api.EventStreamRoute:      route(eventStreamHandler.EventStream),
-		routing_api.ListRouterGroups:      route(routerGroupsHandler.ListRouterGroups),
-		routing_api.CreateRouterGroup:     route(routerGroupsHandler.CreateRouterGroup),
-		routing_api.UpdateRouterGroup:     route(routerGroupsHandler.UpdateRouterGroup),
-		routing_api.DeleteRouterGroup:     route(routerGroupsHandler.DeleteRouterGroup),
-		routing_api.UpsertTcpRouteMapping: route(tcpMappingsHandler.Upsert),
-		routing_api.DeleteTcpRouteMapping: route(tcpMappingsHandler.Delete),
-		routing_api.ListTcpRouteMapping:   route(tcpMappingsHandler.List),
-		routing_api.EventStreamTcpRoute:   route(eventStreamHandler.TcpEventStream),
+		routing_api.UpsertRoute:                   route(routesHandler.Upsert),
+		routing_api.DeleteRoute:                   route(routesHandler.Delete),
+		routing_api.ListRoute:                     route(routesHandler.List),
+		routing_api.EventStreamRoute:              route(eventStreamHandler.EventStream),
+		routing_api.ListRouterGroups:              route(routerGroupsHandler.ListRouterGroups),
+		routing_api.GetRouterGroup:                route(routerGroupsHandler.GetRouterGroup),
+		routing_api.CreateRouterGroup:             route(routerGroupsHandler.CreateRouterGroup),
+		routing_api.UpdateRouterGroup:             route(routerGroupsHandler.UpdateRouterGroup),
+		routing_api.DeleteRouterGroup:             route(routerGroupsHandler.DeleteRouterGroup),
+		routing_api.RouterGroupPorts:              route(routerGroupsHandler.RouterGroupPorts),
+		routing_api.EventStreamRouterGroups:       route(eventStreamHandler.RouterGroupEventStream),
+		routing_api.UpsertTcpRouteMapping:         route(tcpMappingsHandler.Upsert),
+		routing_api.DeleteTcpRouteMapping:         route(tcpMappingsHandler.Delete),
+		routing_api.ListTcpRouteMapping:           route(tcpMappingsHandler.List),
+		routing_api.EventStreamTcpRoute:           route(eventStreamHandler.TcpEventStream),
+		routing_api.UpsertUdpRouteMapping:         route(udpMappingsHandler.Upsert),
+		routing_api.DeleteUdpRouteMapping:         route(udpMappingsHandler.Delete),
+		routing_api.ListUdpRouteMapping:           route(udpMappingsHandler.List),
+		routing_api.EventStreamUdpRoute:           route(eventStreamHandler.UdpEventStream),
+		routing_api.CreatePortReservation:         route(portReservationsHandler.Create),
+		routing_api.ListPortReservations:          route(portReservationsHandler.List),
+		routing_api.GetPortReservation:            route(portReservationsHandler.Get),
+		routing_api.DeletePortReservation:         route(portReservationsHandler.Delete),
+		routing_api.EventStreamPortReservations:   route(eventStreamHandler.PortReservationEventStream),
+		routing_api.CreateTlsCertificate:          route(tlsCertificatesHandler.Create),
+		routing_api.ListTlsCertificates:           route(tlsCertificatesHandler.List),
+		routing_api.GetTlsCertificate:             route(tlsCertificatesHandler.Get),
+		routing_api.UpdateTlsCertificate:          route(tlsCertificatesHandler.Update),
+		routing_api.DeleteTlsCertificate:          route(tlsCertificatesHandler.Delete),
+		routing_api.EventStreamTlsCertificates:    route(eventStreamHandler.TlsCertificateEventStream),
+		routing_api.CreateBackendTlsProfile:       route(backendTlsProfilesHandler.Create),
+		routing_api.ListBackendTlsProfiles:        route(backendTlsProfilesHandler.List),
+		routing_api.GetBackendTlsProfile:          route(backendTlsProfilesHandler.Get),
+		routing_api.UpdateBackendTlsProfile:       route(backendTlsProfilesHandler.Update),
+		routing_api.DeleteBackendTlsProfile:       route(backendTlsProfilesHandler.Delete),
+		routing_api.EventStreamBackendTlsProfiles: route(eventStreamHandler.BackendTlsProfileEventStream),
 	}
 
 	handler, err := rata.NewRouter(routing_api.Routes(), actions)
@@ -399,10 +458,13 @@ func buildDatabase(
 
 	data := lager.Data{"host": cfg.SqlDB.Host, "port": cfg.SqlDB.Port}
 	logger.Info("database", data)
-	database, err = db.NewSqlDB(&cfg.SqlDB)
+	sqlDB, err := db.NewSqlDB(&cfg.SqlDB)
 	if err != nil {
 		logger.Error("failed-initialize-sql-connection", err, data)
 		return nil, err
 	}
+	sqlDB.SetPortPolicy(cfg.PortPolicy())
+	sqlDB.SetEncryptionKey(cfg.TlsCertificates.EncryptionKey)
+	database = sqlDB
 	return database, nil
 }
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"strings"
 	"time"
 
 	"gopkg.in/yaml.v3"
@@ -14,8 +15,42 @@ import (
 
 const (
 	DefaultLockResourceKey = "routing_api_lock"
+
+	// RouterGroupsModeSeed only creates the configured router groups when
+	// there are no router groups yet.
+	RouterGroupsModeSeed = "seed"
+	// RouterGroupsModeReconcile makes the router groups match the
+	// configuration at startup and when the configuration is reloaded.
+	RouterGroupsModeReconcile = "reconcile"
+
+	// minEncryptionKeyLength is the minimum length of the encryption key of
+	// the tls certificate store.
+	minEncryptionKeyLength = 32
 )
 
+// TcpConnectionLimitsConfig bounds the connection limits and timeouts of tcp
+// route mappings. A bound of 0 leaves the setting unbounded.
+type TcpConnectionLimitsConfig struct {
+	MaxConnections    int           `yaml:"max_connections"`
+	MaxConnectTimeout time.Duration `yaml:"max_connect_timeout"`
+	MaxIdleTimeout    time.Duration `yaml:"max_idle_timeout"`
+}
+
+// BackendNetworksConfig restricts the backend ips of routes. Backend ips in a
+// denied network are rejected, and so are backend ips outside of the allowed
+// networks when there are any.
+type BackendNetworksConfig struct {
+	AllowedCIDRs []string `yaml:"allowed_cidrs"`
+	DeniedCIDRs  []string `yaml:"denied_cidrs"`
+}
+
+// TlsCertificatesConfig configures the tls certificate store. The private
+// keys of tls certificates and backend tls profiles are encrypted with the
+// encryption key before they are stored in the database.
+type TlsCertificatesConfig struct {
+	EncryptionKey string `yaml:"encryption_key"`
+}
+
 type MetronConfig struct {
 	Address string
 	Port    string
@@ -70,8 +105,15 @@ type Config struct {
 	StatsdClientFlushInterval       time.Duration             `yaml:"-"`
 	OAuth                           OAuthConfig               `yaml:"oauth"`
 	RouterGroups                    models.RouterGroups       `yaml:"router_groups"`
+	RouterGroupsMode                string                    `yaml:"router_groups_mode"`
+	PruneRouterGroups               bool                      `yaml:"prune_router_groups"`
+	ForceRouterGroupPortChanges     bool                      `yaml:"force_router_group_port_changes"`
 	ReservedSystemComponentPorts    []uint16                  `yaml:"reserved_system_component_ports"`
 	FailOnRouterPortConflicts       bool                      `yaml:"fail_on_router_port_conflicts"`
+	TcpConnectionLimits             TcpConnectionLimitsConfig `yaml:"tcp_connection_limits"`
+	CustomALPNProtocolIDs           []string                  `yaml:"custom_alpn_protocol_ids"`
+	BackendNetworks                 BackendNetworksConfig     `yaml:"backend_networks"`
+	TlsCertificates                 TlsCertificatesConfig     `yaml:"tls_certificates"`
 	SqlDB                           SqlDB                     `yaml:"sqldb"`
 	Locket                          locket.ClientLocketConfig `yaml:"locket"`
 	UUID                            string                    `yaml:"uuid"`
@@ -142,20 +184,83 @@ func (cfg *Config) validate(authDisabled bool) error {
 		return fmt.Errorf("invalid API mTLS listen port: %s", err)
 	}
 
-	models.ReservedSystemComponentPorts = cfg.ReservedSystemComponentPorts
-	models.FailOnRouterPortConflicts = cfg.FailOnRouterPortConflicts
-
 	if cfg.Locket.LocketAddress == "" {
 		return errors.New("locket address is required")
 	}
 
-	if err := cfg.RouterGroups.Validate(); err != nil {
+	if err := cfg.RouterGroups.Validate(cfg.PortPolicy()); err != nil {
 		return err
 	}
 
+	limits := cfg.TcpConnectionLimits
+	if limits.MaxConnections < 0 || limits.MaxConnectTimeout < 0 || limits.MaxIdleTimeout < 0 {
+		return errors.New("invalid tcp_connection_limits: bounds must not be negative")
+	}
+
+	for _, id := range cfg.CustomALPNProtocolIDs {
+		if id == "" || len(id) > 255 || strings.ContainsAny(id, ", ") {
+			return fmt.Errorf("invalid custom_alpn_protocol_ids: '%s'", id)
+		}
+	}
+
+	if _, err := models.ParseCIDRs(cfg.BackendNetworks.AllowedCIDRs); err != nil {
+		return fmt.Errorf("invalid backend_networks allowed_cidrs: %s", err)
+	}
+
+	if _, err := models.ParseCIDRs(cfg.BackendNetworks.DeniedCIDRs); err != nil {
+		return fmt.Errorf("invalid backend_networks denied_cidrs: %s", err)
+	}
+
+	if key := cfg.TlsCertificates.EncryptionKey; key != "" && len(key) < minEncryptionKeyLength {
+		return fmt.Errorf("invalid tls_certificates: encryption_key must be at least %d characters", minEncryptionKeyLength)
+	}
+
+	switch cfg.RouterGroupsMode {
+	case "", RouterGroupsModeSeed, RouterGroupsModeReconcile:
+	default:
+		return fmt.Errorf("invalid router_groups_mode: %s (%s or %s)", cfg.RouterGroupsMode, RouterGroupsModeSeed, RouterGroupsModeReconcile)
+	}
+
+	if cfg.PruneRouterGroups && cfg.RouterGroupsMode != RouterGroupsModeReconcile {
+		return fmt.Errorf("prune_router_groups requires router_groups_mode %s", RouterGroupsModeReconcile)
+	}
+
+	if cfg.ForceRouterGroupPortChanges && cfg.RouterGroupsMode != RouterGroupsModeReconcile {
+		return fmt.Errorf("force_router_group_port_changes requires router_groups_mode %s", RouterGroupsModeReconcile)
+	}
+
 	return nil
 }
 
+// PortPolicy returns the port settings that apply to every router group.
+func (cfg Config) PortPolicy() models.PortPolicy {
+	return models.PortPolicy{
+		SystemComponentPorts: cfg.ReservedSystemComponentPorts,
+		FailOnConflicts:      cfg.FailOnRouterPortConflicts,
+	}
+}
+
+// ConnectionLimitPolicy returns the bounds of the connection limits and
+// timeouts of tcp route mappings.
+func (cfg Config) ConnectionLimitPolicy() models.ConnectionLimitPolicy {
+	return models.ConnectionLimitPolicy{
+		MaxConnections:    cfg.TcpConnectionLimits.MaxConnections,
+		MaxConnectTimeout: int(cfg.TcpConnectionLimits.MaxConnectTimeout.Seconds()),
+		MaxIdleTimeout:    int(cfg.TcpConnectionLimits.MaxIdleTimeout.Seconds()),
+	}
+}
+
+// BackendNetworkPolicy returns the networks that backend ips of routes are
+// allowed in and denied from. The networks are validated with the config.
+func (cfg Config) BackendNetworkPolicy() models.BackendNetworkPolicy {
+	allowed, _ := models.ParseCIDRs(cfg.BackendNetworks.AllowedCIDRs)
+	denied, _ := models.ParseCIDRs(cfg.BackendNetworks.DeniedCIDRs)
+	return models.BackendNetworkPolicy{
+		AllowedCIDRs: allowed,
+		DeniedCIDRs:  denied,
+	}
+}
+
 func validatePort(port uint16) error {
 	if port < 1 {
 		return fmt.Errorf("port number is invalid: %d (1-65535)", port)
@@ -177,6 +282,10 @@ func (cfg *Config) process() error {
 		cfg.LockResouceKey = DefaultLockResourceKey
 	}
 
+	if cfg.RouterGroupsMode == "" {
+		cfg.RouterGroupsMode = RouterGroupsModeSeed
+	}
+
 	cfg.SqlDB.SkipSSLValidation = cfg.SkipSSLValidation
 	cfg.OAuth.SkipSSLValidation = cfg.SkipSSLValidation
 
@@ -3,6 +3,7 @@ package config_test
 import (
 	"encoding/json"
 	"errors"
+	"net/netip"
 	"time"
 
 	"code.cloudfoundry.org/locket"
@@ -57,6 +58,16 @@ var _ = Describe("Config", func() {
 					Expect(cfg.API.MTLSServerKeyPath).To(Equal("server key file path"))
 					Expect(cfg.ReservedSystemComponentPorts).To(Equal([]uint16{5555, 6666}))
 					Expect(cfg.FailOnRouterPortConflicts).To(BeTrue())
+					Expect(cfg.TcpConnectionLimits.MaxConnections).To(Equal(10000))
+					Expect(cfg.TcpConnectionLimits.MaxConnectTimeout).To(Equal(30 * time.Second))
+					Expect(cfg.TcpConnectionLimits.MaxIdleTimeout).To(Equal(time.Hour))
+					Expect(cfg.CustomALPNProtocolIDs).To(Equal([]string{"my-proto"}))
+					Expect(cfg.BackendNetworks.AllowedCIDRs).To(Equal([]string{"10.0.0.0/8", "fd00::/8"}))
+					Expect(cfg.BackendNetworks.DeniedCIDRs).To(Equal([]string{"10.255.0.0/16"}))
+					Expect(cfg.TlsCertificates.EncryptionKey).To(Equal("an-encryption-key-of-at-least-32-characters"))
+					Expect(cfg.RouterGroupsMode).To(Equal(config.RouterGroupsModeReconcile))
+					Expect(cfg.PruneRouterGroups).To(BeFalse())
+					Expect(cfg.ForceRouterGroupPortChanges).To(BeFalse())
 				})
 
 				Context("when there is no token endpoint specified", func() {
@@ -506,16 +517,173 @@ var _ = Describe("Config", func() {
 			})
 		})
 
+		Context("when router_groups_mode is not set", func() {
+			It("defaults to seed", func() {
+				cfg, err := config.NewConfigFromBytes(testConfig, true)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(cfg.RouterGroupsMode).To(Equal(config.RouterGroupsModeSeed))
+				Expect(cfg.PruneRouterGroups).To(BeFalse())
+			})
+		})
+
+		Context("when router_groups_mode is reconcile", func() {
+			BeforeEach(func() {
+				validHash["router_groups_mode"] = "reconcile"
+				validHash["prune_router_groups"] = true
+				validHash["force_router_group_port_changes"] = true
+			})
+
+			It("populates the values", func() {
+				cfg, err := config.NewConfigFromBytes(testConfig, true)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(cfg.RouterGroupsMode).To(Equal(config.RouterGroupsModeReconcile))
+				Expect(cfg.PruneRouterGroups).To(BeTrue())
+				Expect(cfg.ForceRouterGroupPortChanges).To(BeTrue())
+			})
+		})
+
+		Context("when router_groups_mode is invalid", func() {
+			BeforeEach(func() {
+				validHash["router_groups_mode"] = "sync"
+			})
+
+			It("returns an error", func() {
+				_, err := config.NewConfigFromBytes(testConfig, true)
+				Expect(err).To(MatchError(ContainSubstring("invalid router_groups_mode: sync")))
+			})
+		})
+
+		Context("when prune_router_groups is set without reconcile", func() {
+			BeforeEach(func() {
+				validHash["prune_router_groups"] = true
+			})
+
+			It("returns an error", func() {
+				_, err := config.NewConfigFromBytes(testConfig, true)
+				Expect(err).To(MatchError("prune_router_groups requires router_groups_mode reconcile"))
+			})
+		})
+
+		Context("when force_router_group_port_changes is set without reconcile", func() {
+			BeforeEach(func() {
+				validHash["force_router_group_port_changes"] = true
+			})
+
+			It("returns an error", func() {
+				_, err := config.NewConfigFromBytes(testConfig, true)
+				Expect(err).To(MatchError("force_router_group_port_changes requires router_groups_mode reconcile"))
+			})
+		})
+
 		Context("when fail_on_router_port_conflicts is provided", func() {
 			BeforeEach(func() {
 				validHash["fail_on_router_port_conflicts"] = true
 			})
 
-			It("sets the models.FailOnRouterPortConflicts variable", func() {
+			It("fails on conflicts in the port policy", func() {
+				cfg, err := config.NewConfigFromBytes(testConfig, true)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(cfg.PortPolicy().FailOnConflicts).To(Equal(true))
+
+			})
+		})
+
+		Context("when custom_alpn_protocol_ids are provided", func() {
+			BeforeEach(func() {
+				validHash["custom_alpn_protocol_ids"] = []string{"my-proto"}
+			})
+
+			It("includes the ids in the config", func() {
+				cfg, err := config.NewConfigFromBytes(testConfig, true)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(cfg.CustomALPNProtocolIDs).To(Equal([]string{"my-proto"}))
+			})
+
+			Context("when an id contains a comma", func() {
+				BeforeEach(func() {
+					validHash["custom_alpn_protocol_ids"] = []string{"my,proto"}
+				})
+
+				It("returns an error", func() {
+					_, err := config.NewConfigFromBytes(testConfig, true)
+					Expect(err).To(MatchError("invalid custom_alpn_protocol_ids: 'my,proto'"))
+				})
+			})
+		})
+
+		Context("when backend_networks are provided", func() {
+			BeforeEach(func() {
+				validHash["backend_networks"] = map[string]interface{}{
+					"allowed_cidrs": []string{"10.0.0.0/8", "fd00::/8"},
+					"denied_cidrs":  []string{"10.255.0.0/16"},
+				}
+			})
+
+			It("returns the networks as the backend network policy", func() {
+				cfg, err := config.NewConfigFromBytes(testConfig, true)
+				Expect(err).NotTo(HaveOccurred())
+				policy := cfg.BackendNetworkPolicy()
+				Expect(policy.AllowedCIDRs).To(Equal([]netip.Prefix{netip.MustParsePrefix("10.0.0.0/8"), netip.MustParsePrefix("fd00::/8")}))
+				Expect(policy.DeniedCIDRs).To(Equal([]netip.Prefix{netip.MustParsePrefix("10.255.0.0/16")}))
+			})
+
+			Context("when a network is invalid", func() {
+				BeforeEach(func() {
+					validHash["backend_networks"] = map[string]interface{}{
+						"denied_cidrs": []string{"10.255.0.0"},
+					}
+				})
+
+				It("returns an error", func() {
+					_, err := config.NewConfigFromBytes(testConfig, true)
+					Expect(err).To(MatchError("invalid backend_networks denied_cidrs: invalid cidr '10.255.0.0'"))
+				})
+			})
+		})
+
+		Context("when the tls_certificates encryption_key is too short", func() {
+			BeforeEach(func() {
+				validHash["tls_certificates"] = map[string]interface{}{
+					"encryption_key": "too-short",
+				}
+			})
+
+			It("returns an error", func() {
 				_, err := config.NewConfigFromBytes(testConfig, true)
+				Expect(err).To(MatchError("invalid tls_certificates: encryption_key must be at least 32 characters"))
+			})
+		})
+
+		Context("when tcp_connection_limits are provided", func() {
+			BeforeEach(func() {
+				validHash["tcp_connection_limits"] = map[string]interface{}{
+					"max_connections":     500,
+					"max_connect_timeout": "10s",
+					"max_idle_timeout":    "5m",
+				}
+			})
+
+			It("includes the bounds in the connection limit policy", func() {
+				cfg, err := config.NewConfigFromBytes(testConfig, true)
 				Expect(err).NotTo(HaveOccurred())
-				Expect(models.FailOnRouterPortConflicts).To(Equal(true))
+				Expect(cfg.ConnectionLimitPolicy()).To(Equal(models.ConnectionLimitPolicy{
+					MaxConnections:    500,
+					MaxConnectTimeout: 10,
+					MaxIdleTimeout:    300,
+				}))
+			})
+
+			Context("when a bound is negative", func() {
+				BeforeEach(func() {
+					validHash["tcp_connection_limits"] = map[string]interface{}{
+						"max_connections": -1,
+					}
+				})
 
+				It("returns an error", func() {
+					_, err := config.NewConfigFromBytes(testConfig, true)
+					Expect(err).To(MatchError("invalid tcp_connection_limits: bounds must not be negative"))
+				})
 			})
 		})
 
@@ -524,10 +692,10 @@ var _ = Describe("Config", func() {
 				validHash["reserved_system_component_ports"] = []int{1234, 5555}
 			})
 
-			It("sets the models.ReservedSystemComponentPorts variable", func() {
-				_, err := config.NewConfigFromBytes(testConfig, true)
+			It("includes the ports in the port policy", func() {
+				cfg, err := config.NewConfigFromBytes(testConfig, true)
 				Expect(err).NotTo(HaveOccurred())
-				Expect(models.ReservedSystemComponentPorts).To(Equal([]uint16{1234, 5555}))
+				Expect(cfg.PortPolicy().SystemComponentPorts).To(Equal([]uint16{1234, 5555}))
 
 			})
 
@@ -31,14 +31,39 @@ type DB interface {
 	ReadTcpRouteMappings() ([]models.TcpRouteMapping, error)
 	ReadFilteredTcpRouteMappings(columnName string, values []string) ([]models.TcpRouteMapping, error)
 	FindSimilarTcpRouteMappings(sniHostname string, externalPort uint16) ([]models.TcpRouteMapping, error)
+	FindOverlappingTcpRouteMappings(tcpMapping models.TcpRouteMapping) ([]models.TcpRouteMapping, error)
 	SaveTcpRouteMapping(tcpMapping models.TcpRouteMapping) error
+	AllocateTcpRouteMapping(tcpMapping models.TcpRouteMapping) (models.TcpRouteMapping, error)
 	DeleteTcpRouteMapping(tcpMapping models.TcpRouteMapping) error
 
+	ReadUdpRouteMappings() ([]models.UdpRouteMapping, error)
+	ReadFilteredUdpRouteMappings(columnName string, values []string) ([]models.UdpRouteMapping, error)
+	SaveUdpRouteMapping(udpMapping models.UdpRouteMapping) error
+	DeleteUdpRouteMapping(udpMapping models.UdpRouteMapping) error
+
 	ReadRouterGroups() (models.RouterGroups, error)
 	ReadRouterGroup(guid string) (models.RouterGroup, error)
 	DeleteRouterGroup(guid string) error
 	ReadRouterGroupByName(name string) (models.RouterGroup, error)
 	SaveRouterGroup(routerGroup models.RouterGroup) error
+	SaveRouterGroupAndDeleteStrandedTcpRouteMappings(routerGroup models.RouterGroup) ([]models.TcpRouteMapping, error)
+	DeleteRouterGroupCascade(guid string) (models.RouterGroupDependents, error)
+
+	ReadPortReservations() ([]models.PortReservation, error)
+	ReadPortReservation(guid string) (models.PortReservation, error)
+	SavePortReservation(reservation models.PortReservation) (models.PortReservation, error)
+	DeletePortReservation(guid string) error
+
+	ReadTlsCertificates() ([]models.TlsCertificate, error)
+	ReadTlsCertificate(guid string) (models.TlsCertificate, error)
+	SaveTlsCertificate(certificate models.TlsCertificate) (models.TlsCertificate, error)
+	DeleteTlsCertificate(guid string) error
+
+	ReadBackendTlsProfiles() ([]models.BackendTlsProfile, error)
+	ReadBackendTlsProfile(guid string) (models.BackendTlsProfile, error)
+	ReadBackendTlsProfileByName(name string) (models.BackendTlsProfile, error)
+	SaveBackendTlsProfile(profile models.BackendTlsProfile) (models.BackendTlsProfile, error)
+	DeleteBackendTlsProfile(guid string) error
 
 	CancelWatches()
 	WatchChanges(watchType string) (<-chan Event, <-chan error, context.CancelFunc)
@@ -50,14 +75,18 @@ type DB interface {
 }
 
 const (
-	TCP_MAPPING_BASE_KEY  string = "/v1/tcp_routes/router_groups"
-	HTTP_ROUTE_BASE_KEY   string = "/routes"
-	ROUTER_GROUP_BASE_KEY string = "/v1/router_groups"
-	defaultDialTimeout           = 30 * time.Second
-	maxRetries                   = 3
-	TCP_WATCH             string = "tcp-watch"
-	HTTP_WATCH            string = "http-watch"
-	ROUTER_GROUP_WATCH    string = "router-group-watch"
+	TCP_MAPPING_BASE_KEY      string = "/v1/tcp_routes/router_groups"
+	HTTP_ROUTE_BASE_KEY       string = "/routes"
+	ROUTER_GROUP_BASE_KEY     string = "/v1/router_groups"
+	defaultDialTimeout               = 30 * time.Second
+	maxRetries                       = 3
+	TCP_WATCH                 string = "tcp-watch"
+	UDP_WATCH                 string = "udp-watch"
+	HTTP_WATCH                string = "http-watch"
+	ROUTER_GROUP_WATCH        string = "router-group-watch"
+	PORT_RESERVATION_WATCH    string = "port-reservation-watch"
+	TLS_CERTIFICATE_WATCH     string = "tls-certificate-watch"
+	BACKEND_TLS_PROFILE_WATCH string = "backend-tls-profile-watch"
 )
 
 const backupError = "database unavailable due to backup or restore"
@@ -92,14 +121,24 @@ func (l *rwLocker) unlockWrites() {
 }
 
 type SqlDB struct {
-	Client       Client
-	tcpEventHub  eventhub.Hub
-	httpEventHub eventhub.Hub
-	locker       *rwLocker
+	Client                    Client
+	tcpEventHub               eventhub.Hub
+	udpEventHub               eventhub.Hub
+	httpEventHub              eventhub.Hub
+	portReservationEventHub   eventhub.Hub
+	routerGroupEventHub       eventhub.Hub
+	tlsCertificateEventHub    eventhub.Hub
+	backendTlsProfileEventHub eventhub.Hub
+	locker                    *rwLocker
+	portPolicy                models.PortPolicy
+	encryptor                 *encryptor
 }
 
 var DeleteRouteError = DBError{Type: KeyNotFound, Message: "Delete Fails: Route does not exist"}
 var DeleteRouterGroupError = DBError{Type: KeyNotFound, Message: "Delete Fails: Router Group does not exist"}
+var DeletePortReservationError = DBError{Type: KeyNotFound, Message: "Delete Fails: Port Reservation does not exist"}
+var DeleteTlsCertificateError = DBError{Type: KeyNotFound, Message: "Delete Fails: Tls Certificate does not exist"}
+var DeleteBackendTlsProfileError = DBError{Type: KeyNotFound, Message: "Delete Fails: Backend Tls Profile does not exist"}
 
 func NewSqlDB(cfg *config.SqlDB) (*SqlDB, error) {
 	if cfg == nil {
@@ -137,16 +176,43 @@ func NewSqlDB(cfg *config.SqlDB) (*SqlDB, error) {
 	sqlDB.SetConnMaxLifetime(connMaxLifetime)
 
 	tcpEventHub := eventhub.NewNonBlocking(1024)
+	udpEventHub := eventhub.NewNonBlocking(1024)
 	httpEventHub := eventhub.NewNonBlocking(1024)
+	portReservationEventHub := eventhub.NewNonBlocking(1024)
+	routerGroupEventHub := eventhub.NewNonBlocking(1024)
+	tlsCertificateEventHub := eventhub.NewNonBlocking(1024)
+	backendTlsProfileEventHub := eventhub.NewNonBlocking(1024)
 
 	return &SqlDB{
-		Client:       NewGormClient(db),
-		tcpEventHub:  tcpEventHub,
-		httpEventHub: httpEventHub,
-		locker:       &rwLocker{},
+		Client:                    NewGormClient(db),
+		tcpEventHub:               tcpEventHub,
+		udpEventHub:               udpEventHub,
+		httpEventHub:              httpEventHub,
+		portReservationEventHub:   portReservationEventHub,
+		routerGroupEventHub:       routerGroupEventHub,
+		tlsCertificateEventHub:    tlsCertificateEventHub,
+		backendTlsProfileEventHub: backendTlsProfileEventHub,
+		locker:                    &rwLocker{},
 	}, nil
 }
 
+// SetPortPolicy sets the port policy used to allocate external ports and
+// port reservations.
+func (s *SqlDB) SetPortPolicy(policy models.PortPolicy) {
+	s.portPolicy = policy
+}
+
+// SetEncryptionKey sets the key that the private keys of tls certificates and
+// backend tls profiles are encrypted with. Without it, they cannot be stored
+// or read.
+func (s *SqlDB) SetEncryptionKey(key string) {
+	if key == "" {
+		s.encryptor = nil
+		return
+	}
+	s.encryptor = newEncryptor(key)
+}
+
 func (s *SqlDB) FindExpiredRoutes(routes interface{}, c clock.Clock) error {
 	// mysql stores time at second level precision, but lets us query with sub-second precision.
 	// postgres stores at microsecond precision. we subtract a second from expiry time to give
@@ -157,7 +223,7 @@ func (s *SqlDB) FindExpiredRoutes(routes interface{}, c clock.Clock) error {
 }
 
 func (s *SqlDB) CleanupRoutes(logger lager.Logger, pruningInterval time.Duration, signals <-chan os.Signal) {
-	var tcpInFlight, httpInFlight int32
+	var tcpInFlight, udpInFlight, httpInFlight, reservationInFlight int32
 	pruningTicker := time.NewTicker(pruningInterval)
 	clock := clock.NewClock()
 	for {
@@ -192,6 +258,35 @@ func (s *SqlDB) CleanupRoutes(logger lager.Logger, pruningInterval time.Duration
 				}()
 			}
 
+			if atomic.CompareAndSwapInt32(&udpInFlight, 0, 1) {
+				go func() {
+					defer atomic.StoreInt32(&udpInFlight, 0)
+					var udpRoutes []models.UdpRouteMapping
+					err := s.FindExpiredRoutes(&udpRoutes, clock)
+					if err != nil {
+						logger.Error("failed-to-prune-udp-routes", err)
+						return
+					}
+					guids := make([]string, 0, len(udpRoutes))
+					for _, route := range udpRoutes {
+						guids = append(guids, route.Guid)
+					}
+					rowsAffected, err := s.Client.Delete(models.UdpRouteMapping{}, "guid in (?)", guids)
+					if err != nil {
+						logger.Error("failed-to-prune-udp-routes", err)
+						return
+					}
+					for _, route := range udpRoutes {
+						err = s.emitEvent(ExpireEvent, route)
+						if err != nil {
+							logger.Error("failed-to-emit-expire-udp-event", err)
+						}
+					}
+
+					logger.Info("successfully-finished-pruning-udp-routes", lager.Data{"rowsAffected": rowsAffected})
+				}()
+			}
+
 			if atomic.CompareAndSwapInt32(&httpInFlight, 0, 1) {
 				go func() {
 					defer atomic.StoreInt32(&httpInFlight, 0)
@@ -220,6 +315,35 @@ func (s *SqlDB) CleanupRoutes(logger lager.Logger, pruningInterval time.Duration
 					logger.Info("successfully-finished-pruning-http-routes", lager.Data{"rowsAffected": rowsAffected})
 				}()
 			}
+
+			if atomic.CompareAndSwapInt32(&reservationInFlight, 0, 1) {
+				go func() {
+					defer atomic.StoreInt32(&reservationInFlight, 0)
+					var reservations []models.PortReservation
+					err := s.FindExpiredRoutes(&reservations, clock)
+					if err != nil {
+						logger.Error("failed-to-prune-port-reservations", err)
+						return
+					}
+					guids := make([]string, 0, len(reservations))
+					for _, reservation := range reservations {
+						guids = append(guids, reservation.Guid)
+					}
+					rowsAffected, err := s.Client.Delete(models.PortReservation{}, "guid in (?)", guids)
+					if err != nil {
+						logger.Error("failed-to-prune-port-reservations", err)
+						return
+					}
+					for _, reservation := range reservations {
+						err = s.emitEvent(ExpireEvent, reservation)
+						if err != nil {
+							logger.Error("failed-to-emit-expire-port-reservation-event", err)
+						}
+					}
+
+					logger.Info("successfully-finished-pruning-port-reservations", lager.Data{"rowsAffected": rowsAffected})
+				}()
+			}
 		case <-signals:
 			return
 		}
@@ -284,35 +408,215 @@ func (s *SqlDB) SaveRouterGroup(routerGroup models.RouterGroup) error {
 	}
 
 	routerGroupDB := models.NewRouterGroupDB(routerGroup)
+	eventType := CreateEvent
 	if existingRouterGroup.Guid == routerGroup.Guid {
 		updateRouterGroup(&existingRouterGroup, &routerGroup)
 		routerGroupDB = models.NewRouterGroupDB(existingRouterGroup)
 		_, err = s.Client.Save(&routerGroupDB)
+		eventType = UpdateEvent
 	} else {
 		_, err = s.Client.Create(&routerGroupDB)
 	}
+	if err != nil {
+		return err
+	}
 
-	return err
+	return s.emitEvent(eventType, routerGroupDB.ToRouterGroup())
+}
+
+// SaveRouterGroupAndDeleteStrandedTcpRouteMappings updates an existing router
+// group and, in the same transaction, deletes its tcp route mappings whose
+// external port is no longer within its reservable ports. It returns the
+// deleted mappings.
+func (s *SqlDB) SaveRouterGroupAndDeleteStrandedTcpRouteMappings(routerGroup models.RouterGroup) ([]models.TcpRouteMapping, error) {
+	if s.locker.isWriteLocked() {
+		return nil, errors.New(backupError)
+	}
+
+	tx := s.Client.Begin()
+
+	saved, stranded, err := saveRouterGroupAndDeleteStrandedTcpRouteMappings(tx, routerGroup)
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.emitEvent(UpdateEvent, saved)
+	if err != nil {
+		return stranded, err
+	}
+	for _, mapping := range stranded {
+		err = s.emitEvent(DeleteEvent, mapping)
+		if err != nil {
+			return stranded, err
+		}
+	}
+	return stranded, nil
 }
 
+func saveRouterGroupAndDeleteStrandedTcpRouteMappings(tx Client, routerGroup models.RouterGroup) (models.RouterGroup, []models.TcpRouteMapping, error) {
+	existingRouterGroup, err := lockRouterGroup(tx, routerGroup.Guid)
+	if err != nil {
+		return models.RouterGroup{}, nil, err
+	}
+
+	updateRouterGroup(&existingRouterGroup, &routerGroup)
+	routerGroupDB := models.NewRouterGroupDB(existingRouterGroup)
+	_, err = tx.Save(&routerGroupDB)
+	if err != nil {
+		return models.RouterGroup{}, nil, err
+	}
+
+	var mappings []models.TcpRouteMapping
+	err = tx.Where("router_group_guid = ?", routerGroup.Guid).Where("expires_at > ?", time.Now()).Find(&mappings)
+	if err != nil {
+		return models.RouterGroup{}, nil, err
+	}
+
+	stranded, err := existingRouterGroup.StrandedTcpRouteMappings(mappings)
+	if err != nil {
+		return models.RouterGroup{}, nil, err
+	}
+
+	for i := range stranded {
+		_, err = tx.Delete(&stranded[i])
+		if err != nil {
+			return models.RouterGroup{}, nil, err
+		}
+	}
+	return routerGroupDB.ToRouterGroup(), stranded, nil
+}
+
+// DeleteRouterGroup deletes a router group that has no live http routes, tcp or
+// udp route mappings or port reservations. Otherwise it returns an InUse
+// DBError.
 func (s *SqlDB) DeleteRouterGroup(guid string) error {
+	_, err := s.deleteRouterGroup(guid, false)
+	return err
+}
+
+// DeleteRouterGroupCascade deletes a router group along with its http routes,
+// tcp and udp route mappings and port reservations in one transaction, and
+// returns the live routes, mappings and reservations that were deleted.
+func (s *SqlDB) DeleteRouterGroupCascade(guid string) (models.RouterGroupDependents, error) {
+	return s.deleteRouterGroup(guid, true)
+}
+
+func (s *SqlDB) deleteRouterGroup(guid string, cascade bool) (models.RouterGroupDependents, error) {
 	if s.locker.isWriteLocked() {
-		return errors.New(backupError)
+		return models.RouterGroupDependents{}, errors.New(backupError)
 	}
-	routerGroup, err := s.ReadRouterGroup(guid)
+
+	tx := s.Client.Begin()
+
+	routerGroup, dependents, err := deleteRouterGroup(tx, guid, cascade)
 	if err != nil {
-		return err
+		_ = tx.Rollback()
+		return models.RouterGroupDependents{}, err
 	}
-	if routerGroup == (models.RouterGroup{}) {
-		return DeleteRouterGroupError
+
+	err = tx.Commit()
+	if err != nil {
+		return models.RouterGroupDependents{}, err
 	}
 
-	// Use WHERE clause to delete the specific router group by guid
-	_, err = s.Client.Where("guid = ?", guid).Delete(&models.RouterGroupDB{})
+	err = s.emitEvent(DeleteEvent, routerGroup)
 	if err != nil {
-		return err
+		return dependents, err
 	}
-	return nil
+
+	for _, route := range dependents.Routes {
+		err = s.emitEvent(DeleteEvent, route)
+		if err != nil {
+			return dependents, err
+		}
+	}
+	for _, mapping := range dependents.TcpRouteMappings {
+		err = s.emitEvent(DeleteEvent, mapping)
+		if err != nil {
+			return dependents, err
+		}
+	}
+	for _, mapping := range dependents.UdpRouteMappings {
+		err = s.emitEvent(DeleteEvent, mapping)
+		if err != nil {
+			return dependents, err
+		}
+	}
+	for _, reservation := range dependents.PortReservations {
+		err = s.emitEvent(DeleteEvent, reservation)
+		if err != nil {
+			return dependents, err
+		}
+	}
+	return dependents, nil
+}
+
+func deleteRouterGroup(tx Client, guid string, cascade bool) (models.RouterGroup, models.RouterGroupDependents, error) {
+	routerGroup, err := lockRouterGroup(tx, guid)
+	if err != nil {
+		if dberr, ok := err.(DBError); ok && dberr.Type == KeyNotFound {
+			return models.RouterGroup{}, models.RouterGroupDependents{}, DeleteRouterGroupError
+		}
+		return models.RouterGroup{}, models.RouterGroupDependents{}, err
+	}
+
+	now := time.Now()
+	var dependents models.RouterGroupDependents
+	err = tx.Where("router_group_guid = ?", guid).Where("expires_at > ?", now).Find(&dependents.Routes)
+	if err != nil {
+		return models.RouterGroup{}, models.RouterGroupDependents{}, err
+	}
+
+	err = tx.Where("router_group_guid = ?", guid).Where("expires_at > ?", now).Find(&dependents.TcpRouteMappings)
+	if err != nil {
+		return models.RouterGroup{}, models.RouterGroupDependents{}, err
+	}
+
+	err = tx.Where("router_group_guid = ?", guid).Where("expires_at > ?", now).Find(&dependents.UdpRouteMappings)
+	if err != nil {
+		return models.RouterGroup{}, models.RouterGroupDependents{}, err
+	}
+
+	err = tx.Where("router_group_guid = ?", guid).Where("expires_at IS NULL OR expires_at > ?", now).Find(&dependents.PortReservations)
+	if err != nil {
+		return models.RouterGroup{}, models.RouterGroupDependents{}, err
+	}
+
+	if !cascade && !dependents.Empty() {
+		return models.RouterGroup{}, models.RouterGroupDependents{}, DBError{
+			Type:    InUse,
+			Message: fmt.Sprintf("Delete Fails: Router Group has %s", dependents),
+		}
+	}
+
+	// expired rows are deleted too, so that no row refers to the router group
+	_, err = tx.Where("router_group_guid = ?", guid).Delete(&models.Route{})
+	if err != nil {
+		return models.RouterGroup{}, models.RouterGroupDependents{}, err
+	}
+	_, err = tx.Where("router_group_guid = ?", guid).Delete(&models.TcpRouteMapping{})
+	if err != nil {
+		return models.RouterGroup{}, models.RouterGroupDependents{}, err
+	}
+	_, err = tx.Where("router_group_guid = ?", guid).Delete(&models.UdpRouteMapping{})
+	if err != nil {
+		return models.RouterGroup{}, models.RouterGroupDependents{}, err
+	}
+	_, err = tx.Where("router_group_guid = ?", guid).Delete(&models.PortReservation{})
+	if err != nil {
+		return models.RouterGroup{}, models.RouterGroupDependents{}, err
+	}
+	_, err = tx.Where("guid = ?", guid).Delete(&models.RouterGroupDB{})
+	if err != nil {
+		return models.RouterGroup{}, models.RouterGroupDependents{}, err
+	}
+	return routerGroup, dependents, nil
 }
 
 func (s *SqlDB) LockRouterGroupReads() {
@@ -339,6 +643,18 @@ func updateRouterGroup(existingRouterGroup, currentRouterGroup *models.RouterGro
 		existingRouterGroup.Name = currentRouterGroup.Name
 	}
 	existingRouterGroup.ReservablePorts = currentRouterGroup.ReservablePorts
+	if currentRouterGroup.Labels != "" {
+		existingRouterGroup.Labels = currentRouterGroup.Labels
+	}
+	existingRouterGroup.Description = currentRouterGroup.Description
+	existingRouterGroup.MinTTL = currentRouterGroup.MinTTL
+	existingRouterGroup.MaxTTL = currentRouterGroup.MaxTTL
+	existingRouterGroup.DefaultTTL = currentRouterGroup.DefaultTTL
+	existingRouterGroup.MaxTcpRoutes = currentRouterGroup.MaxTcpRoutes
+	existingRouterGroup.MaxTcpRoutesPerIsolationSegment = currentRouterGroup.MaxTcpRoutesPerIsolationSegment
+	existingRouterGroup.ExcludedPorts = currentRouterGroup.ExcludedPorts
+	existingRouterGroup.AllowedBackendCIDRs = currentRouterGroup.AllowedBackendCIDRs
+	existingRouterGroup.DeniedBackendCIDRs = currentRouterGroup.DeniedBackendCIDRs
 }
 
 func updateTcpRouteMapping(existingTcpRouteMapping models.TcpRouteMapping, currentTcpRouteMapping models.TcpRouteMapping) models.TcpRouteMapping {
@@ -348,12 +664,38 @@ func updateTcpRouteMapping(existingTcpRouteMapping models.TcpRouteMapping, curre
 	}
 	existingTcpRouteMapping.IsolationSegment = currentTcpRouteMapping.IsolationSegment
 	existingTcpRouteMapping.SniRewriteHostname = currentTcpRouteMapping.SniRewriteHostname
+	existingTcpRouteMapping.HealthCheck = currentTcpRouteMapping.HealthCheck
+	existingTcpRouteMapping.ProxyProtocol = currentTcpRouteMapping.ProxyProtocol
+	existingTcpRouteMapping.MaxConnections = currentTcpRouteMapping.MaxConnections
+	existingTcpRouteMapping.ConnectTimeout = currentTcpRouteMapping.ConnectTimeout
+	existingTcpRouteMapping.IdleTimeout = currentTcpRouteMapping.IdleTimeout
+	existingTcpRouteMapping.TlsCertificateGuid = currentTcpRouteMapping.TlsCertificateGuid
+	existingTcpRouteMapping.BackendTlsProfile = currentTcpRouteMapping.BackendTlsProfile
+	if currentTcpRouteMapping.Labels != "" {
+		existingTcpRouteMapping.Labels = currentTcpRouteMapping.Labels
+	}
 
 	existingTcpRouteMapping.ExpiresAt = time.Now().
 		Add(time.Duration(*existingTcpRouteMapping.TTL) * time.Second)
 	return existingTcpRouteMapping
 }
 
+func updateUdpRouteMapping(existingUdpRouteMapping models.UdpRouteMapping, currentUdpRouteMapping models.UdpRouteMapping) models.UdpRouteMapping {
+	existingUdpRouteMapping.ModificationTag.Increment()
+	if currentUdpRouteMapping.TTL != nil {
+		existingUdpRouteMapping.TTL = currentUdpRouteMapping.TTL
+	}
+	existingUdpRouteMapping.InstanceId = currentUdpRouteMapping.InstanceId
+	existingUdpRouteMapping.IsolationSegment = currentUdpRouteMapping.IsolationSegment
+	if currentUdpRouteMapping.Labels != "" {
+		existingUdpRouteMapping.Labels = currentUdpRouteMapping.Labels
+	}
+
+	existingUdpRouteMapping.ExpiresAt = time.Now().
+		Add(time.Duration(*existingUdpRouteMapping.TTL) * time.Second)
+	return existingUdpRouteMapping
+}
+
 func updateRoute(existingRoute, currentRoute models.Route) models.Route {
 	existingRoute.ModificationTag.Increment()
 	if currentRoute.TTL != nil {
@@ -363,6 +705,12 @@ func updateRoute(existingRoute, currentRoute models.Route) models.Route {
 	if currentRoute.LogGuid != "" {
 		existingRoute.LogGuid = currentRoute.LogGuid
 	}
+	existingRoute.ServerCertDomainSAN = currentRoute.ServerCertDomainSAN
+	existingRoute.Protocol = currentRoute.Protocol
+	existingRoute.RouterGroupGuid = currentRoute.RouterGroupGuid
+	if currentRoute.Labels != "" {
+		existingRoute.Labels = currentRoute.Labels
+	}
 
 	existingRoute.ExpiresAt = time.Now().
 		Add(time.Duration(*existingRoute.TTL) * time.Second)
@@ -388,8 +736,8 @@ func (s *SqlDB) ReadRoutes() ([]models.Route, error) {
 
 func (s *SqlDB) readRoute(route models.Route) (models.Route, error) {
 	var routes []models.Route
-	err := s.Client.Where("route = ? and ip = ? and port = ? and route_service_url = ?",
-		route.Route, route.IP, route.Port, route.RouteServiceUrl).Find(&routes)
+	err := s.Client.Where("route = ? and ip = ? and port = ? and route_service_url = ? and tls_port = ?",
+		route.Route, route.IP, route.Port, route.RouteServiceUrl, route.TLSPort).Find(&routes)
 
 	if err != nil {
 		return route, err
@@ -486,7 +834,26 @@ func (s *SqlDB) FindSimilarTcpRouteMappings(sniHostname string, externalPort uin
 	return tcpRoutes, nil
 }
 
+// FindOverlappingTcpRouteMappings returns the live mappings of the router
+// group of the mapping that forward at least one of its external ports.
+func (s *SqlDB) FindOverlappingTcpRouteMappings(tcpMapping models.TcpRouteMapping) ([]models.TcpRouteMapping, error) {
+	var tcpRoutes []models.TcpRouteMapping
+	start, end := tcpMapping.ExternalPortRange().Endpoints()
+	err := s.Client.
+		Where("router_group_guid = ? and external_port <= ? and (external_port >= ? or external_port_end >= ?)", tcpMapping.RouterGroupGuid, end, start, start).
+		Where("expires_at > ?", time.Now()).
+		Find(&tcpRoutes)
+	if err != nil {
+		return nil, err
+	}
+	return tcpRoutes, nil
+}
+
 func (s *SqlDB) FindExistingTcpRouteMapping(tcpMapping models.TcpRouteMapping) (models.TcpRouteMapping, error) {
+	return findExistingTcpRouteMapping(s.Client, tcpMapping)
+}
+
+func findExistingTcpRouteMapping(tx Client, tcpMapping models.TcpRouteMapping) (models.TcpRouteMapping, error) {
 	var routes []models.TcpRouteMapping
 	var tcpRoute models.TcpRouteMapping
 	var err error
@@ -494,11 +861,11 @@ func (s *SqlDB) FindExistingTcpRouteMapping(tcpMapping models.TcpRouteMapping) (
 	// this where clause should represent all fields marked with the unique index on the TcpRouteMapping model,
 	// to ensure it returns the correct record from the database
 	if tcpMapping.SniHostname == nil {
-		err = s.Client.Where("router_group_guid = ? and host_ip = ? and host_port = ? and external_port = ? and host_tls_port = ? and sni_hostname IS NULL and enable_backend_m_tls = ?",
-			tcpMapping.RouterGroupGuid, tcpMapping.HostIP, tcpMapping.HostPort, tcpMapping.ExternalPort, tcpMapping.HostTLSPort, tcpMapping.EnableBackendMTLS).Find(&routes)
+		err = tx.Where("router_group_guid = ? and host_ip = ? and host_port = ? and external_port = ? and external_port_end = ? and host_tls_port = ? and sni_hostname IS NULL and enable_backend_m_tls = ?",
+			tcpMapping.RouterGroupGuid, tcpMapping.HostIP, tcpMapping.HostPort, tcpMapping.ExternalPort, tcpMapping.ExternalPortEnd, tcpMapping.HostTLSPort, tcpMapping.EnableBackendMTLS).Find(&routes)
 	} else {
-		err = s.Client.Where("router_group_guid = ? and host_ip = ? and host_port = ? and external_port = ? and host_tls_port = ? and sni_hostname = ? and enable_backend_m_tls = ?",
-			tcpMapping.RouterGroupGuid, tcpMapping.HostIP, tcpMapping.HostPort, tcpMapping.ExternalPort, tcpMapping.HostTLSPort, tcpMapping.SniHostname, tcpMapping.EnableBackendMTLS).Find(&routes)
+		err = tx.Where("router_group_guid = ? and host_ip = ? and host_port = ? and external_port = ? and external_port_end = ? and host_tls_port = ? and sni_hostname = ? and enable_backend_m_tls = ?",
+			tcpMapping.RouterGroupGuid, tcpMapping.HostIP, tcpMapping.HostPort, tcpMapping.ExternalPort, tcpMapping.ExternalPortEnd, tcpMapping.HostTLSPort, tcpMapping.SniHostname, tcpMapping.EnableBackendMTLS).Find(&routes)
 	}
 
 	if err != nil {
@@ -526,84 +893,898 @@ func (s *SqlDB) emitEvent(eventType EventType, obj interface{}) error {
 		s.httpEventHub.Emit(event)
 	case models.TcpRouteMapping:
 		s.tcpEventHub.Emit(event)
+	case models.UdpRouteMapping:
+		s.udpEventHub.Emit(event)
+	case models.PortReservation:
+		s.portReservationEventHub.Emit(event)
+	case models.RouterGroup:
+		s.routerGroupEventHub.Emit(event)
+	case models.TlsCertificate:
+		s.tlsCertificateEventHub.Emit(event)
+	case models.BackendTlsProfile:
+		s.backendTlsProfileEventHub.Emit(event)
 	default:
 		return errors.New("unknown event type")
 	}
 	return nil
 }
 
+// SaveTcpRouteMapping creates the mapping or updates the existing one. When
+// the router group has quotas, the router group row is locked while the new
+// mapping is counted and created, so that concurrent saves cannot exceed them.
 func (s *SqlDB) SaveTcpRouteMapping(tcpRouteMapping models.TcpRouteMapping) error {
-	existingTcpRouteMapping, err := s.FindExistingTcpRouteMapping(tcpRouteMapping)
+	routerGroupDB := models.RouterGroupDB{}
+	err := s.Client.Where("guid = ?", tcpRouteMapping.RouterGroupGuid).First(&routerGroupDB)
+	if err != nil && !recordNotFound(err) {
+		return err
+	}
+	routerGroup := routerGroupDB.ToRouterGroup()
+
+	if !routerGroup.HasTcpRouteQuotas() {
+		tcpMapping, eventType, err := saveTcpRouteMapping(s.Client, tcpRouteMapping, routerGroup)
+		if err != nil {
+			return err
+		}
+		return s.emitEvent(eventType, tcpMapping)
+	}
+
+	tx := s.Client.Begin()
+
+	routerGroup, err = lockRouterGroup(tx, routerGroup.Guid)
+	if err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	tcpMapping, eventType, err := saveTcpRouteMapping(tx, tcpRouteMapping, routerGroup)
+	if err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	err = tx.Commit()
 	if err != nil {
 		return err
 	}
 
+	return s.emitEvent(eventType, tcpMapping)
+}
+
+func saveTcpRouteMapping(tx Client, tcpRouteMapping models.TcpRouteMapping, routerGroup models.RouterGroup) (models.TcpRouteMapping, EventType, error) {
+	existingTcpRouteMapping, err := findExistingTcpRouteMapping(tx, tcpRouteMapping)
+	if err != nil {
+		return models.TcpRouteMapping{}, InvalidEvent, err
+	}
+
 	if existingTcpRouteMapping != (models.TcpRouteMapping{}) {
 		newTcpRouteMapping := updateTcpRouteMapping(existingTcpRouteMapping, tcpRouteMapping)
-		_, err = s.Client.Save(&newTcpRouteMapping)
+		_, err = tx.Save(&newTcpRouteMapping)
 		if err != nil {
-			return err
+			return models.TcpRouteMapping{}, InvalidEvent, err
 		}
-		return s.emitEvent(UpdateEvent, newTcpRouteMapping)
+		return newTcpRouteMapping, UpdateEvent, nil
+	}
+
+	err = checkTcpRouteQuotas(tx, routerGroup, tcpRouteMapping)
+	if err != nil {
+		return models.TcpRouteMapping{}, InvalidEvent, err
 	}
 
 	tcpMapping, err := models.NewTcpRouteMappingWithModel(tcpRouteMapping)
 	if err != nil {
-		return err
+		return models.TcpRouteMapping{}, InvalidEvent, err
 	}
 
 	tag, err := models.NewModificationTag()
 	if err != nil {
-		return err
+		return models.TcpRouteMapping{}, InvalidEvent, err
 	}
 	tcpMapping.ModificationTag = tag
 
-	_, err = s.Client.Create(&tcpMapping)
+	_, err = tx.Create(&tcpMapping)
 	if err != nil {
-		return err
+		return models.TcpRouteMapping{}, InvalidEvent, err
 	}
-
-	return s.emitEvent(CreateEvent, tcpMapping)
+	return tcpMapping, CreateEvent, nil
 }
 
-func (s *SqlDB) DeleteTcpRouteMapping(tcpMapping models.TcpRouteMapping) error {
-	tcpMapping, err := s.FindExistingTcpRouteMapping(tcpMapping)
+// checkTcpRouteQuotas returns a QuotaExceeded error when a new mapping would
+// exceed the quotas of its router group. It must be called inside the
+// transaction that locked the router group.
+func checkTcpRouteQuotas(tx Client, routerGroup models.RouterGroup, tcpRouteMapping models.TcpRouteMapping) error {
+	if !routerGroup.HasTcpRouteQuotas() {
+		return nil
+	}
+
+	var live []models.TcpRouteMapping
+	err := tx.Where("router_group_guid = ?", routerGroup.Guid).Where("expires_at > ?", time.Now()).Find(&live)
 	if err != nil {
 		return err
 	}
-	if tcpMapping == (models.TcpRouteMapping{}) {
-		return DeleteRouteError
+
+	usage := models.NewRouterGroupQuotaUsage(routerGroup, live)
+	err = usage.Add(routerGroup, tcpRouteMapping)
+	if err != nil {
+		return DBError{Type: QuotaExceeded, Message: err.Error()}
 	}
+	return nil
+}
 
-	_, err = s.Client.Delete(&tcpMapping)
+// AllocateTcpRouteMapping creates the mapping on the first external port of
+// its router group's reservable ports that is not used by another mapping in
+// that router group. The router group row is locked for the duration of the
+// transaction so that concurrent allocations never hand out the same port or
+// exceed the quotas of the router group.
+func (s *SqlDB) AllocateTcpRouteMapping(tcpRouteMapping models.TcpRouteMapping) (models.TcpRouteMapping, error) {
+	tx := s.Client.Begin()
+
+	tcpMapping, err := allocateTcpRouteMapping(tx, tcpRouteMapping, s.portPolicy)
 	if err != nil {
-		return err
+		_ = tx.Rollback()
+		return models.TcpRouteMapping{}, err
 	}
-	return s.emitEvent(DeleteEvent, tcpMapping)
+
+	err = tx.Commit()
+	if err != nil {
+		return models.TcpRouteMapping{}, err
+	}
+
+	return tcpMapping, s.emitEvent(CreateEvent, tcpMapping)
 }
 
-func (s *SqlDB) Connect() error {
-	return notImplementedError()
+func allocateTcpRouteMapping(tx Client, tcpRouteMapping models.TcpRouteMapping, policy models.PortPolicy) (models.TcpRouteMapping, error) {
+	guid := tcpRouteMapping.RouterGroupGuid
+
+	routerGroup, err := lockRouterGroup(tx, guid)
+	if err != nil {
+		return models.TcpRouteMapping{}, err
+	}
+
+	usedPorts, err := readUsedPorts(tx, guid)
+	if err != nil {
+		return models.TcpRouteMapping{}, err
+	}
+
+	port, err := nextFreeExternalPort(routerGroup, usedPorts, policy)
+	if err != nil {
+		return models.TcpRouteMapping{}, err
+	}
+
+	err = checkTcpRouteQuotas(tx, routerGroup, tcpRouteMapping)
+	if err != nil {
+		return models.TcpRouteMapping{}, err
+	}
+
+	tcpRouteMapping.ExternalPort = port
+	tcpMapping, err := models.NewTcpRouteMappingWithModel(tcpRouteMapping)
+	if err != nil {
+		return models.TcpRouteMapping{}, err
+	}
+
+	tag, err := models.NewModificationTag()
+	if err != nil {
+		return models.TcpRouteMapping{}, err
+	}
+	tcpMapping.ModificationTag = tag
+
+	_, err = tx.Create(&tcpMapping)
+	if err != nil {
+		return models.TcpRouteMapping{}, err
+	}
+	return tcpMapping, nil
 }
 
-func (s *SqlDB) CancelWatches() {
-	// This only errors if the eventhub was closed.
-	_ = s.tcpEventHub.Close()
-	_ = s.httpEventHub.Close()
+// lockRouterGroup reads the router group inside the transaction after
+// updating its row. The update takes a lock on the row that is held until the
+// transaction ends, which serializes port allocations for the router group.
+func lockRouterGroup(tx Client, guid string) (models.RouterGroup, error) {
+	rowsAffected, err := tx.Model(&models.RouterGroupDB{}).Where("guid = ?", guid).Update("updated_at", time.Now())
+	if err != nil {
+		return models.RouterGroup{}, err
+	}
+	if rowsAffected == 0 {
+		return models.RouterGroup{}, DBError{Type: KeyNotFound, Message: "Router group " + guid + " does not exist"}
+	}
+
+	routerGroupDB := models.RouterGroupDB{}
+	err = tx.Where("guid = ?", guid).First(&routerGroupDB)
+	if err != nil {
+		return models.RouterGroup{}, err
+	}
+	return routerGroupDB.ToRouterGroup(), nil
 }
 
-func (s *SqlDB) WatchChanges(watchType string) (<-chan Event, <-chan error, context.CancelFunc) {
-	var (
-		sub eventhub.Source
-		err error
-	)
-	events := make(chan Event)
-	errors := make(chan error, 1)
-	cancelFunc := func() {}
+// readUsedPorts returns the external ports of a router group that are taken by
+// tcp route mappings or port reservations.
+func readUsedPorts(tx Client, routerGroupGuid string) (map[uint16]bool, error) {
+	now := time.Now()
 
-	switch watchType {
-	case TCP_WATCH:
-		sub, err = s.tcpEventHub.Subscribe()
+	var mappings []models.TcpRouteMapping
+	err := tx.Where("router_group_guid = ?", routerGroupGuid).Where("expires_at > ?", now).Find(&mappings)
+	if err != nil {
+		return nil, err
+	}
+
+	var reservations []models.PortReservation
+	err = tx.Where("router_group_guid = ?", routerGroupGuid).Where("expires_at IS NULL OR expires_at > ?", now).Find(&reservations)
+	if err != nil {
+		return nil, err
+	}
+
+	usedPorts := make(map[uint16]bool)
+	for _, mapping := range mappings {
+		start, end := mapping.ExternalPortRange().Endpoints()
+		for port := uint32(start); port <= uint32(end); port++ {
+			usedPorts[uint16(port)] = true
+		}
+	}
+	for _, reservation := range reservations {
+		usedPorts[reservation.Port] = true
+	}
+	return usedPorts, nil
+}
+
+func nextFreeExternalPort(routerGroup models.RouterGroup, usedPorts map[uint16]bool, policy models.PortPolicy) (uint16, error) {
+	excluded, err := policy.ExcludedPorts(routerGroup)
+	if err != nil {
+		return 0, err
+	}
+
+	if routerGroup.ReservablePorts != "" {
+		ranges, err := routerGroup.ReservablePorts.Parse()
+		if err != nil {
+			return 0, err
+		}
+
+		for _, r := range ranges {
+			start, end := r.Endpoints()
+			for port := uint32(start); port <= uint32(end); port++ {
+				if !usedPorts[uint16(port)] && !excluded.Contains(uint16(port)) {
+					return uint16(port), nil
+				}
+			}
+		}
+	}
+
+	return 0, DBError{Type: PortsExhausted, Message: "There are no free ports in router group " + routerGroup.Name}
+}
+
+func (s *SqlDB) DeleteTcpRouteMapping(tcpMapping models.TcpRouteMapping) error {
+	tcpMapping, err := s.FindExistingTcpRouteMapping(tcpMapping)
+	if err != nil {
+		return err
+	}
+	if tcpMapping == (models.TcpRouteMapping{}) {
+		return DeleteRouteError
+	}
+
+	_, err = s.Client.Delete(&tcpMapping)
+	if err != nil {
+		return err
+	}
+	return s.emitEvent(DeleteEvent, tcpMapping)
+}
+
+func (s *SqlDB) ReadUdpRouteMappings() ([]models.UdpRouteMapping, error) {
+	var udpRoutes []models.UdpRouteMapping
+	now := time.Now()
+	err := s.Client.Where("expires_at > ?", now).Find(&udpRoutes)
+	if err != nil {
+		return nil, err
+	}
+	return udpRoutes, nil
+}
+
+func (s *SqlDB) ReadFilteredUdpRouteMappings(columnName string, values []string) ([]models.UdpRouteMapping, error) {
+	var udpRoutes []models.UdpRouteMapping
+	now := time.Now()
+	err := s.Client.Where(columnName+" in (?)", values).Where("expires_at > ?", now).Find(&udpRoutes)
+	if err != nil {
+		return nil, err
+	}
+	return udpRoutes, nil
+}
+
+func (s *SqlDB) FindExistingUdpRouteMapping(udpMapping models.UdpRouteMapping) (models.UdpRouteMapping, error) {
+	var routes []models.UdpRouteMapping
+	var udpRoute models.UdpRouteMapping
+
+	// this where clause should represent all fields marked with the unique index on the UdpRouteMapping model,
+	// to ensure it returns the correct record from the database
+	err := s.Client.Where("router_group_guid = ? and host_ip = ? and host_port = ? and external_port = ?",
+		udpMapping.RouterGroupGuid, udpMapping.HostIP, udpMapping.HostPort, udpMapping.ExternalPort).Find(&routes)
+	if err != nil {
+		return udpRoute, err
+	}
+	count := len(routes)
+	if count > 1 {
+		return udpRoute, errors.New("have duplicate udp route mappings")
+	}
+	if count == 1 {
+		udpRoute = routes[0]
+	}
+
+	return udpRoute, nil
+}
+
+func (s *SqlDB) SaveUdpRouteMapping(udpRouteMapping models.UdpRouteMapping) error {
+	existingUdpRouteMapping, err := s.FindExistingUdpRouteMapping(udpRouteMapping)
+	if err != nil {
+		return err
+	}
+
+	if existingUdpRouteMapping != (models.UdpRouteMapping{}) {
+		newUdpRouteMapping := updateUdpRouteMapping(existingUdpRouteMapping, udpRouteMapping)
+		_, err = s.Client.Save(&newUdpRouteMapping)
+		if err != nil {
+			return err
+		}
+		return s.emitEvent(UpdateEvent, newUdpRouteMapping)
+	}
+
+	udpMapping, err := models.NewUdpRouteMappingWithModel(udpRouteMapping)
+	if err != nil {
+		return err
+	}
+
+	tag, err := models.NewModificationTag()
+	if err != nil {
+		return err
+	}
+	udpMapping.ModificationTag = tag
+
+	_, err = s.Client.Create(&udpMapping)
+	if err != nil {
+		return err
+	}
+
+	return s.emitEvent(CreateEvent, udpMapping)
+}
+
+func (s *SqlDB) DeleteUdpRouteMapping(udpMapping models.UdpRouteMapping) error {
+	udpMapping, err := s.FindExistingUdpRouteMapping(udpMapping)
+	if err != nil {
+		return err
+	}
+	if udpMapping == (models.UdpRouteMapping{}) {
+		return DeleteRouteError
+	}
+
+	_, err = s.Client.Delete(&udpMapping)
+	if err != nil {
+		return err
+	}
+	return s.emitEvent(DeleteEvent, udpMapping)
+}
+
+func (s *SqlDB) ReadPortReservations() ([]models.PortReservation, error) {
+	var reservations []models.PortReservation
+	err := s.Client.Where("expires_at IS NULL OR expires_at > ?", time.Now()).Find(&reservations)
+	if err != nil {
+		return nil, err
+	}
+	return reservations, nil
+}
+
+func (s *SqlDB) ReadPortReservation(guid string) (models.PortReservation, error) {
+	var reservation models.PortReservation
+	err := s.Client.Where("guid = ?", guid).First(&reservation)
+	if recordNotFound(err) {
+		return models.PortReservation{}, nil
+	}
+	return reservation, err
+}
+
+// SavePortReservation reserves the port of the reservation, or the first free
+// reservable port of the router group when the port is 0. Saving a reservation
+// for a port the owner already holds refreshes its expiry.
+func (s *SqlDB) SavePortReservation(reservation models.PortReservation) (models.PortReservation, error) {
+	if s.locker.isWriteLocked() {
+		return models.PortReservation{}, errors.New(backupError)
+	}
+
+	tx := s.Client.Begin()
+
+	savedReservation, eventType, expiredReservations, err := savePortReservation(tx, reservation, s.portPolicy)
+	if err != nil {
+		_ = tx.Rollback()
+		return models.PortReservation{}, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return models.PortReservation{}, err
+	}
+
+	for _, expired := range expiredReservations {
+		err = s.emitEvent(ExpireEvent, expired)
+		if err != nil {
+			return models.PortReservation{}, err
+		}
+	}
+	return savedReservation, s.emitEvent(eventType, savedReservation)
+}
+
+func savePortReservation(tx Client, reservation models.PortReservation, policy models.PortPolicy) (models.PortReservation, EventType, []models.PortReservation, error) {
+	guid := reservation.RouterGroupGuid
+
+	routerGroup, err := lockRouterGroup(tx, guid)
+	if err != nil {
+		return models.PortReservation{}, InvalidEvent, nil, err
+	}
+
+	if reservation.Port != 0 {
+		var existing []models.PortReservation
+		err = tx.Where("router_group_guid = ? and port = ?", guid, reservation.Port).
+			Where("expires_at IS NULL OR expires_at > ?", time.Now()).
+			Find(&existing)
+		if err != nil {
+			return models.PortReservation{}, InvalidEvent, nil, err
+		}
+
+		if len(existing) > 0 {
+			if existing[0].Owner != reservation.Owner {
+				return models.PortReservation{}, InvalidEvent, nil, DBError{Type: UniqueField, Message: fmt.Sprintf("Port %d of router group %s is reserved by another owner", reservation.Port, routerGroup.Name)}
+			}
+
+			updated := existing[0]
+			updated.TTL = reservation.TTL
+			updated.SetExpiry()
+			updated.ModificationTag.Increment()
+			_, err = tx.Save(&updated)
+			return updated, UpdateEvent, nil, err
+		}
+
+		usedPorts, err := readUsedPorts(tx, guid)
+		if err != nil {
+			return models.PortReservation{}, InvalidEvent, nil, err
+		}
+		if usedPorts[reservation.Port] {
+			return models.PortReservation{}, InvalidEvent, nil, DBError{Type: UniqueField, Message: fmt.Sprintf("Port %d of router group %s is in use by a tcp route", reservation.Port, routerGroup.Name)}
+		}
+	} else {
+		usedPorts, err := readUsedPorts(tx, guid)
+		if err != nil {
+			return models.PortReservation{}, InvalidEvent, nil, err
+		}
+
+		reservation.Port, err = nextFreeExternalPort(routerGroup, usedPorts, policy)
+		if err != nil {
+			return models.PortReservation{}, InvalidEvent, nil, err
+		}
+	}
+
+	newReservation, err := models.NewPortReservationWithModel(reservation)
+	if err != nil {
+		return models.PortReservation{}, InvalidEvent, nil, err
+	}
+
+	// expired reservations are only pruned periodically, so one may still
+	// hold the port
+	var expiredReservations []models.PortReservation
+	err = tx.Where("router_group_guid = ? and port = ?", guid, newReservation.Port).Find(&expiredReservations)
+	if err != nil {
+		return models.PortReservation{}, InvalidEvent, nil, err
+	}
+	if len(expiredReservations) > 0 {
+		_, err = tx.Where("router_group_guid = ? and port = ?", guid, newReservation.Port).Delete(&models.PortReservation{})
+		if err != nil {
+			return models.PortReservation{}, InvalidEvent, nil, err
+		}
+	}
+
+	_, err = tx.Create(&newReservation)
+	return newReservation, CreateEvent, expiredReservations, err
+}
+
+func (s *SqlDB) DeletePortReservation(guid string) error {
+	if s.locker.isWriteLocked() {
+		return errors.New(backupError)
+	}
+
+	reservation, err := s.ReadPortReservation(guid)
+	if err != nil {
+		return err
+	}
+	if reservation.Guid == "" {
+		return DeletePortReservationError
+	}
+
+	_, err = s.Client.Where("guid = ?", guid).Delete(&models.PortReservation{})
+	if err != nil {
+		return err
+	}
+	return s.emitEvent(DeleteEvent, reservation)
+}
+
+// ReadTlsCertificates returns the tls certificates with their decrypted
+// private keys. A certificate whose private key cannot be decrypted, e.g. after
+// the encryption key changed, is returned without it rather than failing the
+// other certificates.
+func (s *SqlDB) ReadTlsCertificates() ([]models.TlsCertificate, error) {
+	if s.encryptor == nil {
+		return nil, ErrNoEncryptionKey
+	}
+
+	var certificates []models.TlsCertificate
+	err := s.Client.Find(&certificates)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range certificates {
+		_ = s.decryptPrivateKey(&certificates[i])
+	}
+	return certificates, nil
+}
+
+// ReadTlsCertificate returns the tls certificate with its decrypted private
+// key, or an empty certificate when it does not exist.
+func (s *SqlDB) ReadTlsCertificate(guid string) (models.TlsCertificate, error) {
+	certificate, err := readTlsCertificate(s.Client, guid)
+	if err != nil || certificate.Guid == "" {
+		return certificate, err
+	}
+
+	err = s.decryptPrivateKey(&certificate)
+	if err != nil {
+		return models.TlsCertificate{}, err
+	}
+	return certificate, nil
+}
+
+func readTlsCertificate(client Client, guid string) (models.TlsCertificate, error) {
+	var certificate models.TlsCertificate
+	err := client.Where("guid = ?", guid).First(&certificate)
+	if recordNotFound(err) {
+		return models.TlsCertificate{}, nil
+	}
+	return certificate, err
+}
+
+func (s *SqlDB) decryptPrivateKey(certificate *models.TlsCertificate) error {
+	if s.encryptor == nil {
+		return ErrNoEncryptionKey
+	}
+
+	privateKey, err := s.encryptor.decrypt(certificate.EncryptedPrivateKey, certificate.Guid)
+	if err != nil {
+		return fmt.Errorf("tls certificate %s: %s", certificate.Guid, err)
+	}
+	certificate.PrivateKey = privateKey
+	return nil
+}
+
+// SaveTlsCertificate stores a new tls certificate when it has no guid, and
+// otherwise rotates the certificate chain and private key of the certificate
+// with the guid. There is one certificate per SNI hostname, and the SNI
+// hostname of a certificate cannot be changed.
+func (s *SqlDB) SaveTlsCertificate(certificate models.TlsCertificate) (models.TlsCertificate, error) {
+	if s.locker.isWriteLocked() {
+		return models.TlsCertificate{}, errors.New(backupError)
+	}
+	if s.encryptor == nil {
+		return models.TlsCertificate{}, ErrNoEncryptionKey
+	}
+
+	var (
+		saved     models.TlsCertificate
+		eventType EventType
+		err       error
+	)
+	if certificate.Guid == "" {
+		saved, err = s.createTlsCertificate(certificate)
+		eventType = CreateEvent
+	} else {
+		saved, err = s.rotateTlsCertificate(certificate)
+		eventType = UpdateEvent
+	}
+	if err != nil {
+		return models.TlsCertificate{}, err
+	}
+	return saved, s.emitEvent(eventType, saved)
+}
+
+func (s *SqlDB) createTlsCertificate(certificate models.TlsCertificate) (models.TlsCertificate, error) {
+	var existing []models.TlsCertificate
+	err := s.Client.Where("sni_hostname = ?", certificate.SniHostname).Find(&existing)
+	if err != nil {
+		return models.TlsCertificate{}, err
+	}
+	if len(existing) > 0 {
+		return models.TlsCertificate{}, DBError{Type: UniqueField, Message: fmt.Sprintf("A tls certificate for sni_hostname %s already exists: %s", certificate.SniHostname, existing[0].Guid)}
+	}
+
+	newCertificate, err := models.NewTlsCertificateWithModel(certificate)
+	if err != nil {
+		return models.TlsCertificate{}, err
+	}
+
+	newCertificate.EncryptedPrivateKey, err = s.encryptor.encrypt(newCertificate.PrivateKey, newCertificate.Guid)
+	if err != nil {
+		return models.TlsCertificate{}, err
+	}
+
+	_, err = s.Client.Create(&newCertificate)
+	return newCertificate, err
+}
+
+func (s *SqlDB) rotateTlsCertificate(certificate models.TlsCertificate) (models.TlsCertificate, error) {
+	// the current private key is not decrypted, so that certificates can be
+	// rotated after the encryption key has changed
+	existing, err := readTlsCertificate(s.Client, certificate.Guid)
+	if err != nil {
+		return models.TlsCertificate{}, err
+	}
+	if existing.Guid == "" {
+		return models.TlsCertificate{}, DBError{Type: KeyNotFound, Message: "Tls certificate " + certificate.Guid + " does not exist"}
+	}
+	if certificate.SniHostname != "" && certificate.SniHostname != existing.SniHostname {
+		return models.TlsCertificate{}, DBError{Type: NonUpdatableField, Message: "The sni_hostname of a tls certificate cannot be changed"}
+	}
+
+	existing.CertChain = certificate.CertChain
+	existing.PrivateKey = certificate.PrivateKey
+	existing.NotAfter = certificate.NotAfter
+	existing.ModificationTag.Increment()
+	existing.EncryptedPrivateKey, err = s.encryptor.encrypt(existing.PrivateKey, existing.Guid)
+	if err != nil {
+		return models.TlsCertificate{}, err
+	}
+
+	_, err = s.Client.Save(&existing)
+	return existing, err
+}
+
+// DeleteTlsCertificate deletes the tls certificate unless live tcp route
+// mappings refer to it.
+func (s *SqlDB) DeleteTlsCertificate(guid string) error {
+	if s.locker.isWriteLocked() {
+		return errors.New(backupError)
+	}
+
+	certificate, err := readTlsCertificate(s.Client, guid)
+	if err != nil {
+		return err
+	}
+	if certificate.Guid == "" {
+		return DeleteTlsCertificateError
+	}
+
+	var mappings []models.TcpRouteMapping
+	err = s.Client.Where("tls_certificate_guid = ?", guid).Where("expires_at > ?", time.Now()).Find(&mappings)
+	if err != nil {
+		return err
+	}
+	if len(mappings) > 0 {
+		return DBError{Type: InUse, Message: fmt.Sprintf("Delete Fails: Tls Certificate is used by %d tcp routes", len(mappings))}
+	}
+
+	_, err = s.Client.Where("guid = ?", guid).Delete(&models.TlsCertificate{})
+	if err != nil {
+		return err
+	}
+	// delete events carry the certificate without its private key
+	return s.emitEvent(DeleteEvent, certificate)
+}
+
+// ReadBackendTlsProfiles returns the backend tls profiles with their decrypted
+// client private keys. A profile whose client private key cannot be
+// decrypted is returned without it rather than failing the other profiles.
+func (s *SqlDB) ReadBackendTlsProfiles() ([]models.BackendTlsProfile, error) {
+	if s.encryptor == nil {
+		return nil, ErrNoEncryptionKey
+	}
+
+	var profiles []models.BackendTlsProfile
+	err := s.Client.Find(&profiles)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range profiles {
+		_ = s.decryptClientPrivateKey(&profiles[i])
+	}
+	return profiles, nil
+}
+
+// ReadBackendTlsProfile returns the backend tls profile with its decrypted
+// client private key, or an empty profile when it does not exist.
+func (s *SqlDB) ReadBackendTlsProfile(guid string) (models.BackendTlsProfile, error) {
+	return s.readDecryptedBackendTlsProfile("guid = ?", guid)
+}
+
+// ReadBackendTlsProfileByName returns the backend tls profile with the name
+// and its decrypted client private key, or an empty profile when it does not
+// exist.
+func (s *SqlDB) ReadBackendTlsProfileByName(name string) (models.BackendTlsProfile, error) {
+	return s.readDecryptedBackendTlsProfile("name = ?", name)
+}
+
+func (s *SqlDB) readDecryptedBackendTlsProfile(query, value string) (models.BackendTlsProfile, error) {
+	profile, err := readBackendTlsProfile(s.Client, query, value)
+	if err != nil || profile.Guid == "" {
+		return profile, err
+	}
+
+	err = s.decryptClientPrivateKey(&profile)
+	if err != nil {
+		return models.BackendTlsProfile{}, err
+	}
+	return profile, nil
+}
+
+func readBackendTlsProfile(client Client, query, value string) (models.BackendTlsProfile, error) {
+	var profile models.BackendTlsProfile
+	err := client.Where(query, value).First(&profile)
+	if recordNotFound(err) {
+		return models.BackendTlsProfile{}, nil
+	}
+	return profile, err
+}
+
+func (s *SqlDB) decryptClientPrivateKey(profile *models.BackendTlsProfile) error {
+	if s.encryptor == nil {
+		return ErrNoEncryptionKey
+	}
+
+	clientPrivateKey, err := s.encryptor.decrypt(profile.EncryptedClientPrivateKey, profile.Guid)
+	if err != nil {
+		return fmt.Errorf("backend tls profile %s: %s", profile.Guid, err)
+	}
+	profile.ClientPrivateKey = clientPrivateKey
+	return nil
+}
+
+// SaveBackendTlsProfile stores a new backend tls profile when it has no guid,
+// and otherwise updates the profile with the guid. Names are unique, and the
+// name of a profile cannot be changed since tcp route mappings refer to it.
+func (s *SqlDB) SaveBackendTlsProfile(profile models.BackendTlsProfile) (models.BackendTlsProfile, error) {
+	if s.locker.isWriteLocked() {
+		return models.BackendTlsProfile{}, errors.New(backupError)
+	}
+	if s.encryptor == nil {
+		return models.BackendTlsProfile{}, ErrNoEncryptionKey
+	}
+
+	var (
+		saved     models.BackendTlsProfile
+		eventType EventType
+		err       error
+	)
+	if profile.Guid == "" {
+		saved, err = s.createBackendTlsProfile(profile)
+		eventType = CreateEvent
+	} else {
+		saved, err = s.updateBackendTlsProfile(profile)
+		eventType = UpdateEvent
+	}
+	if err != nil {
+		return models.BackendTlsProfile{}, err
+	}
+	return saved, s.emitEvent(eventType, saved)
+}
+
+func (s *SqlDB) createBackendTlsProfile(profile models.BackendTlsProfile) (models.BackendTlsProfile, error) {
+	existing, err := readBackendTlsProfile(s.Client, "name = ?", profile.Name)
+	if err != nil {
+		return models.BackendTlsProfile{}, err
+	}
+	if existing.Guid != "" {
+		return models.BackendTlsProfile{}, DBError{Type: UniqueField, Message: fmt.Sprintf("A backend tls profile named %s already exists: %s", profile.Name, existing.Guid)}
+	}
+
+	newProfile, err := models.NewBackendTlsProfileWithModel(profile)
+	if err != nil {
+		return models.BackendTlsProfile{}, err
+	}
+
+	newProfile.EncryptedClientPrivateKey, err = s.encryptor.encrypt(newProfile.ClientPrivateKey, newProfile.Guid)
+	if err != nil {
+		return models.BackendTlsProfile{}, err
+	}
+
+	_, err = s.Client.Create(&newProfile)
+	return newProfile, err
+}
+
+func (s *SqlDB) updateBackendTlsProfile(profile models.BackendTlsProfile) (models.BackendTlsProfile, error) {
+	// the current client private key is not decrypted, so that profiles can
+	// be updated after the encryption key has changed
+	existing, err := readBackendTlsProfile(s.Client, "guid = ?", profile.Guid)
+	if err != nil {
+		return models.BackendTlsProfile{}, err
+	}
+	if existing.Guid == "" {
+		return models.BackendTlsProfile{}, DBError{Type: KeyNotFound, Message: "Backend tls profile " + profile.Guid + " does not exist"}
+	}
+	if profile.Name != "" && profile.Name != existing.Name {
+		return models.BackendTlsProfile{}, DBError{Type: NonUpdatableField, Message: "The name of a backend tls profile cannot be changed"}
+	}
+
+	existing.ClientCertChain = profile.ClientCertChain
+	existing.ClientPrivateKey = profile.ClientPrivateKey
+	existing.TrustedCA = profile.TrustedCA
+	existing.ExpectedSAN = profile.ExpectedSAN
+	existing.IsolationSegment = profile.IsolationSegment
+	existing.NotAfter = profile.NotAfter
+	existing.ModificationTag.Increment()
+	existing.EncryptedClientPrivateKey, err = s.encryptor.encrypt(existing.ClientPrivateKey, existing.Guid)
+	if err != nil {
+		return models.BackendTlsProfile{}, err
+	}
+
+	_, err = s.Client.Save(&existing)
+	return existing, err
+}
+
+// DeleteBackendTlsProfile deletes the backend tls profile unless live tcp
+// route mappings refer to it.
+func (s *SqlDB) DeleteBackendTlsProfile(guid string) error {
+	if s.locker.isWriteLocked() {
+		return errors.New(backupError)
+	}
+
+	profile, err := readBackendTlsProfile(s.Client, "guid = ?", guid)
+	if err != nil {
+		return err
+	}
+	if profile.Guid == "" {
+		return DeleteBackendTlsProfileError
+	}
+
+	var mappings []models.TcpRouteMapping
+	err = s.Client.Where("backend_tls_profile = ?", profile.Name).Where("expires_at > ?", time.Now()).Find(&mappings)
+	if err != nil {
+		return err
+	}
+	if len(mappings) > 0 {
+		return DBError{Type: InUse, Message: fmt.Sprintf("Delete Fails: Backend Tls Profile is used by %d tcp routes", len(mappings))}
+	}
+
+	_, err = s.Client.Where("guid = ?", guid).Delete(&models.BackendTlsProfile{})
+	if err != nil {
+		return err
+	}
+	// delete events carry the profile without its client private key
+	return s.emitEvent(DeleteEvent, profile)
+}
+
+func (s *SqlDB) Connect() error {
+	return notImplementedError()
+}
+
+func (s *SqlDB) CancelWatches() {
+	// This only errors if the eventhub was closed.
+	_ = s.tcpEventHub.Close()
+	_ = s.udpEventHub.Close()
+	_ = s.httpEventHub.Close()
+	_ = s.portReservationEventHub.Close()
+	_ = s.routerGroupEventHub.Close()
+	_ = s.tlsCertificateEventHub.Close()
+	_ = s.backendTlsProfileEventHub.Close()
+}
+
+func (s *SqlDB) WatchChanges(watchType string) (<-chan Event, <-chan error, context.CancelFunc) {
+	var (
+		sub eventhub.Source
+		err error
+	)
+	events := make(chan Event)
+	errors := make(chan error, 1)
+	cancelFunc := func() {}
+
+	switch watchType {
+	case TCP_WATCH:
+		sub, err = s.tcpEventHub.Subscribe()
+		if err != nil {
+			errors <- err
+			close(events)
+			close(errors)
+			return events, errors, cancelFunc
+		}
+	case UDP_WATCH:
+		sub, err = s.udpEventHub.Subscribe()
 		if err != nil {
 			errors <- err
 			close(events)
@@ -618,6 +1799,38 @@ func (s *SqlDB) WatchChanges(watchType string) (<-chan Event, <-chan error, cont
 			close(errors)
 			return events, errors, cancelFunc
 		}
+	case PORT_RESERVATION_WATCH:
+		sub, err = s.portReservationEventHub.Subscribe()
+		if err != nil {
+			errors <- err
+			close(events)
+			close(errors)
+			return events, errors, cancelFunc
+		}
+	case ROUTER_GROUP_WATCH:
+		sub, err = s.routerGroupEventHub.Subscribe()
+		if err != nil {
+			errors <- err
+			close(events)
+			close(errors)
+			return events, errors, cancelFunc
+		}
+	case TLS_CERTIFICATE_WATCH:
+		sub, err = s.tlsCertificateEventHub.Subscribe()
+		if err != nil {
+			errors <- err
+			close(events)
+			close(errors)
+			return events, errors, cancelFunc
+		}
+	case BACKEND_TLS_PROFILE_WATCH:
+		sub, err = s.backendTlsProfileEventHub.Subscribe()
+		if err != nil {
+			errors <- err
+			close(events)
+			close(errors)
+			return events, errors, cancelFunc
+		}
 	default:
 		err := fmt.Errorf("invalid watch type: %s", watchType)
 		errors <- err
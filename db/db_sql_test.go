@@ -18,6 +18,7 @@ import (
 	"code.cloudfoundry.org/routing-api/matchers"
 	"code.cloudfoundry.org/routing-api/migration"
 	"code.cloudfoundry.org/routing-api/models"
+	"code.cloudfoundry.org/routing-api/test_helpers"
 	uuid "github.com/nu7hatch/gouuid"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -604,6 +605,80 @@ var _ = Describe("SqlDB", func() {
 					Expect(rg.ReservablePorts).To(Equal(routerGroup.ReservablePorts))
 					Expect(rg.Type).To(Equal(routerGroup.Type))
 				})
+
+				It("updates and clears the description", func() {
+					routerGroup.Description = "routers in zone a"
+					err = sqlDB.SaveRouterGroup(routerGroup)
+					Expect(err).ToNot(HaveOccurred())
+					rg, err := sqlDB.ReadRouterGroup(routerGroup.Guid)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(rg.Description).To(Equal("routers in zone a"))
+
+					routerGroup.Description = ""
+					err = sqlDB.SaveRouterGroup(routerGroup)
+					Expect(err).ToNot(HaveOccurred())
+					rg, err = sqlDB.ReadRouterGroup(routerGroup.Guid)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(rg.Description).To(BeEmpty())
+				})
+
+				It("updates the ttl policy", func() {
+					routerGroup.MinTTL = 10
+					routerGroup.MaxTTL = 300
+					routerGroup.DefaultTTL = 60
+					err = sqlDB.SaveRouterGroup(routerGroup)
+					Expect(err).ToNot(HaveOccurred())
+					rg, err := sqlDB.ReadRouterGroup(routerGroup.Guid)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(rg.MinTTL).To(Equal(10))
+					Expect(rg.MaxTTL).To(Equal(300))
+					Expect(rg.DefaultTTL).To(Equal(60))
+				})
+
+				It("updates the quotas", func() {
+					routerGroup.MaxTcpRoutes = 100
+					routerGroup.MaxTcpRoutesPerIsolationSegment = 10
+					err = sqlDB.SaveRouterGroup(routerGroup)
+					Expect(err).ToNot(HaveOccurred())
+					rg, err := sqlDB.ReadRouterGroup(routerGroup.Guid)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(rg.MaxTcpRoutes).To(Equal(100))
+					Expect(rg.MaxTcpRoutesPerIsolationSegment).To(Equal(10))
+				})
+
+				It("updates the excluded ports", func() {
+					routerGroup.ExcludedPorts = "1500-1510"
+					err = sqlDB.SaveRouterGroup(routerGroup)
+					Expect(err).ToNot(HaveOccurred())
+					rg, err := sqlDB.ReadRouterGroup(routerGroup.Guid)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(rg.ExcludedPorts).To(Equal(models.ReservablePorts("1500-1510")))
+				})
+
+				It("updates the backend networks", func() {
+					routerGroup.AllowedBackendCIDRs = "10.0.0.0/8"
+					routerGroup.DeniedBackendCIDRs = "10.255.0.0/16"
+					err = sqlDB.SaveRouterGroup(routerGroup)
+					Expect(err).ToNot(HaveOccurred())
+					rg, err := sqlDB.ReadRouterGroup(routerGroup.Guid)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(rg.AllowedBackendCIDRs).To(Equal(models.CIDRs("10.0.0.0/8")))
+					Expect(rg.DeniedBackendCIDRs).To(Equal(models.CIDRs("10.255.0.0/16")))
+				})
+
+				It("emits an update event", func() {
+					results, _, cancel := sqlDB.WatchChanges(db.ROUTER_GROUP_WATCH)
+					defer cancel()
+
+					err = sqlDB.SaveRouterGroup(routerGroup)
+					Expect(err).ToNot(HaveOccurred())
+
+					var event db.Event
+					Eventually(results).Should(Receive(&event))
+					Expect(event.Type).To(Equal(db.UpdateEvent))
+					Expect(event.Value).To(ContainSubstring(routerGroup.Guid))
+					Expect(event.Value).To(ContainSubstring(routerGroup.Name))
+				})
 			})
 
 			It("Can remove ReservablePorts", func() {
@@ -640,6 +715,19 @@ var _ = Describe("SqlDB", func() {
 					Expect(rg.ReservablePorts).To(Equal(routerGroup.ReservablePorts))
 					Expect(rg.Type).To(Equal(routerGroup.Type))
 				})
+
+				It("emits a create event", func() {
+					results, _, cancel := sqlDB.WatchChanges(db.ROUTER_GROUP_WATCH)
+					defer cancel()
+
+					err = sqlDB.SaveRouterGroup(routerGroup)
+					Expect(err).ToNot(HaveOccurred())
+
+					var event db.Event
+					Eventually(results).Should(Receive(&event))
+					Expect(event.Type).To(Equal(db.CreateEvent))
+					Expect(event.Value).To(ContainSubstring(routerGroup.Guid))
+				})
 			})
 		})
 	}
@@ -685,6 +773,30 @@ var _ = Describe("SqlDB", func() {
 					Expect(routerGroups).To(BeEmpty())
 				})
 
+				Context("when router group changes are watched", func() {
+					var (
+						results <-chan db.Event
+						cancel  func()
+					)
+
+					BeforeEach(func() {
+						results, _, cancel = sqlDB.WatchChanges(db.ROUTER_GROUP_WATCH)
+					})
+
+					AfterEach(func() {
+						cancel()
+					})
+
+					It("emits a delete event", func() {
+						Expect(err).ToNot(HaveOccurred())
+
+						var event db.Event
+						Eventually(results).Should(Receive(&event))
+						Expect(event.Type).To(Equal(db.DeleteEvent))
+						Expect(event.Value).To(ContainSubstring(routerGroup.Guid))
+					})
+				})
+
 				Context("when multiple router groups exist", func() {
 					var (
 						routerGroup2   models.RouterGroup
@@ -730,6 +842,192 @@ var _ = Describe("SqlDB", func() {
 					Expect(dberr.Type).To(Equal(db.KeyNotFound))
 				})
 			})
+
+			Context("when the router group has tcp routes or port reservations", func() {
+				BeforeEach(func() {
+					_, err = sqlDB.Client.Create(&routerGroupDB)
+					Expect(err).ToNot(HaveOccurred())
+
+					mapping := models.NewTcpRouteMapping(routerGroup.Guid, 2000, "127.0.0.1", 2990, 0, "instance-id", nil, nil, 5, models.ModificationTag{}, false, "")
+					Expect(sqlDB.SaveTcpRouteMapping(mapping)).To(Succeed())
+					_, err = sqlDB.SavePortReservation(models.NewPortReservation(routerGroup.Guid, 2001, "some-owner", nil))
+					Expect(err).ToNot(HaveOccurred())
+				})
+
+				AfterEach(func() {
+					_, err = sqlDB.Client.Where("router_group_guid = ?", routerGroup.Guid).Delete(&models.TcpRouteMapping{})
+					Expect(err).ToNot(HaveOccurred())
+					_, err = sqlDB.Client.Where("router_group_guid = ?", routerGroup.Guid).Delete(&models.PortReservation{})
+					Expect(err).ToNot(HaveOccurred())
+					_, err = sqlDB.Client.Where("guid = ?", routerGroup.Guid).Delete(&models.RouterGroupDB{})
+					Expect(err).ToNot(HaveOccurred())
+				})
+
+				It("returns an in use error and deletes nothing", func() {
+					Expect(err).To(HaveOccurred())
+					dberr, ok := err.(db.DBError)
+					Expect(ok).To(BeTrue())
+					Expect(dberr.Type).To(Equal(db.InUse))
+					Expect(dberr.Message).To(ContainSubstring("0 http routes, 1 tcp routes, 0 udp routes and 1 port reservations"))
+
+					rg, err := sqlDB.ReadRouterGroup(routerGroup.Guid)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(rg.Guid).To(Equal(routerGroup.Guid))
+					tcpRoutes, err := sqlDB.ReadTcpRouteMappings()
+					Expect(err).ToNot(HaveOccurred())
+					Expect(tcpRoutes).To(HaveLen(1))
+				})
+			})
+
+			Context("when the router group has udp routes", func() {
+				BeforeEach(func() {
+					_, err = sqlDB.Client.Create(&routerGroupDB)
+					Expect(err).ToNot(HaveOccurred())
+
+					mapping := models.NewUdpRouteMapping(routerGroup.Guid, 2000, "127.0.0.1", 53, "instance-id", 5, models.ModificationTag{})
+					Expect(sqlDB.SaveUdpRouteMapping(mapping)).To(Succeed())
+				})
+
+				AfterEach(func() {
+					_, err = sqlDB.Client.Where("router_group_guid = ?", routerGroup.Guid).Delete(&models.UdpRouteMapping{})
+					Expect(err).ToNot(HaveOccurred())
+					_, err = sqlDB.Client.Where("guid = ?", routerGroup.Guid).Delete(&models.RouterGroupDB{})
+					Expect(err).ToNot(HaveOccurred())
+				})
+
+				It("returns an in use error and deletes nothing", func() {
+					Expect(err).To(HaveOccurred())
+					dberr, ok := err.(db.DBError)
+					Expect(ok).To(BeTrue())
+					Expect(dberr.Type).To(Equal(db.InUse))
+					Expect(dberr.Message).To(ContainSubstring("0 http routes, 0 tcp routes, 1 udp routes and 0 port reservations"))
+
+					udpRoutes, err := sqlDB.ReadUdpRouteMappings()
+					Expect(err).ToNot(HaveOccurred())
+					Expect(udpRoutes).To(HaveLen(1))
+				})
+			})
+
+			Context("when the router group has http routes", func() {
+				BeforeEach(func() {
+					_, err = sqlDB.Client.Create(&routerGroupDB)
+					Expect(err).ToNot(HaveOccurred())
+
+					route := models.NewRoute("rg.example.com", 8080, "127.0.0.1", "", "", 5)
+					route.RouterGroupGuid = routerGroup.Guid
+					Expect(sqlDB.SaveRoute(route)).To(Succeed())
+				})
+
+				AfterEach(func() {
+					_, err = sqlDB.Client.Where("router_group_guid = ?", routerGroup.Guid).Delete(&models.Route{})
+					Expect(err).ToNot(HaveOccurred())
+					_, err = sqlDB.Client.Where("guid = ?", routerGroup.Guid).Delete(&models.RouterGroupDB{})
+					Expect(err).ToNot(HaveOccurred())
+				})
+
+				It("returns an in use error and deletes nothing", func() {
+					Expect(err).To(HaveOccurred())
+					dberr, ok := err.(db.DBError)
+					Expect(ok).To(BeTrue())
+					Expect(dberr.Type).To(Equal(db.InUse))
+					Expect(dberr.Message).To(ContainSubstring("1 http routes, 0 tcp routes, 0 udp routes and 0 port reservations"))
+
+					routes, err := sqlDB.ReadRoutes()
+					Expect(err).ToNot(HaveOccurred())
+					Expect(routes).To(HaveLen(1))
+				})
+			})
+		})
+	}
+
+	DeleteRouterGroupCascade := func() {
+		Describe("DeleteRouterGroupCascade", func() {
+			var (
+				err           error
+				routerGroupId string
+			)
+
+			BeforeEach(func() {
+				routerGroupId = newUuid()
+				_, err = sqlDB.Client.Create(&models.RouterGroupDB{
+					Model:           models.Model{Guid: routerGroupId},
+					Name:            "rg-cascade",
+					Type:            "tcp",
+					ReservablePorts: "2000-3000",
+				})
+				Expect(err).ToNot(HaveOccurred())
+
+				mapping := models.NewTcpRouteMapping(routerGroupId, 2000, "127.0.0.1", 2990, 0, "instance-id", nil, nil, 5, models.ModificationTag{}, false, "")
+				Expect(sqlDB.SaveTcpRouteMapping(mapping)).To(Succeed())
+				udpMapping := models.NewUdpRouteMapping(routerGroupId, 2002, "127.0.0.1", 53, "instance-id", 5, models.ModificationTag{})
+				Expect(sqlDB.SaveUdpRouteMapping(udpMapping)).To(Succeed())
+				_, err = sqlDB.SavePortReservation(models.NewPortReservation(routerGroupId, 2001, "some-owner", nil))
+				Expect(err).ToNot(HaveOccurred())
+				route := models.NewRoute("cascade.example.com", 8080, "127.0.0.1", "", "", 5)
+				route.RouterGroupGuid = routerGroupId
+				Expect(sqlDB.SaveRoute(route)).To(Succeed())
+			})
+
+			It("deletes the router group with its routes and port reservations", func() {
+				tcpResults, _, cancelTcp := sqlDB.WatchChanges(db.TCP_WATCH)
+				defer cancelTcp()
+				udpResults, _, cancelUdp := sqlDB.WatchChanges(db.UDP_WATCH)
+				defer cancelUdp()
+				reservationResults, _, cancelReservations := sqlDB.WatchChanges(db.PORT_RESERVATION_WATCH)
+				defer cancelReservations()
+				httpResults, _, cancelHttp := sqlDB.WatchChanges(db.HTTP_WATCH)
+				defer cancelHttp()
+
+				dependents, err := sqlDB.DeleteRouterGroupCascade(routerGroupId)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(dependents.Routes).To(HaveLen(1))
+				Expect(dependents.TcpRouteMappings).To(HaveLen(1))
+				Expect(dependents.UdpRouteMappings).To(HaveLen(1))
+				Expect(dependents.PortReservations).To(HaveLen(1))
+
+				rg, err := sqlDB.ReadRouterGroup(routerGroupId)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(rg).To(Equal(models.RouterGroup{}))
+
+				var remainingMappings []models.TcpRouteMapping
+				err = sqlDB.Client.Where("router_group_guid = ?", routerGroupId).Find(&remainingMappings)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(remainingMappings).To(BeEmpty())
+
+				var remainingUdpMappings []models.UdpRouteMapping
+				err = sqlDB.Client.Where("router_group_guid = ?", routerGroupId).Find(&remainingUdpMappings)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(remainingUdpMappings).To(BeEmpty())
+
+				var remainingReservations []models.PortReservation
+				err = sqlDB.Client.Where("router_group_guid = ?", routerGroupId).Find(&remainingReservations)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(remainingReservations).To(BeEmpty())
+
+				var remainingRoutes []models.Route
+				err = sqlDB.Client.Where("router_group_guid = ?", routerGroupId).Find(&remainingRoutes)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(remainingRoutes).To(BeEmpty())
+
+				var event db.Event
+				Eventually(tcpResults).Should(Receive(&event))
+				Expect(event.Type).To(Equal(db.DeleteEvent))
+				Expect(event.Value).To(ContainSubstring(`"port":2000`))
+				Eventually(udpResults).Should(Receive(&event))
+				Expect(event.Type).To(Equal(db.DeleteEvent))
+				Expect(event.Value).To(ContainSubstring(`"port":2002`))
+				Eventually(reservationResults).Should(Receive(&event))
+				Expect(event.Type).To(Equal(db.DeleteEvent))
+				Expect(event.Value).To(ContainSubstring(`"port":2001`))
+				Eventually(httpResults).Should(Receive(&event))
+				Expect(event.Type).To(Equal(db.DeleteEvent))
+				Expect(event.Value).To(ContainSubstring(`"route":"cascade.example.com"`))
+			})
+
+			It("returns a key not found error when the router group does not exist", func() {
+				_, err := sqlDB.DeleteRouterGroupCascade(newUuid())
+				Expect(err).To(MatchError(db.DeleteRouterGroupError))
+			})
 		})
 	}
 
@@ -797,6 +1095,93 @@ var _ = Describe("SqlDB", func() {
 				})
 			})
 		})
+		Describe("Health Check", func() {
+			var (
+				routerGroupId string
+				tcpRoute      models.TcpRouteMapping
+			)
+
+			BeforeEach(func() {
+				routerGroupId = newUuid()
+				tcpRoute = models.NewTcpRouteMapping(routerGroupId, 3057, "127.0.0.3", 2990, 0, "instance-1", nil, nil, 5, models.ModificationTag{}, false, "")
+				tcpRoute.HealthCheck = &models.TcpHealthCheck{
+					Type:     models.TcpHealthCheckHTTP,
+					Interval: 10,
+					Path:     "/health",
+				}
+				err := sqlDB.SaveTcpRouteMapping(tcpRoute)
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			AfterEach(func() {
+				_, err := sqlDB.Client.Where("router_group_guid = ?", routerGroupId).Delete(&models.TcpRouteMapping{})
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("stores and retrieves the health check", func() {
+				dbTcpRoute := getFirstTCPRouteMapping(sqlDB, "127.0.0.3")
+				Expect(dbTcpRoute.HealthCheck).To(Equal(tcpRoute.HealthCheck))
+			})
+
+			It("replaces the health check when the route is refreshed", func() {
+				tcpRoute.HealthCheck = &models.TcpHealthCheck{Type: models.TcpHealthCheckTCPConnect}
+				err := sqlDB.SaveTcpRouteMapping(tcpRoute)
+				Expect(err).ToNot(HaveOccurred())
+
+				dbTcpRoute := getFirstTCPRouteMapping(sqlDB, "127.0.0.3")
+				Expect(dbTcpRoute.HealthCheck).To(Equal(&models.TcpHealthCheck{Type: models.TcpHealthCheckTCPConnect}))
+			})
+
+			It("removes the health check when the route is refreshed without one", func() {
+				tcpRoute.HealthCheck = nil
+				err := sqlDB.SaveTcpRouteMapping(tcpRoute)
+				Expect(err).ToNot(HaveOccurred())
+
+				dbTcpRoute := getFirstTCPRouteMapping(sqlDB, "127.0.0.3")
+				Expect(dbTcpRoute.HealthCheck).To(BeNil())
+			})
+		})
+		Describe("Proxy Protocol and Connection Limits", func() {
+			var routerGroupId string
+
+			BeforeEach(func() {
+				routerGroupId = newUuid()
+			})
+
+			AfterEach(func() {
+				_, err := sqlDB.Client.Where("router_group_guid = ?", routerGroupId).Delete(&models.TcpRouteMapping{})
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("updates the proxy protocol when the route is refreshed", func() {
+				tcpRoute := models.NewTcpRouteMapping(routerGroupId, 3058, "127.0.0.4", 2990, 0, "instance-1", nil, nil, 5, models.ModificationTag{}, false, "")
+				tcpRoute.ProxyProtocol = models.ProxyProtocolV1
+				err := sqlDB.SaveTcpRouteMapping(tcpRoute)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(getFirstTCPRouteMapping(sqlDB, "127.0.0.4").ProxyProtocol).To(Equal(models.ProxyProtocolV1))
+
+				tcpRoute.ProxyProtocol = models.ProxyProtocolV2
+				err = sqlDB.SaveTcpRouteMapping(tcpRoute)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(getFirstTCPRouteMapping(sqlDB, "127.0.0.4").ProxyProtocol).To(Equal(models.ProxyProtocolV2))
+			})
+
+			It("updates the connection limits when the route is refreshed", func() {
+				tcpRoute := models.NewTcpRouteMapping(routerGroupId, 3058, "127.0.0.4", 2990, 0, "instance-1", nil, nil, 5, models.ModificationTag{}, false, "")
+				tcpRoute.MaxConnections = 100
+				err := sqlDB.SaveTcpRouteMapping(tcpRoute)
+				Expect(err).ToNot(HaveOccurred())
+
+				tcpRoute.MaxConnections = 50
+				tcpRoute.IdleTimeout = 300
+				err = sqlDB.SaveTcpRouteMapping(tcpRoute)
+				Expect(err).ToNot(HaveOccurred())
+
+				dbTcpRoute := getFirstTCPRouteMapping(sqlDB, "127.0.0.4")
+				Expect(dbTcpRoute.MaxConnections).To(Equal(50))
+				Expect(dbTcpRoute.IdleTimeout).To(Equal(300))
+			})
+		})
 		Describe("SNI Rewrite Hostname", func() {
 			var (
 				sniRewriteHostname string
@@ -985,129 +1370,966 @@ var _ = Describe("SqlDB", func() {
 								Fail("unexpected unique field found on models.TcpRouteMapping. Please update this test to account for it")
 							}
 
-							newRoute, err := sqlDB.FindExistingTcpRouteMapping(routeToTest)
-							Expect(err).NotTo(HaveOccurred())
-							Expect(newRoute).To(Equal(models.TcpRouteMapping{}))
-						}
-					})
+							newRoute, err := sqlDB.FindExistingTcpRouteMapping(routeToTest)
+							Expect(err).NotTo(HaveOccurred())
+							Expect(newRoute).To(Equal(models.TcpRouteMapping{}))
+						}
+					})
+				})
+			})
+		})
+	}
+
+	SaveTcpRouteMapping := func() {
+		Describe("SaveTcpRouteMapping", func() {
+			var (
+				routerGroupId string
+				err           error
+				tcpRoute      models.TcpRouteMapping
+			)
+
+			BeforeEach(func() {
+				routerGroupId = newUuid()
+				tcpRoute = models.NewTcpRouteMapping(routerGroupId, 3056, "127.0.0.1", 2990, 2991, "instance-id", nil, nil, 5, models.ModificationTag{}, true, "alpn1.alpn2")
+			})
+
+			AfterEach(func() {
+				_, err = sqlDB.Client.Where("guid = ?", tcpRoute.Guid).Delete(&models.TcpRouteMapping{})
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			Context("when tcp route exists", func() {
+				BeforeEach(func() {
+					err = sqlDB.SaveTcpRouteMapping(tcpRoute)
+					Expect(err).ToNot(HaveOccurred())
+				})
+
+				It("updates the existing tcp route mapping and increments modification tag", func() {
+					tcpRoute.IsolationSegment = "some-iso-seg"
+					myTTL := 77
+					tcpRoute.TTL = &myTTL
+
+					err := sqlDB.SaveTcpRouteMapping(tcpRoute)
+					Expect(err).ToNot(HaveOccurred())
+
+					dbTcpRoute := getFirstTCPRouteMapping(sqlDB, "127.0.0.1")
+					Expect(dbTcpRoute.IsolationSegment).To(Equal("some-iso-seg"))
+					Expect(dbTcpRoute.TTL).To(Equal(&myTTL))
+					Expect(dbTcpRoute.ModificationTag.Index).To(BeNumerically("==", 1))
+				})
+
+				It("refreshes the expiration time of the mapping", func() {
+					dbTcpRoute := getFirstTCPRouteMapping(sqlDB, "127.0.0.1")
+					ttl := 9
+					initialExpiration := dbTcpRoute.ExpiresAt
+
+					tcpRoute.TTL = &ttl
+					err := sqlDB.SaveTcpRouteMapping(tcpRoute)
+					Expect(err).ToNot(HaveOccurred())
+
+					dbTcpRoute = getFirstTCPRouteMapping(sqlDB, "127.0.0.1")
+					Expect(initialExpiration).To(BeTemporally("<", dbTcpRoute.ExpiresAt))
+				})
+
+				Context("and router group is changed", func() {
+					var (
+						routerGroupId2 string
+						tcpRoute2      models.TcpRouteMapping
+					)
+					BeforeEach(func() {
+						routerGroupId2 = newUuid()
+						tcpRoute2 = models.NewTcpRouteMapping(routerGroupId2, 3056, "127.0.0.1", 2990, 2991, "", nil, nil, 5, models.ModificationTag{}, true, "alpn1,alpn2")
+					})
+
+					AfterEach(func() {
+						if tcpRoute2.Guid != "" {
+							_, err = sqlDB.Client.Where("guid = ?", tcpRoute2.Guid).Delete(&models.TcpRouteMapping{})
+							Expect(err).ToNot(HaveOccurred())
+						}
+					})
+
+					It("creates another tcp route", func() {
+						err = sqlDB.SaveTcpRouteMapping(tcpRoute2)
+						Expect(err).ToNot(HaveOccurred())
+						var dbTcpRoutes []models.TcpRouteMapping
+						err = sqlDB.Client.Where("host_ip = ?", "127.0.0.1").Find(&dbTcpRoutes)
+						Expect(err).ToNot(HaveOccurred())
+						Expect(dbTcpRoutes).To(HaveLen(2))
+						Expect(dbTcpRoutes).To(ConsistOf(
+							matchers.MatchTcpRoute(tcpRoute),
+							matchers.MatchTcpRoute(tcpRoute2),
+						))
+					})
+				})
+
+				Context("and the update is a no-op", func() {
+					It("updates the expiry using the previous TTL", func() {
+						dbTcpRoute := getFirstTCPRouteMapping(sqlDB, "127.0.0.1")
+						initialExpiration := dbTcpRoute.ExpiresAt
+						time.Sleep(1 * time.Second)
+
+						err := sqlDB.SaveTcpRouteMapping(tcpRoute)
+						Expect(err).ToNot(HaveOccurred())
+
+						dbTcpRoute = getFirstTCPRouteMapping(sqlDB, "127.0.0.1")
+						Expect(initialExpiration).To(BeTemporally("<", dbTcpRoute.ExpiresAt))
+					})
+				})
+			})
+
+			Context("when the tcp route doesn't exist", func() {
+				It("creates a modification tag", func() {
+					err := sqlDB.SaveTcpRouteMapping(tcpRoute)
+					Expect(err).ToNot(HaveOccurred())
+
+					dbTcpRoute := getFirstTCPRouteMapping(sqlDB, "127.0.0.1")
+					Expect(dbTcpRoute.ModificationTag.Guid).ToNot(BeEmpty())
+					Expect(dbTcpRoute.ModificationTag.Index).To(BeZero())
+				})
+
+				It("creates a tcp route", func() {
+					err := sqlDB.SaveTcpRouteMapping(tcpRoute)
+					Expect(err).ToNot(HaveOccurred())
+
+					dbTcpRoute := getFirstTCPRouteMapping(sqlDB, "127.0.0.1")
+					Expect(dbTcpRoute).To(matchers.MatchTcpRoute(tcpRoute))
+				})
+			})
+		})
+	}
+
+	AllocateTcpRouteMapping := func() {
+		Describe("AllocateTcpRouteMapping", func() {
+			var (
+				routerGroupId string
+				err           error
+				tcpRoute      models.TcpRouteMapping
+			)
+
+			BeforeEach(func() {
+				routerGroupId = newUuid()
+				_, err = sqlDB.Client.Create(&models.RouterGroupDB{
+					Model:           models.Model{Guid: routerGroupId},
+					Name:            "rg-allocate",
+					Type:            "tcp",
+					ReservablePorts: "65000-65001",
+				})
+				Expect(err).ToNot(HaveOccurred())
+
+				tcpRoute = models.NewTcpRouteMapping(routerGroupId, 0, "127.0.0.1", 2990, 0, "instance-id", nil, nil, 5, models.ModificationTag{}, false, "")
+			})
+
+			AfterEach(func() {
+				_, err = sqlDB.Client.Where("router_group_guid = ?", routerGroupId).Delete(&models.TcpRouteMapping{})
+				Expect(err).ToNot(HaveOccurred())
+				_, err = sqlDB.Client.Where("router_group_guid = ?", routerGroupId).Delete(&models.PortReservation{})
+				Expect(err).ToNot(HaveOccurred())
+				_, err = sqlDB.Client.Where("guid = ?", routerGroupId).Delete(&models.RouterGroupDB{})
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("creates the mapping on the first free reservable port", func() {
+				allocated, err := sqlDB.AllocateTcpRouteMapping(tcpRoute)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(allocated.ExternalPort).To(Equal(uint16(65000)))
+				Expect(allocated.ModificationTag.Guid).ToNot(BeEmpty())
+
+				dbTcpRoute := getFirstTCPRouteMapping(sqlDB, "127.0.0.1")
+				Expect(dbTcpRoute.ExternalPort).To(Equal(uint16(65000)))
+			})
+
+			It("skips ports used by other mappings in the router group", func() {
+				existing := models.NewTcpRouteMapping(routerGroupId, 65000, "127.0.0.2", 2990, 0, "instance-id", nil, nil, 5, models.ModificationTag{}, false, "")
+				err := sqlDB.SaveTcpRouteMapping(existing)
+				Expect(err).ToNot(HaveOccurred())
+
+				allocated, err := sqlDB.AllocateTcpRouteMapping(tcpRoute)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(allocated.ExternalPort).To(Equal(uint16(65001)))
+			})
+
+			It("skips every port of port range mappings in the router group", func() {
+				existing := models.NewTcpRouteMapping(routerGroupId, 65000, "127.0.0.2", 2990, 0, "instance-id", nil, nil, 5, models.ModificationTag{}, false, "")
+				existing.ExternalPortEnd = 65001
+				err := sqlDB.SaveTcpRouteMapping(existing)
+				Expect(err).ToNot(HaveOccurred())
+
+				_, err = sqlDB.AllocateTcpRouteMapping(tcpRoute)
+				Expect(err).To(HaveOccurred())
+				dberr, ok := err.(db.DBError)
+				Expect(ok).To(BeTrue())
+				Expect(dberr.Type).To(Equal(db.PortsExhausted))
+			})
+
+			It("skips ports held by port reservations in the router group", func() {
+				_, err := sqlDB.SavePortReservation(models.NewPortReservation(routerGroupId, 65000, "some-owner", nil))
+				Expect(err).ToNot(HaveOccurred())
+
+				allocated, err := sqlDB.AllocateTcpRouteMapping(tcpRoute)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(allocated.ExternalPort).To(Equal(uint16(65001)))
+			})
+
+			It("skips the reserved system component ports of the port policy", func() {
+				sqlDB.SetPortPolicy(models.PortPolicy{SystemComponentPorts: []uint16{65000}})
+
+				allocated, err := sqlDB.AllocateTcpRouteMapping(tcpRoute)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(allocated.ExternalPort).To(Equal(uint16(65001)))
+			})
+
+			It("skips the excluded ports of the router group", func() {
+				routerGroup, err := sqlDB.ReadRouterGroup(routerGroupId)
+				Expect(err).ToNot(HaveOccurred())
+				routerGroup.ExcludedPorts = "65000"
+				Expect(sqlDB.SaveRouterGroup(routerGroup)).To(Succeed())
+
+				allocated, err := sqlDB.AllocateTcpRouteMapping(tcpRoute)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(allocated.ExternalPort).To(Equal(uint16(65001)))
+			})
+
+			It("never allocates the same port twice when called concurrently", func() {
+				ports := make(chan uint16, 2)
+				errs := make(chan error, 2)
+				for i := 0; i < 2; i++ {
+					go func(hostIP string) {
+						defer GinkgoRecover()
+						mapping := tcpRoute
+						mapping.HostIP = hostIP
+						allocated, err := sqlDB.AllocateTcpRouteMapping(mapping)
+						errs <- err
+						ports <- allocated.ExternalPort
+					}(fmt.Sprintf("127.0.0.%d", i+1))
+				}
+
+				Eventually(errs).Should(Receive(BeNil()))
+				Eventually(errs).Should(Receive(BeNil()))
+				var port1, port2 uint16
+				Eventually(ports).Should(Receive(&port1))
+				Eventually(ports).Should(Receive(&port2))
+				Expect([]uint16{port1, port2}).To(ConsistOf(uint16(65000), uint16(65001)))
+			})
+
+			It("emits a create event", func() {
+				results, _, cancel := sqlDB.WatchChanges(db.TCP_WATCH)
+				defer cancel()
+
+				_, err := sqlDB.AllocateTcpRouteMapping(tcpRoute)
+				Expect(err).ToNot(HaveOccurred())
+
+				var event db.Event
+				Eventually(results).Should(Receive(&event))
+				Expect(event.Type).To(Equal(db.CreateEvent))
+				Expect(event.Value).To(ContainSubstring(`"port":65000`))
+			})
+
+			Context("when all reservable ports are used", func() {
+				BeforeEach(func() {
+					for i, port := range []uint16{65000, 65001} {
+						existing := models.NewTcpRouteMapping(routerGroupId, port, fmt.Sprintf("127.0.1.%d", i), 2990, 0, "instance-id", nil, nil, 5, models.ModificationTag{}, false, "")
+						err := sqlDB.SaveTcpRouteMapping(existing)
+						Expect(err).ToNot(HaveOccurred())
+					}
+				})
+
+				It("returns a ports exhausted error and creates nothing", func() {
+					_, err := sqlDB.AllocateTcpRouteMapping(tcpRoute)
+					Expect(err).To(HaveOccurred())
+					dbErr, ok := err.(db.DBError)
+					Expect(ok).To(BeTrue())
+					Expect(dbErr.Type).To(Equal(db.PortsExhausted))
+
+					var mappings []models.TcpRouteMapping
+					err = sqlDB.Client.Where("router_group_guid = ?", routerGroupId).Find(&mappings)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(mappings).To(HaveLen(2))
+				})
+			})
+
+			Context("when the router group has quotas", func() {
+				BeforeEach(func() {
+					routerGroup, err := sqlDB.ReadRouterGroup(routerGroupId)
+					Expect(err).ToNot(HaveOccurred())
+					routerGroup.MaxTcpRoutes = 1
+					Expect(sqlDB.SaveRouterGroup(routerGroup)).To(Succeed())
+				})
+
+				It("returns a quota exceeded error and creates nothing when the quota is reached", func() {
+					_, err := sqlDB.AllocateTcpRouteMapping(tcpRoute)
+					Expect(err).ToNot(HaveOccurred())
+
+					tcpRoute.HostIP = "127.0.0.2"
+					_, err = sqlDB.AllocateTcpRouteMapping(tcpRoute)
+					Expect(err).To(HaveOccurred())
+					dbErr, ok := err.(db.DBError)
+					Expect(ok).To(BeTrue())
+					Expect(dbErr.Type).To(Equal(db.QuotaExceeded))
+
+					var mappings []models.TcpRouteMapping
+					err = sqlDB.Client.Where("router_group_guid = ?", routerGroupId).Find(&mappings)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(mappings).To(HaveLen(1))
+				})
+
+				It("never exceeds the quota when saving concurrently", func() {
+					errs := make(chan error, 2)
+					for i := 0; i < 2; i++ {
+						go func(hostIP string) {
+							defer GinkgoRecover()
+							mapping := tcpRoute
+							mapping.ExternalPort = 65000
+							mapping.HostIP = hostIP
+							errs <- sqlDB.SaveTcpRouteMapping(mapping)
+						}(fmt.Sprintf("127.0.0.%d", i+1))
+					}
+
+					var err1, err2 error
+					Eventually(errs).Should(Receive(&err1))
+					Eventually(errs).Should(Receive(&err2))
+					Expect([]error{err1, err2}).To(ContainElement(BeNil()))
+					Expect([]error{err1, err2}).To(ContainElement(MatchError("router group rg-allocate has reached its quota of 1 tcp routes")))
+
+					var mappings []models.TcpRouteMapping
+					err := sqlDB.Client.Where("router_group_guid = ?", routerGroupId).Find(&mappings)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(mappings).To(HaveLen(1))
+				})
+
+				It("updates existing mappings without counting them", func() {
+					tcpRoute.ExternalPort = 65000
+					Expect(sqlDB.SaveTcpRouteMapping(tcpRoute)).To(Succeed())
+
+					tcpRoute.IsolationSegment = "some-iso-seg"
+					Expect(sqlDB.SaveTcpRouteMapping(tcpRoute)).To(Succeed())
+				})
+			})
+
+			Context("when the router group does not exist", func() {
+				It("returns a key not found error", func() {
+					tcpRoute.RouterGroupGuid = newUuid()
+					_, err := sqlDB.AllocateTcpRouteMapping(tcpRoute)
+					Expect(err).To(HaveOccurred())
+					dbErr, ok := err.(db.DBError)
+					Expect(ok).To(BeTrue())
+					Expect(dbErr.Type).To(Equal(db.KeyNotFound))
+				})
+			})
+		})
+	}
+
+	SaveRouterGroupAndDeleteStrandedTcpRouteMappings := func() {
+		Describe("SaveRouterGroupAndDeleteStrandedTcpRouteMappings", func() {
+			var (
+				routerGroupId string
+				err           error
+				inside        models.TcpRouteMapping
+				outside       models.TcpRouteMapping
+			)
+
+			BeforeEach(func() {
+				routerGroupId = newUuid()
+				_, err = sqlDB.Client.Create(&models.RouterGroupDB{
+					Model:           models.Model{Guid: routerGroupId},
+					Name:            "rg-shrink",
+					Type:            "tcp",
+					ReservablePorts: "65000-65010",
+				})
+				Expect(err).ToNot(HaveOccurred())
+
+				inside = models.NewTcpRouteMapping(routerGroupId, 65000, "127.0.0.1", 2990, 0, "instance-id", nil, nil, 5, models.ModificationTag{}, false, "")
+				outside = models.NewTcpRouteMapping(routerGroupId, 65010, "127.0.0.2", 2990, 0, "instance-id", nil, nil, 5, models.ModificationTag{}, false, "")
+				Expect(sqlDB.SaveTcpRouteMapping(inside)).To(Succeed())
+				Expect(sqlDB.SaveTcpRouteMapping(outside)).To(Succeed())
+			})
+
+			AfterEach(func() {
+				_, err = sqlDB.Client.Where("router_group_guid = ?", routerGroupId).Delete(&models.TcpRouteMapping{})
+				Expect(err).ToNot(HaveOccurred())
+				_, err = sqlDB.Client.Where("guid = ?", routerGroupId).Delete(&models.RouterGroupDB{})
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("saves the router group and deletes the mappings outside its reservable ports", func() {
+				results, _, cancel := sqlDB.WatchChanges(db.TCP_WATCH)
+				defer cancel()
+
+				deleted, err := sqlDB.SaveRouterGroupAndDeleteStrandedTcpRouteMappings(models.RouterGroup{
+					Guid:            routerGroupId,
+					ReservablePorts: "65000-65005",
+				})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(deleted).To(HaveLen(1))
+				Expect(deleted[0].HostIP).To(Equal("127.0.0.2"))
+
+				routerGroup, err := sqlDB.ReadRouterGroup(routerGroupId)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(routerGroup.ReservablePorts).To(Equal(models.ReservablePorts("65000-65005")))
+				Expect(routerGroup.Name).To(Equal("rg-shrink"))
+
+				var mappings []models.TcpRouteMapping
+				err = sqlDB.Client.Where("router_group_guid = ?", routerGroupId).Find(&mappings)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(mappings).To(HaveLen(1))
+				Expect(mappings[0].HostIP).To(Equal("127.0.0.1"))
+
+				var event db.Event
+				Eventually(results).Should(Receive(&event))
+				Expect(event.Type).To(Equal(db.DeleteEvent))
+				Expect(event.Value).To(ContainSubstring(`"port":65010`))
+			})
+
+			Context("when the router group does not exist", func() {
+				It("returns a key not found error", func() {
+					_, err := sqlDB.SaveRouterGroupAndDeleteStrandedTcpRouteMappings(models.RouterGroup{
+						Guid:            newUuid(),
+						ReservablePorts: "65000-65005",
+					})
+					Expect(err).To(HaveOccurred())
+					dbErr, ok := err.(db.DBError)
+					Expect(ok).To(BeTrue())
+					Expect(dbErr.Type).To(Equal(db.KeyNotFound))
+				})
+			})
+		})
+	}
+
+	PortReservations := func() {
+		Describe("PortReservations", func() {
+			var (
+				routerGroupId string
+				err           error
+			)
+
+			BeforeEach(func() {
+				routerGroupId = newUuid()
+				_, err = sqlDB.Client.Create(&models.RouterGroupDB{
+					Model:           models.Model{Guid: routerGroupId},
+					Name:            "rg-reservations",
+					Type:            "tcp",
+					ReservablePorts: "65000-65001",
+				})
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			AfterEach(func() {
+				_, err = sqlDB.Client.Where("router_group_guid = ?", routerGroupId).Delete(&models.PortReservation{})
+				Expect(err).ToNot(HaveOccurred())
+				_, err = sqlDB.Client.Where("router_group_guid = ?", routerGroupId).Delete(&models.TcpRouteMapping{})
+				Expect(err).ToNot(HaveOccurred())
+				_, err = sqlDB.Client.Where("guid = ?", routerGroupId).Delete(&models.RouterGroupDB{})
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			Describe("SavePortReservation", func() {
+				It("allocates the first free port when no port is given", func() {
+					reservation, err := sqlDB.SavePortReservation(models.NewPortReservation(routerGroupId, 0, "owner-a", nil))
+					Expect(err).ToNot(HaveOccurred())
+					Expect(reservation.Guid).ToNot(BeEmpty())
+					Expect(reservation.Port).To(Equal(uint16(65000)))
+					Expect(reservation.ExpiresAt).To(BeNil())
+				})
+
+				It("skips ports used by tcp route mappings", func() {
+					mapping := models.NewTcpRouteMapping(routerGroupId, 65000, "127.0.0.1", 2990, 0, "instance-id", nil, nil, 5, models.ModificationTag{}, false, "")
+					Expect(sqlDB.SaveTcpRouteMapping(mapping)).To(Succeed())
+
+					reservation, err := sqlDB.SavePortReservation(models.NewPortReservation(routerGroupId, 0, "owner-a", nil))
+					Expect(err).ToNot(HaveOccurred())
+					Expect(reservation.Port).To(Equal(uint16(65001)))
+				})
+
+				It("sets an expiry when a ttl is given", func() {
+					ttl := 60
+					reservation, err := sqlDB.SavePortReservation(models.NewPortReservation(routerGroupId, 0, "owner-a", &ttl))
+					Expect(err).ToNot(HaveOccurred())
+					Expect(reservation.ExpiresAt).ToNot(BeNil())
+					Expect(*reservation.ExpiresAt).To(BeTemporally("~", time.Now().Add(60*time.Second), 5*time.Second))
+				})
+
+				It("refreshes the reservation when the same owner reserves the port again", func() {
+					first, err := sqlDB.SavePortReservation(models.NewPortReservation(routerGroupId, 65001, "owner-a", nil))
+					Expect(err).ToNot(HaveOccurred())
+
+					second, err := sqlDB.SavePortReservation(models.NewPortReservation(routerGroupId, 65001, "owner-a", nil))
+					Expect(err).ToNot(HaveOccurred())
+					Expect(second.Guid).To(Equal(first.Guid))
+					Expect(second.ModificationTag.Index).To(Equal(first.ModificationTag.Index + 1))
+				})
+
+				It("returns a unique field error when another owner holds the port", func() {
+					_, err := sqlDB.SavePortReservation(models.NewPortReservation(routerGroupId, 65001, "owner-a", nil))
+					Expect(err).ToNot(HaveOccurred())
+
+					_, err = sqlDB.SavePortReservation(models.NewPortReservation(routerGroupId, 65001, "owner-b", nil))
+					Expect(err).To(HaveOccurred())
+					dbErr, ok := err.(db.DBError)
+					Expect(ok).To(BeTrue())
+					Expect(dbErr.Type).To(Equal(db.UniqueField))
+				})
+
+				It("returns a unique field error when a tcp route mapping uses the port", func() {
+					mapping := models.NewTcpRouteMapping(routerGroupId, 65001, "127.0.0.1", 2990, 0, "instance-id", nil, nil, 5, models.ModificationTag{}, false, "")
+					Expect(sqlDB.SaveTcpRouteMapping(mapping)).To(Succeed())
+
+					_, err := sqlDB.SavePortReservation(models.NewPortReservation(routerGroupId, 65001, "owner-a", nil))
+					Expect(err).To(HaveOccurred())
+					dbErr, ok := err.(db.DBError)
+					Expect(ok).To(BeTrue())
+					Expect(dbErr.Type).To(Equal(db.UniqueField))
+				})
+
+				It("returns a ports exhausted error when every port is reserved", func() {
+					for i := 0; i < 2; i++ {
+						_, err := sqlDB.SavePortReservation(models.NewPortReservation(routerGroupId, 0, "owner-a", nil))
+						Expect(err).ToNot(HaveOccurred())
+					}
+
+					_, err := sqlDB.SavePortReservation(models.NewPortReservation(routerGroupId, 0, "owner-a", nil))
+					Expect(err).To(HaveOccurred())
+					dbErr, ok := err.(db.DBError)
+					Expect(ok).To(BeTrue())
+					Expect(dbErr.Type).To(Equal(db.PortsExhausted))
+				})
+
+				It("emits a create event", func() {
+					results, _, cancel := sqlDB.WatchChanges(db.PORT_RESERVATION_WATCH)
+					defer cancel()
+
+					_, err := sqlDB.SavePortReservation(models.NewPortReservation(routerGroupId, 0, "owner-a", nil))
+					Expect(err).ToNot(HaveOccurred())
+
+					var event db.Event
+					Eventually(results).Should(Receive(&event))
+					Expect(event.Type).To(Equal(db.CreateEvent))
+					Expect(event.Value).To(ContainSubstring(`"owner":"owner-a"`))
+				})
+			})
+
+			Describe("ReadPortReservation", func() {
+				It("returns the reservation", func() {
+					saved, err := sqlDB.SavePortReservation(models.NewPortReservation(routerGroupId, 0, "owner-a", nil))
+					Expect(err).ToNot(HaveOccurred())
+
+					reservation, err := sqlDB.ReadPortReservation(saved.Guid)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(reservation.Port).To(Equal(saved.Port))
+					Expect(reservation.Owner).To(Equal("owner-a"))
+
+					reservations, err := sqlDB.ReadPortReservations()
+					Expect(err).ToNot(HaveOccurred())
+					Expect(reservations).To(ContainElement(WithTransform(func(r models.PortReservation) string { return r.Guid }, Equal(saved.Guid))))
+				})
+
+				It("returns an empty reservation when it does not exist", func() {
+					reservation, err := sqlDB.ReadPortReservation(newUuid())
+					Expect(err).ToNot(HaveOccurred())
+					Expect(reservation).To(Equal(models.PortReservation{}))
+				})
+			})
+
+			Describe("DeletePortReservation", func() {
+				It("releases the port", func() {
+					saved, err := sqlDB.SavePortReservation(models.NewPortReservation(routerGroupId, 65000, "owner-a", nil))
+					Expect(err).ToNot(HaveOccurred())
+
+					err = sqlDB.DeletePortReservation(saved.Guid)
+					Expect(err).ToNot(HaveOccurred())
+
+					reservation, err := sqlDB.SavePortReservation(models.NewPortReservation(routerGroupId, 65000, "owner-b", nil))
+					Expect(err).ToNot(HaveOccurred())
+					Expect(reservation.Owner).To(Equal("owner-b"))
+				})
+
+				It("returns a key not found error when it does not exist", func() {
+					err := sqlDB.DeletePortReservation(newUuid())
+					Expect(err).To(Equal(db.DeletePortReservationError))
+				})
+			})
+		})
+	}
+
+	TlsCertificates := func() {
+		Describe("TlsCertificates", func() {
+			var certChain, privateKey string
+
+			BeforeEach(func() {
+				var err error
+				certChain, privateKey, err = test_helpers.CreateHostnameCertificate([]string{"app.example.com"}, time.Now().Add(time.Hour))
+				Expect(err).ToNot(HaveOccurred())
+				sqlDB.SetEncryptionKey("an-encryption-key-of-at-least-32-characters")
+			})
+
+			AfterEach(func() {
+				_, err := sqlDB.Client.Where("sni_hostname = ?", "app.example.com").Delete(&models.TlsCertificate{})
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			Describe("SaveTlsCertificate", func() {
+				It("stores the private key encrypted", func() {
+					saved, err := sqlDB.SaveTlsCertificate(models.NewTlsCertificate("app.example.com", certChain, privateKey))
+					Expect(err).ToNot(HaveOccurred())
+					Expect(saved.Guid).ToNot(BeEmpty())
+
+					var stored models.TlsCertificate
+					err = sqlDB.Client.Where("guid = ?", saved.Guid).First(&stored)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(stored.EncryptedPrivateKey).ToNot(BeEmpty())
+					Expect(stored.EncryptedPrivateKey).ToNot(ContainSubstring("PRIVATE KEY"))
+					Expect(stored.PrivateKey).To(BeEmpty())
+				})
+
+				It("returns a unique field error when there is a certificate for the sni hostname", func() {
+					_, err := sqlDB.SaveTlsCertificate(models.NewTlsCertificate("app.example.com", certChain, privateKey))
+					Expect(err).ToNot(HaveOccurred())
+
+					_, err = sqlDB.SaveTlsCertificate(models.NewTlsCertificate("app.example.com", certChain, privateKey))
+					Expect(err).To(HaveOccurred())
+					dbErr, ok := err.(db.DBError)
+					Expect(ok).To(BeTrue())
+					Expect(dbErr.Type).To(Equal(db.UniqueField))
+				})
+
+				It("rotates the certificate with the guid", func() {
+					first, err := sqlDB.SaveTlsCertificate(models.NewTlsCertificate("app.example.com", certChain, privateKey))
+					Expect(err).ToNot(HaveOccurred())
+
+					newChain, newKey, err := test_helpers.CreateHostnameCertificate([]string{"app.example.com"}, time.Now().Add(2*time.Hour))
+					Expect(err).ToNot(HaveOccurred())
+					rotation := models.NewTlsCertificate("app.example.com", newChain, newKey)
+					rotation.Guid = first.Guid
+					rotated, err := sqlDB.SaveTlsCertificate(rotation)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(rotated.Guid).To(Equal(first.Guid))
+					Expect(rotated.ModificationTag.Index).To(Equal(first.ModificationTag.Index + 1))
+
+					certificate, err := sqlDB.ReadTlsCertificate(first.Guid)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(certificate.CertChain).To(Equal(newChain))
+					Expect(certificate.PrivateKey).To(Equal(newKey))
+				})
+
+				It("returns a non updatable field error when the sni hostname changes", func() {
+					first, err := sqlDB.SaveTlsCertificate(models.NewTlsCertificate("app.example.com", certChain, privateKey))
+					Expect(err).ToNot(HaveOccurred())
+
+					rotation := models.NewTlsCertificate("other.example.com", certChain, privateKey)
+					rotation.Guid = first.Guid
+					_, err = sqlDB.SaveTlsCertificate(rotation)
+					Expect(err).To(HaveOccurred())
+					dbErr, ok := err.(db.DBError)
+					Expect(ok).To(BeTrue())
+					Expect(dbErr.Type).To(Equal(db.NonUpdatableField))
+				})
+
+				It("returns a key not found error when rotating a certificate that does not exist", func() {
+					rotation := models.NewTlsCertificate("app.example.com", certChain, privateKey)
+					rotation.Guid = newUuid()
+					_, err := sqlDB.SaveTlsCertificate(rotation)
+					Expect(err).To(HaveOccurred())
+					dbErr, ok := err.(db.DBError)
+					Expect(ok).To(BeTrue())
+					Expect(dbErr.Type).To(Equal(db.KeyNotFound))
+				})
+
+				It("emits a create event", func() {
+					results, _, cancel := sqlDB.WatchChanges(db.TLS_CERTIFICATE_WATCH)
+					defer cancel()
+
+					_, err := sqlDB.SaveTlsCertificate(models.NewTlsCertificate("app.example.com", certChain, privateKey))
+					Expect(err).ToNot(HaveOccurred())
+
+					var event db.Event
+					Eventually(results).Should(Receive(&event))
+					Expect(event.Type).To(Equal(db.CreateEvent))
+					Expect(event.Value).To(ContainSubstring(`"sni_hostname":"app.example.com"`))
+				})
+
+				Context("when there is no encryption key", func() {
+					BeforeEach(func() {
+						sqlDB.SetEncryptionKey("")
+					})
+
+					It("returns an error", func() {
+						_, err := sqlDB.SaveTlsCertificate(models.NewTlsCertificate("app.example.com", certChain, privateKey))
+						Expect(err).To(Equal(db.ErrNoEncryptionKey))
+					})
+				})
+			})
+
+			Describe("ReadTlsCertificate", func() {
+				It("returns the certificate with its decrypted private key", func() {
+					saved, err := sqlDB.SaveTlsCertificate(models.NewTlsCertificate("app.example.com", certChain, privateKey))
+					Expect(err).ToNot(HaveOccurred())
+
+					certificate, err := sqlDB.ReadTlsCertificate(saved.Guid)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(certificate.SniHostname).To(Equal("app.example.com"))
+					Expect(certificate.PrivateKey).To(Equal(privateKey))
+
+					certificates, err := sqlDB.ReadTlsCertificates()
+					Expect(err).ToNot(HaveOccurred())
+					Expect(certificates).To(ContainElement(WithTransform(func(c models.TlsCertificate) string { return c.PrivateKey }, Equal(privateKey))))
+				})
+
+				It("returns an empty certificate when it does not exist", func() {
+					certificate, err := sqlDB.ReadTlsCertificate(newUuid())
+					Expect(err).ToNot(HaveOccurred())
+					Expect(certificate).To(Equal(models.TlsCertificate{}))
+				})
+
+				It("returns an error when the encryption key has changed", func() {
+					saved, err := sqlDB.SaveTlsCertificate(models.NewTlsCertificate("app.example.com", certChain, privateKey))
+					Expect(err).ToNot(HaveOccurred())
+
+					sqlDB.SetEncryptionKey("another-encryption-key-of-at-least-32-characters")
+					_, err = sqlDB.ReadTlsCertificate(saved.Guid)
+					Expect(err).To(MatchError(ContainSubstring("the encryption key may have changed")))
+				})
+
+				It("lists the certificates without the private keys that cannot be decrypted", func() {
+					saved, err := sqlDB.SaveTlsCertificate(models.NewTlsCertificate("app.example.com", certChain, privateKey))
+					Expect(err).ToNot(HaveOccurred())
+
+					sqlDB.SetEncryptionKey("another-encryption-key-of-at-least-32-characters")
+					certificates, err := sqlDB.ReadTlsCertificates()
+					Expect(err).ToNot(HaveOccurred())
+					Expect(certificates).To(HaveLen(1))
+					Expect(certificates[0].Guid).To(Equal(saved.Guid))
+					Expect(certificates[0].PrivateKey).To(BeEmpty())
+				})
+			})
+
+			Describe("DeleteTlsCertificate", func() {
+				It("deletes the certificate", func() {
+					saved, err := sqlDB.SaveTlsCertificate(models.NewTlsCertificate("app.example.com", certChain, privateKey))
+					Expect(err).ToNot(HaveOccurred())
+
+					err = sqlDB.DeleteTlsCertificate(saved.Guid)
+					Expect(err).ToNot(HaveOccurred())
+
+					certificate, err := sqlDB.ReadTlsCertificate(saved.Guid)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(certificate.Guid).To(BeEmpty())
+				})
+
+				It("returns an in use error while tcp route mappings refer to it", func() {
+					saved, err := sqlDB.SaveTlsCertificate(models.NewTlsCertificate("app.example.com", certChain, privateKey))
+					Expect(err).ToNot(HaveOccurred())
+
+					mapping := models.NewTcpRouteMapping(newUuid(), 65000, "127.0.0.1", 2990, 0, "instance-id", nil, nil, 5, models.ModificationTag{}, true, "")
+					mapping.TlsCertificateGuid = saved.Guid
+					Expect(sqlDB.SaveTcpRouteMapping(mapping)).To(Succeed())
+					defer func() {
+						_, err := sqlDB.Client.Where("tls_certificate_guid = ?", saved.Guid).Delete(&models.TcpRouteMapping{})
+						Expect(err).ToNot(HaveOccurred())
+					}()
+
+					err = sqlDB.DeleteTlsCertificate(saved.Guid)
+					Expect(err).To(HaveOccurred())
+					dbErr, ok := err.(db.DBError)
+					Expect(ok).To(BeTrue())
+					Expect(dbErr.Type).To(Equal(db.InUse))
+				})
+
+				It("returns a key not found error when it does not exist", func() {
+					err := sqlDB.DeleteTlsCertificate(newUuid())
+					Expect(err).To(Equal(db.DeleteTlsCertificateError))
 				})
 			})
 		})
 	}
 
-	SaveTcpRouteMapping := func() {
-		Describe("SaveTcpRouteMapping", func() {
-			var (
-				routerGroupId string
-				err           error
-				tcpRoute      models.TcpRouteMapping
-			)
+	BackendTlsProfiles := func() {
+		Describe("BackendTlsProfiles", func() {
+			var clientCertChain, clientPrivateKey, trustedCA string
 
 			BeforeEach(func() {
-				routerGroupId = newUuid()
-				tcpRoute = models.NewTcpRouteMapping(routerGroupId, 3056, "127.0.0.1", 2990, 2991, "instance-id", nil, nil, 5, models.ModificationTag{}, true, "alpn1.alpn2")
+				var err error
+				clientCertChain, clientPrivateKey, err = test_helpers.CreateClientCertificate(time.Now().Add(time.Hour))
+				Expect(err).ToNot(HaveOccurred())
+				trustedCA, _, err = test_helpers.CreateHostnameCertificate([]string{"backend.internal"}, time.Now().Add(time.Hour))
+				Expect(err).ToNot(HaveOccurred())
+				sqlDB.SetEncryptionKey("an-encryption-key-of-at-least-32-characters")
 			})
 
 			AfterEach(func() {
-				_, err = sqlDB.Client.Where("guid = ?", tcpRoute.Guid).Delete(&models.TcpRouteMapping{})
+				_, err := sqlDB.Client.Where("name = ?", "iso-seg-1").Delete(&models.BackendTlsProfile{})
 				Expect(err).ToNot(HaveOccurred())
 			})
 
-			Context("when tcp route exists", func() {
-				BeforeEach(func() {
-					err = sqlDB.SaveTcpRouteMapping(tcpRoute)
+			Describe("SaveBackendTlsProfile", func() {
+				It("stores the client private key encrypted", func() {
+					saved, err := sqlDB.SaveBackendTlsProfile(models.NewBackendTlsProfile("iso-seg-1", clientCertChain, clientPrivateKey, trustedCA, ""))
+					Expect(err).ToNot(HaveOccurred())
+					Expect(saved.Guid).ToNot(BeEmpty())
+
+					var stored models.BackendTlsProfile
+					err = sqlDB.Client.Where("guid = ?", saved.Guid).First(&stored)
 					Expect(err).ToNot(HaveOccurred())
+					Expect(stored.EncryptedClientPrivateKey).ToNot(BeEmpty())
+					Expect(stored.EncryptedClientPrivateKey).ToNot(ContainSubstring("PRIVATE KEY"))
+					Expect(stored.ClientPrivateKey).To(BeEmpty())
 				})
 
-				It("updates the existing tcp route mapping and increments modification tag", func() {
-					tcpRoute.IsolationSegment = "some-iso-seg"
-					myTTL := 77
-					tcpRoute.TTL = &myTTL
+				It("returns a unique field error when there is a profile with the name", func() {
+					_, err := sqlDB.SaveBackendTlsProfile(models.NewBackendTlsProfile("iso-seg-1", clientCertChain, clientPrivateKey, trustedCA, ""))
+					Expect(err).ToNot(HaveOccurred())
 
-					err := sqlDB.SaveTcpRouteMapping(tcpRoute)
+					_, err = sqlDB.SaveBackendTlsProfile(models.NewBackendTlsProfile("iso-seg-1", clientCertChain, clientPrivateKey, trustedCA, ""))
+					Expect(err).To(HaveOccurred())
+					dbErr, ok := err.(db.DBError)
+					Expect(ok).To(BeTrue())
+					Expect(dbErr.Type).To(Equal(db.UniqueField))
+				})
+
+				It("updates the profile with the guid", func() {
+					first, err := sqlDB.SaveBackendTlsProfile(models.NewBackendTlsProfile("iso-seg-1", clientCertChain, clientPrivateKey, trustedCA, ""))
 					Expect(err).ToNot(HaveOccurred())
 
-					dbTcpRoute := getFirstTCPRouteMapping(sqlDB, "127.0.0.1")
-					Expect(dbTcpRoute.IsolationSegment).To(Equal("some-iso-seg"))
-					Expect(dbTcpRoute.TTL).To(Equal(&myTTL))
-					Expect(dbTcpRoute.ModificationTag.Index).To(BeNumerically("==", 1))
+					newChain, newKey, err := test_helpers.CreateClientCertificate(time.Now().Add(2 * time.Hour))
+					Expect(err).ToNot(HaveOccurred())
+					update := models.NewBackendTlsProfile("iso-seg-1", newChain, newKey, trustedCA, "backend.internal")
+					update.Guid = first.Guid
+					updated, err := sqlDB.SaveBackendTlsProfile(update)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(updated.Guid).To(Equal(first.Guid))
+					Expect(updated.ModificationTag.Index).To(Equal(first.ModificationTag.Index + 1))
+
+					profile, err := sqlDB.ReadBackendTlsProfile(first.Guid)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(profile.ClientCertChain).To(Equal(newChain))
+					Expect(profile.ClientPrivateKey).To(Equal(newKey))
+					Expect(profile.ExpectedSAN).To(Equal("backend.internal"))
 				})
 
-				It("refreshes the expiration time of the mapping", func() {
-					dbTcpRoute := getFirstTCPRouteMapping(sqlDB, "127.0.0.1")
-					ttl := 9
-					initialExpiration := dbTcpRoute.ExpiresAt
+				It("returns a non updatable field error when the name changes", func() {
+					first, err := sqlDB.SaveBackendTlsProfile(models.NewBackendTlsProfile("iso-seg-1", clientCertChain, clientPrivateKey, trustedCA, ""))
+					Expect(err).ToNot(HaveOccurred())
 
-					tcpRoute.TTL = &ttl
-					err := sqlDB.SaveTcpRouteMapping(tcpRoute)
+					update := models.NewBackendTlsProfile("iso-seg-2", clientCertChain, clientPrivateKey, trustedCA, "")
+					update.Guid = first.Guid
+					_, err = sqlDB.SaveBackendTlsProfile(update)
+					Expect(err).To(HaveOccurred())
+					dbErr, ok := err.(db.DBError)
+					Expect(ok).To(BeTrue())
+					Expect(dbErr.Type).To(Equal(db.NonUpdatableField))
+				})
+
+				It("returns a key not found error when updating a profile that does not exist", func() {
+					update := models.NewBackendTlsProfile("iso-seg-1", clientCertChain, clientPrivateKey, trustedCA, "")
+					update.Guid = newUuid()
+					_, err := sqlDB.SaveBackendTlsProfile(update)
+					Expect(err).To(HaveOccurred())
+					dbErr, ok := err.(db.DBError)
+					Expect(ok).To(BeTrue())
+					Expect(dbErr.Type).To(Equal(db.KeyNotFound))
+				})
+
+				It("emits a create event", func() {
+					results, _, cancel := sqlDB.WatchChanges(db.BACKEND_TLS_PROFILE_WATCH)
+					defer cancel()
+
+					_, err := sqlDB.SaveBackendTlsProfile(models.NewBackendTlsProfile("iso-seg-1", clientCertChain, clientPrivateKey, trustedCA, ""))
 					Expect(err).ToNot(HaveOccurred())
 
-					dbTcpRoute = getFirstTCPRouteMapping(sqlDB, "127.0.0.1")
-					Expect(initialExpiration).To(BeTemporally("<", dbTcpRoute.ExpiresAt))
+					var event db.Event
+					Eventually(results).Should(Receive(&event))
+					Expect(event.Type).To(Equal(db.CreateEvent))
+					Expect(event.Value).To(ContainSubstring(`"name":"iso-seg-1"`))
 				})
 
-				Context("and router group is changed", func() {
-					var (
-						routerGroupId2 string
-						tcpRoute2      models.TcpRouteMapping
-					)
+				Context("when there is no encryption key", func() {
 					BeforeEach(func() {
-						routerGroupId2 = newUuid()
-						tcpRoute2 = models.NewTcpRouteMapping(routerGroupId2, 3056, "127.0.0.1", 2990, 2991, "", nil, nil, 5, models.ModificationTag{}, true, "alpn1,alpn2")
+						sqlDB.SetEncryptionKey("")
 					})
 
-					AfterEach(func() {
-						if tcpRoute2.Guid != "" {
-							_, err = sqlDB.Client.Where("guid = ?", tcpRoute2.Guid).Delete(&models.TcpRouteMapping{})
-							Expect(err).ToNot(HaveOccurred())
-						}
+					It("returns an error", func() {
+						_, err := sqlDB.SaveBackendTlsProfile(models.NewBackendTlsProfile("iso-seg-1", clientCertChain, clientPrivateKey, trustedCA, ""))
+						Expect(err).To(Equal(db.ErrNoEncryptionKey))
 					})
+				})
+			})
 
-					It("creates another tcp route", func() {
-						err = sqlDB.SaveTcpRouteMapping(tcpRoute2)
-						Expect(err).ToNot(HaveOccurred())
-						var dbTcpRoutes []models.TcpRouteMapping
-						err = sqlDB.Client.Where("host_ip = ?", "127.0.0.1").Find(&dbTcpRoutes)
-						Expect(err).ToNot(HaveOccurred())
-						Expect(dbTcpRoutes).To(HaveLen(2))
-						Expect(dbTcpRoutes).To(ConsistOf(
-							matchers.MatchTcpRoute(tcpRoute),
-							matchers.MatchTcpRoute(tcpRoute2),
-						))
-					})
+			Describe("ReadBackendTlsProfile", func() {
+				It("returns the profile with its decrypted client private key", func() {
+					saved, err := sqlDB.SaveBackendTlsProfile(models.NewBackendTlsProfile("iso-seg-1", clientCertChain, clientPrivateKey, trustedCA, ""))
+					Expect(err).ToNot(HaveOccurred())
+
+					profile, err := sqlDB.ReadBackendTlsProfile(saved.Guid)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(profile.Name).To(Equal("iso-seg-1"))
+					Expect(profile.ClientPrivateKey).To(Equal(clientPrivateKey))
+
+					profile, err = sqlDB.ReadBackendTlsProfileByName("iso-seg-1")
+					Expect(err).ToNot(HaveOccurred())
+					Expect(profile.Guid).To(Equal(saved.Guid))
+					Expect(profile.ClientPrivateKey).To(Equal(clientPrivateKey))
+
+					profiles, err := sqlDB.ReadBackendTlsProfiles()
+					Expect(err).ToNot(HaveOccurred())
+					Expect(profiles).To(ContainElement(WithTransform(func(p models.BackendTlsProfile) string { return p.ClientPrivateKey }, Equal(clientPrivateKey))))
 				})
 
-				Context("and the update is a no-op", func() {
-					It("updates the expiry using the previous TTL", func() {
-						dbTcpRoute := getFirstTCPRouteMapping(sqlDB, "127.0.0.1")
-						initialExpiration := dbTcpRoute.ExpiresAt
-						time.Sleep(1 * time.Second)
+				It("lists the profiles without the client private keys that cannot be decrypted", func() {
+					saved, err := sqlDB.SaveBackendTlsProfile(models.NewBackendTlsProfile("iso-seg-1", clientCertChain, clientPrivateKey, trustedCA, ""))
+					Expect(err).ToNot(HaveOccurred())
 
-						err := sqlDB.SaveTcpRouteMapping(tcpRoute)
-						Expect(err).ToNot(HaveOccurred())
+					sqlDB.SetEncryptionKey("another-encryption-key-of-at-least-32-characters")
+					profiles, err := sqlDB.ReadBackendTlsProfiles()
+					Expect(err).ToNot(HaveOccurred())
+					Expect(profiles).To(HaveLen(1))
+					Expect(profiles[0].Guid).To(Equal(saved.Guid))
+					Expect(profiles[0].ClientPrivateKey).To(BeEmpty())
+				})
 
-						dbTcpRoute = getFirstTCPRouteMapping(sqlDB, "127.0.0.1")
-						Expect(initialExpiration).To(BeTemporally("<", dbTcpRoute.ExpiresAt))
-					})
+				It("returns an empty profile when it does not exist", func() {
+					profile, err := sqlDB.ReadBackendTlsProfile(newUuid())
+					Expect(err).ToNot(HaveOccurred())
+					Expect(profile).To(Equal(models.BackendTlsProfile{}))
+
+					profile, err = sqlDB.ReadBackendTlsProfileByName("iso-seg-1")
+					Expect(err).ToNot(HaveOccurred())
+					Expect(profile).To(Equal(models.BackendTlsProfile{}))
 				})
 			})
 
-			Context("when the tcp route doesn't exist", func() {
-				It("creates a modification tag", func() {
-					err := sqlDB.SaveTcpRouteMapping(tcpRoute)
+			Describe("DeleteBackendTlsProfile", func() {
+				It("deletes the profile", func() {
+					saved, err := sqlDB.SaveBackendTlsProfile(models.NewBackendTlsProfile("iso-seg-1", clientCertChain, clientPrivateKey, trustedCA, ""))
 					Expect(err).ToNot(HaveOccurred())
 
-					dbTcpRoute := getFirstTCPRouteMapping(sqlDB, "127.0.0.1")
-					Expect(dbTcpRoute.ModificationTag.Guid).ToNot(BeEmpty())
-					Expect(dbTcpRoute.ModificationTag.Index).To(BeZero())
+					err = sqlDB.DeleteBackendTlsProfile(saved.Guid)
+					Expect(err).ToNot(HaveOccurred())
+
+					profile, err := sqlDB.ReadBackendTlsProfile(saved.Guid)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(profile.Guid).To(BeEmpty())
 				})
 
-				It("creates a tcp route", func() {
-					err := sqlDB.SaveTcpRouteMapping(tcpRoute)
+				It("returns an in use error while tcp route mappings refer to it", func() {
+					saved, err := sqlDB.SaveBackendTlsProfile(models.NewBackendTlsProfile("iso-seg-1", clientCertChain, clientPrivateKey, trustedCA, ""))
 					Expect(err).ToNot(HaveOccurred())
 
-					dbTcpRoute := getFirstTCPRouteMapping(sqlDB, "127.0.0.1")
-					Expect(dbTcpRoute).To(matchers.MatchTcpRoute(tcpRoute))
+					mapping := models.NewTcpRouteMapping(newUuid(), 65000, "127.0.0.1", 2990, 0, "instance-id", nil, nil, 5, models.ModificationTag{}, false, "")
+					mapping.EnableBackendMTLS = true
+					mapping.BackendTlsProfile = "iso-seg-1"
+					Expect(sqlDB.SaveTcpRouteMapping(mapping)).To(Succeed())
+					defer func() {
+						_, err := sqlDB.Client.Where("backend_tls_profile = ?", "iso-seg-1").Delete(&models.TcpRouteMapping{})
+						Expect(err).ToNot(HaveOccurred())
+					}()
+
+					err = sqlDB.DeleteBackendTlsProfile(saved.Guid)
+					Expect(err).To(HaveOccurred())
+					dbErr, ok := err.(db.DBError)
+					Expect(ok).To(BeTrue())
+					Expect(dbErr.Type).To(Equal(db.InUse))
+				})
+
+				It("returns a key not found error when it does not exist", func() {
+					err := sqlDB.DeleteBackendTlsProfile(newUuid())
+					Expect(err).To(Equal(db.DeleteBackendTlsProfileError))
 				})
 			})
 		})
@@ -1414,6 +2636,67 @@ var _ = Describe("SqlDB", func() {
 					Expect(dbRoute.ModificationTag.Index).To(BeNumerically("==", 1))
 				})
 
+				It("updates the server cert domain san of the existing route", func() {
+					httpRoute.ServerCertDomainSAN = "new-san"
+					err := sqlDB.SaveRoute(httpRoute)
+					Expect(err).ToNot(HaveOccurred())
+
+					var dbRoutes []models.Route
+					err = sqlDB.Client.Where("ip = ?", "127.0.0.1").Find(&dbRoutes)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(dbRoutes).To(HaveLen(1))
+					Expect(dbRoutes[0].ServerCertDomainSAN).To(Equal("new-san"))
+				})
+
+				It("updates the protocol of the existing route", func() {
+					httpRoute.Protocol = models.RouteProtocolHTTP2
+					err := sqlDB.SaveRoute(httpRoute)
+					Expect(err).ToNot(HaveOccurred())
+
+					var dbRoutes []models.Route
+					err = sqlDB.Client.Where("ip = ?", "127.0.0.1").Find(&dbRoutes)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(dbRoutes).To(HaveLen(1))
+					Expect(dbRoutes[0].Protocol).To(Equal(models.RouteProtocolHTTP2))
+				})
+
+				It("updates the router group of the existing route", func() {
+					httpRoute.RouterGroupGuid = "http-guid"
+					err := sqlDB.SaveRoute(httpRoute)
+					Expect(err).ToNot(HaveOccurred())
+
+					var dbRoutes []models.Route
+					err = sqlDB.Client.Where("ip = ?", "127.0.0.1").Find(&dbRoutes)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(dbRoutes).To(HaveLen(1))
+					Expect(dbRoutes[0].RouterGroupGuid).To(Equal("http-guid"))
+				})
+
+				Context("and the tls port is changed", func() {
+					var tlsRoute models.Route
+
+					BeforeEach(func() {
+						tlsRoute = httpRoute
+						tlsRoute.TLSPort = 7443
+						tlsRoute.ServerCertDomainSAN = "instance-guid"
+					})
+
+					AfterEach(func() {
+						_, err = sqlDB.Client.Where("tls_port = ?", 7443).Delete(&models.Route{})
+						Expect(err).ToNot(HaveOccurred())
+					})
+
+					It("creates another http route", func() {
+						err := sqlDB.SaveRoute(tlsRoute)
+						Expect(err).ToNot(HaveOccurred())
+
+						var dbRoutes []models.Route
+						err = sqlDB.Client.Where("ip = ?", "127.0.0.1").Find(&dbRoutes)
+						Expect(err).ToNot(HaveOccurred())
+						Expect(dbRoutes).To(HaveLen(2))
+					})
+				})
+
 				It("refreshes the expiration time of the route", func() {
 					var dbRoute models.Route
 					ttl := 9
@@ -2155,6 +3438,165 @@ var _ = Describe("SqlDB", func() {
 			})
 		})
 	}
+	FindOverlappingTcpRouteMappings := func() {
+		Describe("FindOverlappingTcpRouteMappings", func() {
+			var (
+				err           error
+				routerGroupId string
+				rangeMapping  models.TcpRouteMapping
+			)
+
+			BeforeEach(func() {
+				routerGroupId = newUuid()
+				rangeMapping = models.NewTcpRouteMapping(routerGroupId, 40000, "127.0.0.1", 50000, 0, "instance-id", nil, nil, 5, models.ModificationTag{}, false, "")
+				rangeMapping.ExternalPortEnd = 40099
+				err = sqlDB.SaveTcpRouteMapping(rangeMapping)
+				Expect(err).ToNot(HaveOccurred())
+
+				for _, port := range []uint16{39999, 40100} {
+					single := models.NewTcpRouteMapping(routerGroupId, port, "127.0.0.1", 2990, 0, "instance-id", nil, nil, 5, models.ModificationTag{}, false, "")
+					err = sqlDB.SaveTcpRouteMapping(single)
+					Expect(err).ToNot(HaveOccurred())
+				}
+			})
+
+			AfterEach(func() {
+				_, err = sqlDB.Client.Where("router_group_guid = ?", routerGroupId).Delete(&models.TcpRouteMapping{})
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("returns the port range mappings that contain a single port", func() {
+				single := models.NewTcpRouteMapping(routerGroupId, 40042, "127.0.0.2", 2990, 0, "", nil, nil, 5, models.ModificationTag{}, false, "")
+				overlapping, err := sqlDB.FindOverlappingTcpRouteMappings(single)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(overlapping).To(HaveLen(1))
+				Expect(overlapping[0].ExternalPortEnd).To(Equal(uint16(40099)))
+			})
+
+			It("returns the mappings within a port range", func() {
+				other := models.NewTcpRouteMapping(routerGroupId, 40050, "127.0.0.2", 2990, 0, "", nil, nil, 5, models.ModificationTag{}, false, "")
+				other.ExternalPortEnd = 40149
+				overlapping, err := sqlDB.FindOverlappingTcpRouteMappings(other)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(overlapping).To(HaveLen(2))
+			})
+
+			It("ignores the mappings of other router groups", func() {
+				single := models.NewTcpRouteMapping(newUuid(), 40042, "127.0.0.2", 2990, 0, "", nil, nil, 5, models.ModificationTag{}, false, "")
+				overlapping, err := sqlDB.FindOverlappingTcpRouteMappings(single)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(overlapping).To(BeEmpty())
+			})
+		})
+	}
+
+	UdpRouteMappings := func() {
+		Describe("UdpRouteMappings", func() {
+			var (
+				err           error
+				routerGroupId string
+				udpRoute      models.UdpRouteMapping
+			)
+
+			BeforeEach(func() {
+				routerGroupId = newUuid()
+				udpRoute = models.NewUdpRouteMapping(routerGroupId, 5300, "127.0.0.1", 53, "instance-id", 5, models.ModificationTag{})
+			})
+
+			AfterEach(func() {
+				_, err = sqlDB.Client.Where("router_group_guid = ?", routerGroupId).Delete(&models.UdpRouteMapping{})
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			Describe("SaveUdpRouteMapping", func() {
+				It("creates a udp route with a modification tag", func() {
+					err = sqlDB.SaveUdpRouteMapping(udpRoute)
+					Expect(err).ToNot(HaveOccurred())
+
+					udpRoutes, err := sqlDB.ReadUdpRouteMappings()
+					Expect(err).ToNot(HaveOccurred())
+					Expect(udpRoutes).To(HaveLen(1))
+					Expect(udpRoutes[0].Matches(udpRoute)).To(BeTrue())
+					Expect(udpRoutes[0].ModificationTag.Guid).ToNot(BeEmpty())
+					Expect(udpRoutes[0].ModificationTag.Index).To(BeZero())
+				})
+
+				It("updates an existing udp route and increments the modification tag", func() {
+					err = sqlDB.SaveUdpRouteMapping(udpRoute)
+					Expect(err).ToNot(HaveOccurred())
+
+					udpRoute.IsolationSegment = "some-iso-seg"
+					ttl := 77
+					udpRoute.TTL = &ttl
+					err = sqlDB.SaveUdpRouteMapping(udpRoute)
+					Expect(err).ToNot(HaveOccurred())
+
+					udpRoutes, err := sqlDB.ReadUdpRouteMappings()
+					Expect(err).ToNot(HaveOccurred())
+					Expect(udpRoutes).To(HaveLen(1))
+					Expect(udpRoutes[0].IsolationSegment).To(Equal("some-iso-seg"))
+					Expect(*udpRoutes[0].TTL).To(Equal(77))
+					Expect(udpRoutes[0].ModificationTag.Index).To(BeNumerically("==", 1))
+				})
+			})
+
+			Describe("ReadFilteredUdpRouteMappings", func() {
+				BeforeEach(func() {
+					udpRoute.IsolationSegment = "is1"
+					err = sqlDB.SaveUdpRouteMapping(udpRoute)
+					Expect(err).ToNot(HaveOccurred())
+
+					udpRoute2 := models.NewUdpRouteMapping(routerGroupId, 5514, "127.0.0.1", 514, "instance-id", 5, models.ModificationTag{})
+					err = sqlDB.SaveUdpRouteMapping(udpRoute2)
+					Expect(err).ToNot(HaveOccurred())
+				})
+
+				It("returns the udp routes matching the filter", func() {
+					udpRoutes, err := sqlDB.ReadFilteredUdpRouteMappings("isolation_segment", []string{"is1"})
+					Expect(err).ToNot(HaveOccurred())
+					Expect(udpRoutes).To(HaveLen(1))
+					Expect(udpRoutes[0].ExternalPort).To(Equal(uint16(5300)))
+				})
+			})
+
+			Describe("DeleteUdpRouteMapping", func() {
+				It("deletes the udp route", func() {
+					err = sqlDB.SaveUdpRouteMapping(udpRoute)
+					Expect(err).ToNot(HaveOccurred())
+
+					err = sqlDB.DeleteUdpRouteMapping(udpRoute)
+					Expect(err).ToNot(HaveOccurred())
+
+					udpRoutes, err := sqlDB.ReadUdpRouteMappings()
+					Expect(err).ToNot(HaveOccurred())
+					Expect(udpRoutes).To(BeEmpty())
+				})
+
+				It("returns a DB error when the udp route doesn't exist", func() {
+					err = sqlDB.DeleteUdpRouteMapping(udpRoute)
+					Expect(err).Should(MatchError(db.DeleteRouteError))
+					dberr, ok := err.(db.DBError)
+					Expect(ok).To(BeTrue())
+					Expect(dberr.Type).To(Equal(db.KeyNotFound))
+				})
+			})
+
+			Describe("WatchChanges with udp events", func() {
+				It("should return a create watch event", func() {
+					results, _, _ := sqlDB.WatchChanges(db.UDP_WATCH)
+
+					err = sqlDB.SaveUdpRouteMapping(udpRoute)
+					Expect(err).NotTo(HaveOccurred())
+
+					var event db.Event
+					Eventually(results).Should(Receive(&event))
+					Expect(event.Type).To(Equal(db.CreateEvent))
+					Expect(event.Value).To(ContainSubstring(`"port":5300`))
+				})
+			})
+		})
+	}
+
 	Describe("DB Connection Configuration", func() {
 		MySQLConnectionString()
 		PostgresConnectionString()
@@ -2169,6 +3611,14 @@ var _ = Describe("SqlDB", func() {
 			Expect(err).ToNot(HaveOccurred())
 			err = migration.NewV0InitMigration().Run(sqlDB)
 			Expect(err).ToNot(HaveOccurred())
+			err = migration.NewV15PortReservations().Run(sqlDB)
+			Expect(err).ToNot(HaveOccurred())
+			err = migration.NewV20UdpRoutes().Run(sqlDB)
+			Expect(err).ToNot(HaveOccurred())
+			err = migration.NewV26TlsCertificates().Run(sqlDB)
+			Expect(err).ToNot(HaveOccurred())
+			err = migration.NewV27BackendTlsProfiles().Run(sqlDB)
+			Expect(err).ToNot(HaveOccurred())
 		})
 
 		CleanupRoutes()
@@ -2180,14 +3630,22 @@ var _ = Describe("SqlDB", func() {
 		ReadTcpRouteMappings()
 		ReadFilteredTcpRouteMappings()
 		SaveTcpRouteMapping()
+		AllocateTcpRouteMapping()
+		PortReservations()
+		TlsCertificates()
+		BackendTlsProfiles()
 		ReadRouterGroup()
 		ReadRouterGroupByName()
 		ReadRouterGroups()
 		SaveRouterGroup()
+		SaveRouterGroupAndDeleteStrandedTcpRouteMappings()
 		DeleteRouterGroup()
+		DeleteRouterGroupCascade()
 		Connection()
 		FindExpiredRoutes()
 		FindExistingTcpRouteMapping()
+		FindOverlappingTcpRouteMappings()
+		UdpRouteMappings()
 	})
 })
 
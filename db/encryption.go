@@ -0,0 +1,56 @@
+package db
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+)
+
+// ErrNoEncryptionKey is returned for tls certificates and backend tls profiles
+// when no encryption key is configured to store their private keys with.
+var ErrNoEncryptionKey = errors.New("tls certificates require tls_certificates.encryption_key to be configured")
+
+// encryptor encrypts values with AES-256-GCM under a key derived from the
+// configured encryption key. The additional data binds a ciphertext to the row
+// it is stored in, so that it cannot be copied to another row.
+type encryptor struct {
+	aead cipher.AEAD
+}
+
+func newEncryptor(key string) *encryptor {
+	derivedKey := sha256.Sum256([]byte(key))
+	// a 32 byte key always makes a valid AES cipher
+	block, _ := aes.NewCipher(derivedKey[:])
+	aead, _ := cipher.NewGCM(block)
+	return &encryptor{aead: aead}
+}
+
+func (e *encryptor) encrypt(plaintext, additionalData string) (string, error) {
+	nonce := make([]byte, e.aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", err
+	}
+
+	sealed := e.aead.Seal(nonce, nonce, []byte(plaintext), []byte(additionalData))
+	return base64.StdEncoding.EncodeToString(sealed), nil
+}
+
+func (e *encryptor) decrypt(ciphertext, additionalData string) (string, error) {
+	sealed, err := base64.StdEncoding.DecodeString(ciphertext)
+	if err != nil {
+		return "", err
+	}
+	if len(sealed) < e.aead.NonceSize() {
+		return "", errors.New("encrypted value is too short")
+	}
+
+	nonce, sealed := sealed[:e.aead.NonceSize()], sealed[e.aead.NonceSize():]
+	plaintext, err := e.aead.Open(nil, nonce, sealed, []byte(additionalData))
+	if err != nil {
+		return "", errors.New("failed to decrypt value, the encryption key may have changed")
+	}
+	return string(plaintext), nil
+}
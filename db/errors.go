@@ -13,4 +13,7 @@ const (
 	KeyNotFound       = "KeyNotFound"
 	NonUpdatableField = "NonUpdatableField"
 	UniqueField       = "UniqueField"
+	PortsExhausted    = "PortsExhausted"
+	InUse             = "InUse"
+	QuotaExceeded     = "QuotaExceeded"
 )
@@ -10,10 +10,45 @@ import (
 )
 
 type FakeDB struct {
+	AllocateTcpRouteMappingStub        func(models.TcpRouteMapping) (models.TcpRouteMapping, error)
+	allocateTcpRouteMappingMutex       sync.RWMutex
+	allocateTcpRouteMappingArgsForCall []struct {
+		arg1 models.TcpRouteMapping
+	}
+	allocateTcpRouteMappingReturns struct {
+		result1 models.TcpRouteMapping
+		result2 error
+	}
+	allocateTcpRouteMappingReturnsOnCall map[int]struct {
+		result1 models.TcpRouteMapping
+		result2 error
+	}
 	CancelWatchesStub        func()
 	cancelWatchesMutex       sync.RWMutex
 	cancelWatchesArgsForCall []struct {
 	}
+	DeleteBackendTlsProfileStub        func(string) error
+	deleteBackendTlsProfileMutex       sync.RWMutex
+	deleteBackendTlsProfileArgsForCall []struct {
+		arg1 string
+	}
+	deleteBackendTlsProfileReturns struct {
+		result1 error
+	}
+	deleteBackendTlsProfileReturnsOnCall map[int]struct {
+		result1 error
+	}
+	DeletePortReservationStub        func(string) error
+	deletePortReservationMutex       sync.RWMutex
+	deletePortReservationArgsForCall []struct {
+		arg1 string
+	}
+	deletePortReservationReturns struct {
+		result1 error
+	}
+	deletePortReservationReturnsOnCall map[int]struct {
+		result1 error
+	}
 	DeleteRouteStub        func(models.Route) error
 	deleteRouteMutex       sync.RWMutex
 	deleteRouteArgsForCall []struct {
@@ -36,6 +71,19 @@ type FakeDB struct {
 	deleteRouterGroupReturnsOnCall map[int]struct {
 		result1 error
 	}
+	DeleteRouterGroupCascadeStub        func(string) (models.RouterGroupDependents, error)
+	deleteRouterGroupCascadeMutex       sync.RWMutex
+	deleteRouterGroupCascadeArgsForCall []struct {
+		arg1 string
+	}
+	deleteRouterGroupCascadeReturns struct {
+		result1 models.RouterGroupDependents
+		result2 error
+	}
+	deleteRouterGroupCascadeReturnsOnCall map[int]struct {
+		result1 models.RouterGroupDependents
+		result2 error
+	}
 	DeleteTcpRouteMappingStub        func(models.TcpRouteMapping) error
 	deleteTcpRouteMappingMutex       sync.RWMutex
 	deleteTcpRouteMappingArgsForCall []struct {
@@ -47,6 +95,41 @@ type FakeDB struct {
 	deleteTcpRouteMappingReturnsOnCall map[int]struct {
 		result1 error
 	}
+	DeleteTlsCertificateStub        func(string) error
+	deleteTlsCertificateMutex       sync.RWMutex
+	deleteTlsCertificateArgsForCall []struct {
+		arg1 string
+	}
+	deleteTlsCertificateReturns struct {
+		result1 error
+	}
+	deleteTlsCertificateReturnsOnCall map[int]struct {
+		result1 error
+	}
+	DeleteUdpRouteMappingStub        func(models.UdpRouteMapping) error
+	deleteUdpRouteMappingMutex       sync.RWMutex
+	deleteUdpRouteMappingArgsForCall []struct {
+		arg1 models.UdpRouteMapping
+	}
+	deleteUdpRouteMappingReturns struct {
+		result1 error
+	}
+	deleteUdpRouteMappingReturnsOnCall map[int]struct {
+		result1 error
+	}
+	FindOverlappingTcpRouteMappingsStub        func(models.TcpRouteMapping) ([]models.TcpRouteMapping, error)
+	findOverlappingTcpRouteMappingsMutex       sync.RWMutex
+	findOverlappingTcpRouteMappingsArgsForCall []struct {
+		arg1 models.TcpRouteMapping
+	}
+	findOverlappingTcpRouteMappingsReturns struct {
+		result1 []models.TcpRouteMapping
+		result2 error
+	}
+	findOverlappingTcpRouteMappingsReturnsOnCall map[int]struct {
+		result1 []models.TcpRouteMapping
+		result2 error
+	}
 	FindSimilarTcpRouteMappingsStub        func(string, uint16) ([]models.TcpRouteMapping, error)
 	findSimilarTcpRouteMappingsMutex       sync.RWMutex
 	findSimilarTcpRouteMappingsArgsForCall []struct {
@@ -69,6 +152,44 @@ type FakeDB struct {
 	lockRouterGroupWritesMutex       sync.RWMutex
 	lockRouterGroupWritesArgsForCall []struct {
 	}
+	ReadBackendTlsProfileStub        func(string) (models.BackendTlsProfile, error)
+	readBackendTlsProfileMutex       sync.RWMutex
+	readBackendTlsProfileArgsForCall []struct {
+		arg1 string
+	}
+	readBackendTlsProfileReturns struct {
+		result1 models.BackendTlsProfile
+		result2 error
+	}
+	readBackendTlsProfileReturnsOnCall map[int]struct {
+		result1 models.BackendTlsProfile
+		result2 error
+	}
+	ReadBackendTlsProfileByNameStub        func(string) (models.BackendTlsProfile, error)
+	readBackendTlsProfileByNameMutex       sync.RWMutex
+	readBackendTlsProfileByNameArgsForCall []struct {
+		arg1 string
+	}
+	readBackendTlsProfileByNameReturns struct {
+		result1 models.BackendTlsProfile
+		result2 error
+	}
+	readBackendTlsProfileByNameReturnsOnCall map[int]struct {
+		result1 models.BackendTlsProfile
+		result2 error
+	}
+	ReadBackendTlsProfilesStub        func() ([]models.BackendTlsProfile, error)
+	readBackendTlsProfilesMutex       sync.RWMutex
+	readBackendTlsProfilesArgsForCall []struct {
+	}
+	readBackendTlsProfilesReturns struct {
+		result1 []models.BackendTlsProfile
+		result2 error
+	}
+	readBackendTlsProfilesReturnsOnCall map[int]struct {
+		result1 []models.BackendTlsProfile
+		result2 error
+	}
 	ReadFilteredTcpRouteMappingsStub        func(string, []string) ([]models.TcpRouteMapping, error)
 	readFilteredTcpRouteMappingsMutex       sync.RWMutex
 	readFilteredTcpRouteMappingsArgsForCall []struct {
@@ -83,6 +204,45 @@ type FakeDB struct {
 		result1 []models.TcpRouteMapping
 		result2 error
 	}
+	ReadFilteredUdpRouteMappingsStub        func(string, []string) ([]models.UdpRouteMapping, error)
+	readFilteredUdpRouteMappingsMutex       sync.RWMutex
+	readFilteredUdpRouteMappingsArgsForCall []struct {
+		arg1 string
+		arg2 []string
+	}
+	readFilteredUdpRouteMappingsReturns struct {
+		result1 []models.UdpRouteMapping
+		result2 error
+	}
+	readFilteredUdpRouteMappingsReturnsOnCall map[int]struct {
+		result1 []models.UdpRouteMapping
+		result2 error
+	}
+	ReadPortReservationStub        func(string) (models.PortReservation, error)
+	readPortReservationMutex       sync.RWMutex
+	readPortReservationArgsForCall []struct {
+		arg1 string
+	}
+	readPortReservationReturns struct {
+		result1 models.PortReservation
+		result2 error
+	}
+	readPortReservationReturnsOnCall map[int]struct {
+		result1 models.PortReservation
+		result2 error
+	}
+	ReadPortReservationsStub        func() ([]models.PortReservation, error)
+	readPortReservationsMutex       sync.RWMutex
+	readPortReservationsArgsForCall []struct {
+	}
+	readPortReservationsReturns struct {
+		result1 []models.PortReservation
+		result2 error
+	}
+	readPortReservationsReturnsOnCall map[int]struct {
+		result1 []models.PortReservation
+		result2 error
+	}
 	ReadRouterGroupStub        func(string) (models.RouterGroup, error)
 	readRouterGroupMutex       sync.RWMutex
 	readRouterGroupArgsForCall []struct {
@@ -145,6 +305,69 @@ type FakeDB struct {
 		result1 []models.TcpRouteMapping
 		result2 error
 	}
+	ReadTlsCertificateStub        func(string) (models.TlsCertificate, error)
+	readTlsCertificateMutex       sync.RWMutex
+	readTlsCertificateArgsForCall []struct {
+		arg1 string
+	}
+	readTlsCertificateReturns struct {
+		result1 models.TlsCertificate
+		result2 error
+	}
+	readTlsCertificateReturnsOnCall map[int]struct {
+		result1 models.TlsCertificate
+		result2 error
+	}
+	ReadTlsCertificatesStub        func() ([]models.TlsCertificate, error)
+	readTlsCertificatesMutex       sync.RWMutex
+	readTlsCertificatesArgsForCall []struct {
+	}
+	readTlsCertificatesReturns struct {
+		result1 []models.TlsCertificate
+		result2 error
+	}
+	readTlsCertificatesReturnsOnCall map[int]struct {
+		result1 []models.TlsCertificate
+		result2 error
+	}
+	ReadUdpRouteMappingsStub        func() ([]models.UdpRouteMapping, error)
+	readUdpRouteMappingsMutex       sync.RWMutex
+	readUdpRouteMappingsArgsForCall []struct {
+	}
+	readUdpRouteMappingsReturns struct {
+		result1 []models.UdpRouteMapping
+		result2 error
+	}
+	readUdpRouteMappingsReturnsOnCall map[int]struct {
+		result1 []models.UdpRouteMapping
+		result2 error
+	}
+	SaveBackendTlsProfileStub        func(models.BackendTlsProfile) (models.BackendTlsProfile, error)
+	saveBackendTlsProfileMutex       sync.RWMutex
+	saveBackendTlsProfileArgsForCall []struct {
+		arg1 models.BackendTlsProfile
+	}
+	saveBackendTlsProfileReturns struct {
+		result1 models.BackendTlsProfile
+		result2 error
+	}
+	saveBackendTlsProfileReturnsOnCall map[int]struct {
+		result1 models.BackendTlsProfile
+		result2 error
+	}
+	SavePortReservationStub        func(models.PortReservation) (models.PortReservation, error)
+	savePortReservationMutex       sync.RWMutex
+	savePortReservationArgsForCall []struct {
+		arg1 models.PortReservation
+	}
+	savePortReservationReturns struct {
+		result1 models.PortReservation
+		result2 error
+	}
+	savePortReservationReturnsOnCall map[int]struct {
+		result1 models.PortReservation
+		result2 error
+	}
 	SaveRouteStub        func(models.Route) error
 	saveRouteMutex       sync.RWMutex
 	saveRouteArgsForCall []struct {
@@ -167,6 +390,19 @@ type FakeDB struct {
 	saveRouterGroupReturnsOnCall map[int]struct {
 		result1 error
 	}
+	SaveRouterGroupAndDeleteStrandedTcpRouteMappingsStub        func(models.RouterGroup) ([]models.TcpRouteMapping, error)
+	saveRouterGroupAndDeleteStrandedTcpRouteMappingsMutex       sync.RWMutex
+	saveRouterGroupAndDeleteStrandedTcpRouteMappingsArgsForCall []struct {
+		arg1 models.RouterGroup
+	}
+	saveRouterGroupAndDeleteStrandedTcpRouteMappingsReturns struct {
+		result1 []models.TcpRouteMapping
+		result2 error
+	}
+	saveRouterGroupAndDeleteStrandedTcpRouteMappingsReturnsOnCall map[int]struct {
+		result1 []models.TcpRouteMapping
+		result2 error
+	}
 	SaveTcpRouteMappingStub        func(models.TcpRouteMapping) error
 	saveTcpRouteMappingMutex       sync.RWMutex
 	saveTcpRouteMappingArgsForCall []struct {
@@ -178,6 +414,30 @@ type FakeDB struct {
 	saveTcpRouteMappingReturnsOnCall map[int]struct {
 		result1 error
 	}
+	SaveTlsCertificateStub        func(models.TlsCertificate) (models.TlsCertificate, error)
+	saveTlsCertificateMutex       sync.RWMutex
+	saveTlsCertificateArgsForCall []struct {
+		arg1 models.TlsCertificate
+	}
+	saveTlsCertificateReturns struct {
+		result1 models.TlsCertificate
+		result2 error
+	}
+	saveTlsCertificateReturnsOnCall map[int]struct {
+		result1 models.TlsCertificate
+		result2 error
+	}
+	SaveUdpRouteMappingStub        func(models.UdpRouteMapping) error
+	saveUdpRouteMappingMutex       sync.RWMutex
+	saveUdpRouteMappingArgsForCall []struct {
+		arg1 models.UdpRouteMapping
+	}
+	saveUdpRouteMappingReturns struct {
+		result1 error
+	}
+	saveUdpRouteMappingReturnsOnCall map[int]struct {
+		result1 error
+	}
 	UnlockRouterGroupReadsStub        func()
 	unlockRouterGroupReadsMutex       sync.RWMutex
 	unlockRouterGroupReadsArgsForCall []struct {
@@ -205,6 +465,70 @@ type FakeDB struct {
 	invocationsMutex sync.RWMutex
 }
 
+func (fake *FakeDB) AllocateTcpRouteMapping(arg1 models.TcpRouteMapping) (models.TcpRouteMapping, error) {
+	fake.allocateTcpRouteMappingMutex.Lock()
+	ret, specificReturn := fake.allocateTcpRouteMappingReturnsOnCall[len(fake.allocateTcpRouteMappingArgsForCall)]
+	fake.allocateTcpRouteMappingArgsForCall = append(fake.allocateTcpRouteMappingArgsForCall, struct {
+		arg1 models.TcpRouteMapping
+	}{arg1})
+	stub := fake.AllocateTcpRouteMappingStub
+	fakeReturns := fake.allocateTcpRouteMappingReturns
+	fake.recordInvocation("AllocateTcpRouteMapping", []interface{}{arg1})
+	fake.allocateTcpRouteMappingMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeDB) AllocateTcpRouteMappingCallCount() int {
+	fake.allocateTcpRouteMappingMutex.RLock()
+	defer fake.allocateTcpRouteMappingMutex.RUnlock()
+	return len(fake.allocateTcpRouteMappingArgsForCall)
+}
+
+func (fake *FakeDB) AllocateTcpRouteMappingCalls(stub func(models.TcpRouteMapping) (models.TcpRouteMapping, error)) {
+	fake.allocateTcpRouteMappingMutex.Lock()
+	defer fake.allocateTcpRouteMappingMutex.Unlock()
+	fake.AllocateTcpRouteMappingStub = stub
+}
+
+func (fake *FakeDB) AllocateTcpRouteMappingArgsForCall(i int) models.TcpRouteMapping {
+	fake.allocateTcpRouteMappingMutex.RLock()
+	defer fake.allocateTcpRouteMappingMutex.RUnlock()
+	argsForCall := fake.allocateTcpRouteMappingArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeDB) AllocateTcpRouteMappingReturns(result1 models.TcpRouteMapping, result2 error) {
+	fake.allocateTcpRouteMappingMutex.Lock()
+	defer fake.allocateTcpRouteMappingMutex.Unlock()
+	fake.AllocateTcpRouteMappingStub = nil
+	fake.allocateTcpRouteMappingReturns = struct {
+		result1 models.TcpRouteMapping
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDB) AllocateTcpRouteMappingReturnsOnCall(i int, result1 models.TcpRouteMapping, result2 error) {
+	fake.allocateTcpRouteMappingMutex.Lock()
+	defer fake.allocateTcpRouteMappingMutex.Unlock()
+	fake.AllocateTcpRouteMappingStub = nil
+	if fake.allocateTcpRouteMappingReturnsOnCall == nil {
+		fake.allocateTcpRouteMappingReturnsOnCall = make(map[int]struct {
+			result1 models.TcpRouteMapping
+			result2 error
+		})
+	}
+	fake.allocateTcpRouteMappingReturnsOnCall[i] = struct {
+		result1 models.TcpRouteMapping
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeDB) CancelWatches() {
 	fake.cancelWatchesMutex.Lock()
 	fake.cancelWatchesArgsForCall = append(fake.cancelWatchesArgsForCall, struct {
@@ -229,6 +553,128 @@ func (fake *FakeDB) CancelWatchesCalls(stub func()) {
 	fake.CancelWatchesStub = stub
 }
 
+func (fake *FakeDB) DeleteBackendTlsProfile(arg1 string) error {
+	fake.deleteBackendTlsProfileMutex.Lock()
+	ret, specificReturn := fake.deleteBackendTlsProfileReturnsOnCall[len(fake.deleteBackendTlsProfileArgsForCall)]
+	fake.deleteBackendTlsProfileArgsForCall = append(fake.deleteBackendTlsProfileArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.DeleteBackendTlsProfileStub
+	fakeReturns := fake.deleteBackendTlsProfileReturns
+	fake.recordInvocation("DeleteBackendTlsProfile", []interface{}{arg1})
+	fake.deleteBackendTlsProfileMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeDB) DeleteBackendTlsProfileCallCount() int {
+	fake.deleteBackendTlsProfileMutex.RLock()
+	defer fake.deleteBackendTlsProfileMutex.RUnlock()
+	return len(fake.deleteBackendTlsProfileArgsForCall)
+}
+
+func (fake *FakeDB) DeleteBackendTlsProfileCalls(stub func(string) error) {
+	fake.deleteBackendTlsProfileMutex.Lock()
+	defer fake.deleteBackendTlsProfileMutex.Unlock()
+	fake.DeleteBackendTlsProfileStub = stub
+}
+
+func (fake *FakeDB) DeleteBackendTlsProfileArgsForCall(i int) string {
+	fake.deleteBackendTlsProfileMutex.RLock()
+	defer fake.deleteBackendTlsProfileMutex.RUnlock()
+	argsForCall := fake.deleteBackendTlsProfileArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeDB) DeleteBackendTlsProfileReturns(result1 error) {
+	fake.deleteBackendTlsProfileMutex.Lock()
+	defer fake.deleteBackendTlsProfileMutex.Unlock()
+	fake.DeleteBackendTlsProfileStub = nil
+	fake.deleteBackendTlsProfileReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeDB) DeleteBackendTlsProfileReturnsOnCall(i int, result1 error) {
+	fake.deleteBackendTlsProfileMutex.Lock()
+	defer fake.deleteBackendTlsProfileMutex.Unlock()
+	fake.DeleteBackendTlsProfileStub = nil
+	if fake.deleteBackendTlsProfileReturnsOnCall == nil {
+		fake.deleteBackendTlsProfileReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.deleteBackendTlsProfileReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeDB) DeletePortReservation(arg1 string) error {
+	fake.deletePortReservationMutex.Lock()
+	ret, specificReturn := fake.deletePortReservationReturnsOnCall[len(fake.deletePortReservationArgsForCall)]
+	fake.deletePortReservationArgsForCall = append(fake.deletePortReservationArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.DeletePortReservationStub
+	fakeReturns := fake.deletePortReservationReturns
+	fake.recordInvocation("DeletePortReservation", []interface{}{arg1})
+	fake.deletePortReservationMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeDB) DeletePortReservationCallCount() int {
+	fake.deletePortReservationMutex.RLock()
+	defer fake.deletePortReservationMutex.RUnlock()
+	return len(fake.deletePortReservationArgsForCall)
+}
+
+func (fake *FakeDB) DeletePortReservationCalls(stub func(string) error) {
+	fake.deletePortReservationMutex.Lock()
+	defer fake.deletePortReservationMutex.Unlock()
+	fake.DeletePortReservationStub = stub
+}
+
+func (fake *FakeDB) DeletePortReservationArgsForCall(i int) string {
+	fake.deletePortReservationMutex.RLock()
+	defer fake.deletePortReservationMutex.RUnlock()
+	argsForCall := fake.deletePortReservationArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeDB) DeletePortReservationReturns(result1 error) {
+	fake.deletePortReservationMutex.Lock()
+	defer fake.deletePortReservationMutex.Unlock()
+	fake.DeletePortReservationStub = nil
+	fake.deletePortReservationReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeDB) DeletePortReservationReturnsOnCall(i int, result1 error) {
+	fake.deletePortReservationMutex.Lock()
+	defer fake.deletePortReservationMutex.Unlock()
+	fake.DeletePortReservationStub = nil
+	if fake.deletePortReservationReturnsOnCall == nil {
+		fake.deletePortReservationReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.deletePortReservationReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeDB) DeleteRoute(arg1 models.Route) error {
 	fake.deleteRouteMutex.Lock()
 	ret, specificReturn := fake.deleteRouteReturnsOnCall[len(fake.deleteRouteArgsForCall)]
@@ -351,6 +797,70 @@ func (fake *FakeDB) DeleteRouterGroupReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeDB) DeleteRouterGroupCascade(arg1 string) (models.RouterGroupDependents, error) {
+	fake.deleteRouterGroupCascadeMutex.Lock()
+	ret, specificReturn := fake.deleteRouterGroupCascadeReturnsOnCall[len(fake.deleteRouterGroupCascadeArgsForCall)]
+	fake.deleteRouterGroupCascadeArgsForCall = append(fake.deleteRouterGroupCascadeArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.DeleteRouterGroupCascadeStub
+	fakeReturns := fake.deleteRouterGroupCascadeReturns
+	fake.recordInvocation("DeleteRouterGroupCascade", []interface{}{arg1})
+	fake.deleteRouterGroupCascadeMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeDB) DeleteRouterGroupCascadeCallCount() int {
+	fake.deleteRouterGroupCascadeMutex.RLock()
+	defer fake.deleteRouterGroupCascadeMutex.RUnlock()
+	return len(fake.deleteRouterGroupCascadeArgsForCall)
+}
+
+func (fake *FakeDB) DeleteRouterGroupCascadeCalls(stub func(string) (models.RouterGroupDependents, error)) {
+	fake.deleteRouterGroupCascadeMutex.Lock()
+	defer fake.deleteRouterGroupCascadeMutex.Unlock()
+	fake.DeleteRouterGroupCascadeStub = stub
+}
+
+func (fake *FakeDB) DeleteRouterGroupCascadeArgsForCall(i int) string {
+	fake.deleteRouterGroupCascadeMutex.RLock()
+	defer fake.deleteRouterGroupCascadeMutex.RUnlock()
+	argsForCall := fake.deleteRouterGroupCascadeArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeDB) DeleteRouterGroupCascadeReturns(result1 models.RouterGroupDependents, result2 error) {
+	fake.deleteRouterGroupCascadeMutex.Lock()
+	defer fake.deleteRouterGroupCascadeMutex.Unlock()
+	fake.DeleteRouterGroupCascadeStub = nil
+	fake.deleteRouterGroupCascadeReturns = struct {
+		result1 models.RouterGroupDependents
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDB) DeleteRouterGroupCascadeReturnsOnCall(i int, result1 models.RouterGroupDependents, result2 error) {
+	fake.deleteRouterGroupCascadeMutex.Lock()
+	defer fake.deleteRouterGroupCascadeMutex.Unlock()
+	fake.DeleteRouterGroupCascadeStub = nil
+	if fake.deleteRouterGroupCascadeReturnsOnCall == nil {
+		fake.deleteRouterGroupCascadeReturnsOnCall = make(map[int]struct {
+			result1 models.RouterGroupDependents
+			result2 error
+		})
+	}
+	fake.deleteRouterGroupCascadeReturnsOnCall[i] = struct {
+		result1 models.RouterGroupDependents
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeDB) DeleteTcpRouteMapping(arg1 models.TcpRouteMapping) error {
 	fake.deleteTcpRouteMappingMutex.Lock()
 	ret, specificReturn := fake.deleteTcpRouteMappingReturnsOnCall[len(fake.deleteTcpRouteMappingArgsForCall)]
@@ -412,27 +922,213 @@ func (fake *FakeDB) DeleteTcpRouteMappingReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
-func (fake *FakeDB) FindSimilarTcpRouteMappings(arg1 string, arg2 uint16) ([]models.TcpRouteMapping, error) {
-	fake.findSimilarTcpRouteMappingsMutex.Lock()
-	ret, specificReturn := fake.findSimilarTcpRouteMappingsReturnsOnCall[len(fake.findSimilarTcpRouteMappingsArgsForCall)]
-	fake.findSimilarTcpRouteMappingsArgsForCall = append(fake.findSimilarTcpRouteMappingsArgsForCall, struct {
+func (fake *FakeDB) DeleteTlsCertificate(arg1 string) error {
+	fake.deleteTlsCertificateMutex.Lock()
+	ret, specificReturn := fake.deleteTlsCertificateReturnsOnCall[len(fake.deleteTlsCertificateArgsForCall)]
+	fake.deleteTlsCertificateArgsForCall = append(fake.deleteTlsCertificateArgsForCall, struct {
 		arg1 string
-		arg2 uint16
-	}{arg1, arg2})
-	stub := fake.FindSimilarTcpRouteMappingsStub
-	fakeReturns := fake.findSimilarTcpRouteMappingsReturns
-	fake.recordInvocation("FindSimilarTcpRouteMappings", []interface{}{arg1, arg2})
-	fake.findSimilarTcpRouteMappingsMutex.Unlock()
+	}{arg1})
+	stub := fake.DeleteTlsCertificateStub
+	fakeReturns := fake.deleteTlsCertificateReturns
+	fake.recordInvocation("DeleteTlsCertificate", []interface{}{arg1})
+	fake.deleteTlsCertificateMutex.Unlock()
 	if stub != nil {
-		return stub(arg1, arg2)
+		return stub(arg1)
 	}
 	if specificReturn {
-		return ret.result1, ret.result2
+		return ret.result1
 	}
-	return fakeReturns.result1, fakeReturns.result2
+	return fakeReturns.result1
 }
 
-func (fake *FakeDB) FindSimilarTcpRouteMappingsCallCount() int {
+func (fake *FakeDB) DeleteTlsCertificateCallCount() int {
+	fake.deleteTlsCertificateMutex.RLock()
+	defer fake.deleteTlsCertificateMutex.RUnlock()
+	return len(fake.deleteTlsCertificateArgsForCall)
+}
+
+func (fake *FakeDB) DeleteTlsCertificateCalls(stub func(string) error) {
+	fake.deleteTlsCertificateMutex.Lock()
+	defer fake.deleteTlsCertificateMutex.Unlock()
+	fake.DeleteTlsCertificateStub = stub
+}
+
+func (fake *FakeDB) DeleteTlsCertificateArgsForCall(i int) string {
+	fake.deleteTlsCertificateMutex.RLock()
+	defer fake.deleteTlsCertificateMutex.RUnlock()
+	argsForCall := fake.deleteTlsCertificateArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeDB) DeleteTlsCertificateReturns(result1 error) {
+	fake.deleteTlsCertificateMutex.Lock()
+	defer fake.deleteTlsCertificateMutex.Unlock()
+	fake.DeleteTlsCertificateStub = nil
+	fake.deleteTlsCertificateReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeDB) DeleteTlsCertificateReturnsOnCall(i int, result1 error) {
+	fake.deleteTlsCertificateMutex.Lock()
+	defer fake.deleteTlsCertificateMutex.Unlock()
+	fake.DeleteTlsCertificateStub = nil
+	if fake.deleteTlsCertificateReturnsOnCall == nil {
+		fake.deleteTlsCertificateReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.deleteTlsCertificateReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeDB) DeleteUdpRouteMapping(arg1 models.UdpRouteMapping) error {
+	fake.deleteUdpRouteMappingMutex.Lock()
+	ret, specificReturn := fake.deleteUdpRouteMappingReturnsOnCall[len(fake.deleteUdpRouteMappingArgsForCall)]
+	fake.deleteUdpRouteMappingArgsForCall = append(fake.deleteUdpRouteMappingArgsForCall, struct {
+		arg1 models.UdpRouteMapping
+	}{arg1})
+	stub := fake.DeleteUdpRouteMappingStub
+	fakeReturns := fake.deleteUdpRouteMappingReturns
+	fake.recordInvocation("DeleteUdpRouteMapping", []interface{}{arg1})
+	fake.deleteUdpRouteMappingMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeDB) DeleteUdpRouteMappingCallCount() int {
+	fake.deleteUdpRouteMappingMutex.RLock()
+	defer fake.deleteUdpRouteMappingMutex.RUnlock()
+	return len(fake.deleteUdpRouteMappingArgsForCall)
+}
+
+func (fake *FakeDB) DeleteUdpRouteMappingCalls(stub func(models.UdpRouteMapping) error) {
+	fake.deleteUdpRouteMappingMutex.Lock()
+	defer fake.deleteUdpRouteMappingMutex.Unlock()
+	fake.DeleteUdpRouteMappingStub = stub
+}
+
+func (fake *FakeDB) DeleteUdpRouteMappingArgsForCall(i int) models.UdpRouteMapping {
+	fake.deleteUdpRouteMappingMutex.RLock()
+	defer fake.deleteUdpRouteMappingMutex.RUnlock()
+	argsForCall := fake.deleteUdpRouteMappingArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeDB) DeleteUdpRouteMappingReturns(result1 error) {
+	fake.deleteUdpRouteMappingMutex.Lock()
+	defer fake.deleteUdpRouteMappingMutex.Unlock()
+	fake.DeleteUdpRouteMappingStub = nil
+	fake.deleteUdpRouteMappingReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeDB) DeleteUdpRouteMappingReturnsOnCall(i int, result1 error) {
+	fake.deleteUdpRouteMappingMutex.Lock()
+	defer fake.deleteUdpRouteMappingMutex.Unlock()
+	fake.DeleteUdpRouteMappingStub = nil
+	if fake.deleteUdpRouteMappingReturnsOnCall == nil {
+		fake.deleteUdpRouteMappingReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.deleteUdpRouteMappingReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeDB) FindOverlappingTcpRouteMappings(arg1 models.TcpRouteMapping) ([]models.TcpRouteMapping, error) {
+	fake.findOverlappingTcpRouteMappingsMutex.Lock()
+	ret, specificReturn := fake.findOverlappingTcpRouteMappingsReturnsOnCall[len(fake.findOverlappingTcpRouteMappingsArgsForCall)]
+	fake.findOverlappingTcpRouteMappingsArgsForCall = append(fake.findOverlappingTcpRouteMappingsArgsForCall, struct {
+		arg1 models.TcpRouteMapping
+	}{arg1})
+	stub := fake.FindOverlappingTcpRouteMappingsStub
+	fakeReturns := fake.findOverlappingTcpRouteMappingsReturns
+	fake.recordInvocation("FindOverlappingTcpRouteMappings", []interface{}{arg1})
+	fake.findOverlappingTcpRouteMappingsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeDB) FindOverlappingTcpRouteMappingsCallCount() int {
+	fake.findOverlappingTcpRouteMappingsMutex.RLock()
+	defer fake.findOverlappingTcpRouteMappingsMutex.RUnlock()
+	return len(fake.findOverlappingTcpRouteMappingsArgsForCall)
+}
+
+func (fake *FakeDB) FindOverlappingTcpRouteMappingsCalls(stub func(models.TcpRouteMapping) ([]models.TcpRouteMapping, error)) {
+	fake.findOverlappingTcpRouteMappingsMutex.Lock()
+	defer fake.findOverlappingTcpRouteMappingsMutex.Unlock()
+	fake.FindOverlappingTcpRouteMappingsStub = stub
+}
+
+func (fake *FakeDB) FindOverlappingTcpRouteMappingsArgsForCall(i int) models.TcpRouteMapping {
+	fake.findOverlappingTcpRouteMappingsMutex.RLock()
+	defer fake.findOverlappingTcpRouteMappingsMutex.RUnlock()
+	argsForCall := fake.findOverlappingTcpRouteMappingsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeDB) FindOverlappingTcpRouteMappingsReturns(result1 []models.TcpRouteMapping, result2 error) {
+	fake.findOverlappingTcpRouteMappingsMutex.Lock()
+	defer fake.findOverlappingTcpRouteMappingsMutex.Unlock()
+	fake.FindOverlappingTcpRouteMappingsStub = nil
+	fake.findOverlappingTcpRouteMappingsReturns = struct {
+		result1 []models.TcpRouteMapping
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDB) FindOverlappingTcpRouteMappingsReturnsOnCall(i int, result1 []models.TcpRouteMapping, result2 error) {
+	fake.findOverlappingTcpRouteMappingsMutex.Lock()
+	defer fake.findOverlappingTcpRouteMappingsMutex.Unlock()
+	fake.FindOverlappingTcpRouteMappingsStub = nil
+	if fake.findOverlappingTcpRouteMappingsReturnsOnCall == nil {
+		fake.findOverlappingTcpRouteMappingsReturnsOnCall = make(map[int]struct {
+			result1 []models.TcpRouteMapping
+			result2 error
+		})
+	}
+	fake.findOverlappingTcpRouteMappingsReturnsOnCall[i] = struct {
+		result1 []models.TcpRouteMapping
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDB) FindSimilarTcpRouteMappings(arg1 string, arg2 uint16) ([]models.TcpRouteMapping, error) {
+	fake.findSimilarTcpRouteMappingsMutex.Lock()
+	ret, specificReturn := fake.findSimilarTcpRouteMappingsReturnsOnCall[len(fake.findSimilarTcpRouteMappingsArgsForCall)]
+	fake.findSimilarTcpRouteMappingsArgsForCall = append(fake.findSimilarTcpRouteMappingsArgsForCall, struct {
+		arg1 string
+		arg2 uint16
+	}{arg1, arg2})
+	stub := fake.FindSimilarTcpRouteMappingsStub
+	fakeReturns := fake.findSimilarTcpRouteMappingsReturns
+	fake.recordInvocation("FindSimilarTcpRouteMappings", []interface{}{arg1, arg2})
+	fake.findSimilarTcpRouteMappingsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeDB) FindSimilarTcpRouteMappingsCallCount() int {
 	fake.findSimilarTcpRouteMappingsMutex.RLock()
 	defer fake.findSimilarTcpRouteMappingsMutex.RUnlock()
 	return len(fake.findSimilarTcpRouteMappingsArgsForCall)
@@ -525,6 +1221,190 @@ func (fake *FakeDB) LockRouterGroupWritesCalls(stub func()) {
 	fake.LockRouterGroupWritesStub = stub
 }
 
+func (fake *FakeDB) ReadBackendTlsProfile(arg1 string) (models.BackendTlsProfile, error) {
+	fake.readBackendTlsProfileMutex.Lock()
+	ret, specificReturn := fake.readBackendTlsProfileReturnsOnCall[len(fake.readBackendTlsProfileArgsForCall)]
+	fake.readBackendTlsProfileArgsForCall = append(fake.readBackendTlsProfileArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.ReadBackendTlsProfileStub
+	fakeReturns := fake.readBackendTlsProfileReturns
+	fake.recordInvocation("ReadBackendTlsProfile", []interface{}{arg1})
+	fake.readBackendTlsProfileMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeDB) ReadBackendTlsProfileCallCount() int {
+	fake.readBackendTlsProfileMutex.RLock()
+	defer fake.readBackendTlsProfileMutex.RUnlock()
+	return len(fake.readBackendTlsProfileArgsForCall)
+}
+
+func (fake *FakeDB) ReadBackendTlsProfileCalls(stub func(string) (models.BackendTlsProfile, error)) {
+	fake.readBackendTlsProfileMutex.Lock()
+	defer fake.readBackendTlsProfileMutex.Unlock()
+	fake.ReadBackendTlsProfileStub = stub
+}
+
+func (fake *FakeDB) ReadBackendTlsProfileArgsForCall(i int) string {
+	fake.readBackendTlsProfileMutex.RLock()
+	defer fake.readBackendTlsProfileMutex.RUnlock()
+	argsForCall := fake.readBackendTlsProfileArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeDB) ReadBackendTlsProfileReturns(result1 models.BackendTlsProfile, result2 error) {
+	fake.readBackendTlsProfileMutex.Lock()
+	defer fake.readBackendTlsProfileMutex.Unlock()
+	fake.ReadBackendTlsProfileStub = nil
+	fake.readBackendTlsProfileReturns = struct {
+		result1 models.BackendTlsProfile
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDB) ReadBackendTlsProfileReturnsOnCall(i int, result1 models.BackendTlsProfile, result2 error) {
+	fake.readBackendTlsProfileMutex.Lock()
+	defer fake.readBackendTlsProfileMutex.Unlock()
+	fake.ReadBackendTlsProfileStub = nil
+	if fake.readBackendTlsProfileReturnsOnCall == nil {
+		fake.readBackendTlsProfileReturnsOnCall = make(map[int]struct {
+			result1 models.BackendTlsProfile
+			result2 error
+		})
+	}
+	fake.readBackendTlsProfileReturnsOnCall[i] = struct {
+		result1 models.BackendTlsProfile
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDB) ReadBackendTlsProfileByName(arg1 string) (models.BackendTlsProfile, error) {
+	fake.readBackendTlsProfileByNameMutex.Lock()
+	ret, specificReturn := fake.readBackendTlsProfileByNameReturnsOnCall[len(fake.readBackendTlsProfileByNameArgsForCall)]
+	fake.readBackendTlsProfileByNameArgsForCall = append(fake.readBackendTlsProfileByNameArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.ReadBackendTlsProfileByNameStub
+	fakeReturns := fake.readBackendTlsProfileByNameReturns
+	fake.recordInvocation("ReadBackendTlsProfileByName", []interface{}{arg1})
+	fake.readBackendTlsProfileByNameMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeDB) ReadBackendTlsProfileByNameCallCount() int {
+	fake.readBackendTlsProfileByNameMutex.RLock()
+	defer fake.readBackendTlsProfileByNameMutex.RUnlock()
+	return len(fake.readBackendTlsProfileByNameArgsForCall)
+}
+
+func (fake *FakeDB) ReadBackendTlsProfileByNameCalls(stub func(string) (models.BackendTlsProfile, error)) {
+	fake.readBackendTlsProfileByNameMutex.Lock()
+	defer fake.readBackendTlsProfileByNameMutex.Unlock()
+	fake.ReadBackendTlsProfileByNameStub = stub
+}
+
+func (fake *FakeDB) ReadBackendTlsProfileByNameArgsForCall(i int) string {
+	fake.readBackendTlsProfileByNameMutex.RLock()
+	defer fake.readBackendTlsProfileByNameMutex.RUnlock()
+	argsForCall := fake.readBackendTlsProfileByNameArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeDB) ReadBackendTlsProfileByNameReturns(result1 models.BackendTlsProfile, result2 error) {
+	fake.readBackendTlsProfileByNameMutex.Lock()
+	defer fake.readBackendTlsProfileByNameMutex.Unlock()
+	fake.ReadBackendTlsProfileByNameStub = nil
+	fake.readBackendTlsProfileByNameReturns = struct {
+		result1 models.BackendTlsProfile
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDB) ReadBackendTlsProfileByNameReturnsOnCall(i int, result1 models.BackendTlsProfile, result2 error) {
+	fake.readBackendTlsProfileByNameMutex.Lock()
+	defer fake.readBackendTlsProfileByNameMutex.Unlock()
+	fake.ReadBackendTlsProfileByNameStub = nil
+	if fake.readBackendTlsProfileByNameReturnsOnCall == nil {
+		fake.readBackendTlsProfileByNameReturnsOnCall = make(map[int]struct {
+			result1 models.BackendTlsProfile
+			result2 error
+		})
+	}
+	fake.readBackendTlsProfileByNameReturnsOnCall[i] = struct {
+		result1 models.BackendTlsProfile
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDB) ReadBackendTlsProfiles() ([]models.BackendTlsProfile, error) {
+	fake.readBackendTlsProfilesMutex.Lock()
+	ret, specificReturn := fake.readBackendTlsProfilesReturnsOnCall[len(fake.readBackendTlsProfilesArgsForCall)]
+	fake.readBackendTlsProfilesArgsForCall = append(fake.readBackendTlsProfilesArgsForCall, struct {
+	}{})
+	stub := fake.ReadBackendTlsProfilesStub
+	fakeReturns := fake.readBackendTlsProfilesReturns
+	fake.recordInvocation("ReadBackendTlsProfiles", []interface{}{})
+	fake.readBackendTlsProfilesMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeDB) ReadBackendTlsProfilesCallCount() int {
+	fake.readBackendTlsProfilesMutex.RLock()
+	defer fake.readBackendTlsProfilesMutex.RUnlock()
+	return len(fake.readBackendTlsProfilesArgsForCall)
+}
+
+func (fake *FakeDB) ReadBackendTlsProfilesCalls(stub func() ([]models.BackendTlsProfile, error)) {
+	fake.readBackendTlsProfilesMutex.Lock()
+	defer fake.readBackendTlsProfilesMutex.Unlock()
+	fake.ReadBackendTlsProfilesStub = stub
+}
+
+func (fake *FakeDB) ReadBackendTlsProfilesReturns(result1 []models.BackendTlsProfile, result2 error) {
+	fake.readBackendTlsProfilesMutex.Lock()
+	defer fake.readBackendTlsProfilesMutex.Unlock()
+	fake.ReadBackendTlsProfilesStub = nil
+	fake.readBackendTlsProfilesReturns = struct {
+		result1 []models.BackendTlsProfile
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDB) ReadBackendTlsProfilesReturnsOnCall(i int, result1 []models.BackendTlsProfile, result2 error) {
+	fake.readBackendTlsProfilesMutex.Lock()
+	defer fake.readBackendTlsProfilesMutex.Unlock()
+	fake.ReadBackendTlsProfilesStub = nil
+	if fake.readBackendTlsProfilesReturnsOnCall == nil {
+		fake.readBackendTlsProfilesReturnsOnCall = make(map[int]struct {
+			result1 []models.BackendTlsProfile
+			result2 error
+		})
+	}
+	fake.readBackendTlsProfilesReturnsOnCall[i] = struct {
+		result1 []models.BackendTlsProfile
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeDB) ReadFilteredTcpRouteMappings(arg1 string, arg2 []string) ([]models.TcpRouteMapping, error) {
 	var arg2Copy []string
 	if arg2 != nil {
@@ -542,7 +1422,204 @@ func (fake *FakeDB) ReadFilteredTcpRouteMappings(arg1 string, arg2 []string) ([]
 	fake.recordInvocation("ReadFilteredTcpRouteMappings", []interface{}{arg1, arg2Copy})
 	fake.readFilteredTcpRouteMappingsMutex.Unlock()
 	if stub != nil {
-		return stub(arg1, arg2)
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeDB) ReadFilteredTcpRouteMappingsCallCount() int {
+	fake.readFilteredTcpRouteMappingsMutex.RLock()
+	defer fake.readFilteredTcpRouteMappingsMutex.RUnlock()
+	return len(fake.readFilteredTcpRouteMappingsArgsForCall)
+}
+
+func (fake *FakeDB) ReadFilteredTcpRouteMappingsCalls(stub func(string, []string) ([]models.TcpRouteMapping, error)) {
+	fake.readFilteredTcpRouteMappingsMutex.Lock()
+	defer fake.readFilteredTcpRouteMappingsMutex.Unlock()
+	fake.ReadFilteredTcpRouteMappingsStub = stub
+}
+
+func (fake *FakeDB) ReadFilteredTcpRouteMappingsArgsForCall(i int) (string, []string) {
+	fake.readFilteredTcpRouteMappingsMutex.RLock()
+	defer fake.readFilteredTcpRouteMappingsMutex.RUnlock()
+	argsForCall := fake.readFilteredTcpRouteMappingsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeDB) ReadFilteredTcpRouteMappingsReturns(result1 []models.TcpRouteMapping, result2 error) {
+	fake.readFilteredTcpRouteMappingsMutex.Lock()
+	defer fake.readFilteredTcpRouteMappingsMutex.Unlock()
+	fake.ReadFilteredTcpRouteMappingsStub = nil
+	fake.readFilteredTcpRouteMappingsReturns = struct {
+		result1 []models.TcpRouteMapping
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDB) ReadFilteredTcpRouteMappingsReturnsOnCall(i int, result1 []models.TcpRouteMapping, result2 error) {
+	fake.readFilteredTcpRouteMappingsMutex.Lock()
+	defer fake.readFilteredTcpRouteMappingsMutex.Unlock()
+	fake.ReadFilteredTcpRouteMappingsStub = nil
+	if fake.readFilteredTcpRouteMappingsReturnsOnCall == nil {
+		fake.readFilteredTcpRouteMappingsReturnsOnCall = make(map[int]struct {
+			result1 []models.TcpRouteMapping
+			result2 error
+		})
+	}
+	fake.readFilteredTcpRouteMappingsReturnsOnCall[i] = struct {
+		result1 []models.TcpRouteMapping
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDB) ReadFilteredUdpRouteMappings(arg1 string, arg2 []string) ([]models.UdpRouteMapping, error) {
+	var arg2Copy []string
+	if arg2 != nil {
+		arg2Copy = make([]string, len(arg2))
+		copy(arg2Copy, arg2)
+	}
+	fake.readFilteredUdpRouteMappingsMutex.Lock()
+	ret, specificReturn := fake.readFilteredUdpRouteMappingsReturnsOnCall[len(fake.readFilteredUdpRouteMappingsArgsForCall)]
+	fake.readFilteredUdpRouteMappingsArgsForCall = append(fake.readFilteredUdpRouteMappingsArgsForCall, struct {
+		arg1 string
+		arg2 []string
+	}{arg1, arg2Copy})
+	stub := fake.ReadFilteredUdpRouteMappingsStub
+	fakeReturns := fake.readFilteredUdpRouteMappingsReturns
+	fake.recordInvocation("ReadFilteredUdpRouteMappings", []interface{}{arg1, arg2Copy})
+	fake.readFilteredUdpRouteMappingsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeDB) ReadFilteredUdpRouteMappingsCallCount() int {
+	fake.readFilteredUdpRouteMappingsMutex.RLock()
+	defer fake.readFilteredUdpRouteMappingsMutex.RUnlock()
+	return len(fake.readFilteredUdpRouteMappingsArgsForCall)
+}
+
+func (fake *FakeDB) ReadFilteredUdpRouteMappingsCalls(stub func(string, []string) ([]models.UdpRouteMapping, error)) {
+	fake.readFilteredUdpRouteMappingsMutex.Lock()
+	defer fake.readFilteredUdpRouteMappingsMutex.Unlock()
+	fake.ReadFilteredUdpRouteMappingsStub = stub
+}
+
+func (fake *FakeDB) ReadFilteredUdpRouteMappingsArgsForCall(i int) (string, []string) {
+	fake.readFilteredUdpRouteMappingsMutex.RLock()
+	defer fake.readFilteredUdpRouteMappingsMutex.RUnlock()
+	argsForCall := fake.readFilteredUdpRouteMappingsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeDB) ReadFilteredUdpRouteMappingsReturns(result1 []models.UdpRouteMapping, result2 error) {
+	fake.readFilteredUdpRouteMappingsMutex.Lock()
+	defer fake.readFilteredUdpRouteMappingsMutex.Unlock()
+	fake.ReadFilteredUdpRouteMappingsStub = nil
+	fake.readFilteredUdpRouteMappingsReturns = struct {
+		result1 []models.UdpRouteMapping
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDB) ReadFilteredUdpRouteMappingsReturnsOnCall(i int, result1 []models.UdpRouteMapping, result2 error) {
+	fake.readFilteredUdpRouteMappingsMutex.Lock()
+	defer fake.readFilteredUdpRouteMappingsMutex.Unlock()
+	fake.ReadFilteredUdpRouteMappingsStub = nil
+	if fake.readFilteredUdpRouteMappingsReturnsOnCall == nil {
+		fake.readFilteredUdpRouteMappingsReturnsOnCall = make(map[int]struct {
+			result1 []models.UdpRouteMapping
+			result2 error
+		})
+	}
+	fake.readFilteredUdpRouteMappingsReturnsOnCall[i] = struct {
+		result1 []models.UdpRouteMapping
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDB) ReadPortReservation(arg1 string) (models.PortReservation, error) {
+	fake.readPortReservationMutex.Lock()
+	ret, specificReturn := fake.readPortReservationReturnsOnCall[len(fake.readPortReservationArgsForCall)]
+	fake.readPortReservationArgsForCall = append(fake.readPortReservationArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.ReadPortReservationStub
+	fakeReturns := fake.readPortReservationReturns
+	fake.recordInvocation("ReadPortReservation", []interface{}{arg1})
+	fake.readPortReservationMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeDB) ReadPortReservationCallCount() int {
+	fake.readPortReservationMutex.RLock()
+	defer fake.readPortReservationMutex.RUnlock()
+	return len(fake.readPortReservationArgsForCall)
+}
+
+func (fake *FakeDB) ReadPortReservationCalls(stub func(string) (models.PortReservation, error)) {
+	fake.readPortReservationMutex.Lock()
+	defer fake.readPortReservationMutex.Unlock()
+	fake.ReadPortReservationStub = stub
+}
+
+func (fake *FakeDB) ReadPortReservationArgsForCall(i int) string {
+	fake.readPortReservationMutex.RLock()
+	defer fake.readPortReservationMutex.RUnlock()
+	argsForCall := fake.readPortReservationArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeDB) ReadPortReservationReturns(result1 models.PortReservation, result2 error) {
+	fake.readPortReservationMutex.Lock()
+	defer fake.readPortReservationMutex.Unlock()
+	fake.ReadPortReservationStub = nil
+	fake.readPortReservationReturns = struct {
+		result1 models.PortReservation
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDB) ReadPortReservationReturnsOnCall(i int, result1 models.PortReservation, result2 error) {
+	fake.readPortReservationMutex.Lock()
+	defer fake.readPortReservationMutex.Unlock()
+	fake.ReadPortReservationStub = nil
+	if fake.readPortReservationReturnsOnCall == nil {
+		fake.readPortReservationReturnsOnCall = make(map[int]struct {
+			result1 models.PortReservation
+			result2 error
+		})
+	}
+	fake.readPortReservationReturnsOnCall[i] = struct {
+		result1 models.PortReservation
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDB) ReadPortReservations() ([]models.PortReservation, error) {
+	fake.readPortReservationsMutex.Lock()
+	ret, specificReturn := fake.readPortReservationsReturnsOnCall[len(fake.readPortReservationsArgsForCall)]
+	fake.readPortReservationsArgsForCall = append(fake.readPortReservationsArgsForCall, struct {
+	}{})
+	stub := fake.ReadPortReservationsStub
+	fakeReturns := fake.readPortReservationsReturns
+	fake.recordInvocation("ReadPortReservations", []interface{}{})
+	fake.readPortReservationsMutex.Unlock()
+	if stub != nil {
+		return stub()
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
@@ -550,47 +1627,40 @@ func (fake *FakeDB) ReadFilteredTcpRouteMappings(arg1 string, arg2 []string) ([]
 	return fakeReturns.result1, fakeReturns.result2
 }
 
-func (fake *FakeDB) ReadFilteredTcpRouteMappingsCallCount() int {
-	fake.readFilteredTcpRouteMappingsMutex.RLock()
-	defer fake.readFilteredTcpRouteMappingsMutex.RUnlock()
-	return len(fake.readFilteredTcpRouteMappingsArgsForCall)
-}
-
-func (fake *FakeDB) ReadFilteredTcpRouteMappingsCalls(stub func(string, []string) ([]models.TcpRouteMapping, error)) {
-	fake.readFilteredTcpRouteMappingsMutex.Lock()
-	defer fake.readFilteredTcpRouteMappingsMutex.Unlock()
-	fake.ReadFilteredTcpRouteMappingsStub = stub
+func (fake *FakeDB) ReadPortReservationsCallCount() int {
+	fake.readPortReservationsMutex.RLock()
+	defer fake.readPortReservationsMutex.RUnlock()
+	return len(fake.readPortReservationsArgsForCall)
 }
 
-func (fake *FakeDB) ReadFilteredTcpRouteMappingsArgsForCall(i int) (string, []string) {
-	fake.readFilteredTcpRouteMappingsMutex.RLock()
-	defer fake.readFilteredTcpRouteMappingsMutex.RUnlock()
-	argsForCall := fake.readFilteredTcpRouteMappingsArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2
+func (fake *FakeDB) ReadPortReservationsCalls(stub func() ([]models.PortReservation, error)) {
+	fake.readPortReservationsMutex.Lock()
+	defer fake.readPortReservationsMutex.Unlock()
+	fake.ReadPortReservationsStub = stub
 }
 
-func (fake *FakeDB) ReadFilteredTcpRouteMappingsReturns(result1 []models.TcpRouteMapping, result2 error) {
-	fake.readFilteredTcpRouteMappingsMutex.Lock()
-	defer fake.readFilteredTcpRouteMappingsMutex.Unlock()
-	fake.ReadFilteredTcpRouteMappingsStub = nil
-	fake.readFilteredTcpRouteMappingsReturns = struct {
-		result1 []models.TcpRouteMapping
+func (fake *FakeDB) ReadPortReservationsReturns(result1 []models.PortReservation, result2 error) {
+	fake.readPortReservationsMutex.Lock()
+	defer fake.readPortReservationsMutex.Unlock()
+	fake.ReadPortReservationsStub = nil
+	fake.readPortReservationsReturns = struct {
+		result1 []models.PortReservation
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeDB) ReadFilteredTcpRouteMappingsReturnsOnCall(i int, result1 []models.TcpRouteMapping, result2 error) {
-	fake.readFilteredTcpRouteMappingsMutex.Lock()
-	defer fake.readFilteredTcpRouteMappingsMutex.Unlock()
-	fake.ReadFilteredTcpRouteMappingsStub = nil
-	if fake.readFilteredTcpRouteMappingsReturnsOnCall == nil {
-		fake.readFilteredTcpRouteMappingsReturnsOnCall = make(map[int]struct {
-			result1 []models.TcpRouteMapping
+func (fake *FakeDB) ReadPortReservationsReturnsOnCall(i int, result1 []models.PortReservation, result2 error) {
+	fake.readPortReservationsMutex.Lock()
+	defer fake.readPortReservationsMutex.Unlock()
+	fake.ReadPortReservationsStub = nil
+	if fake.readPortReservationsReturnsOnCall == nil {
+		fake.readPortReservationsReturnsOnCall = make(map[int]struct {
+			result1 []models.PortReservation
 			result2 error
 		})
 	}
-	fake.readFilteredTcpRouteMappingsReturnsOnCall[i] = struct {
-		result1 []models.TcpRouteMapping
+	fake.readPortReservationsReturnsOnCall[i] = struct {
+		result1 []models.PortReservation
 		result2 error
 	}{result1, result2}
 }
@@ -891,6 +1961,310 @@ func (fake *FakeDB) ReadTcpRouteMappingsReturnsOnCall(i int, result1 []models.Tc
 	}{result1, result2}
 }
 
+func (fake *FakeDB) ReadTlsCertificate(arg1 string) (models.TlsCertificate, error) {
+	fake.readTlsCertificateMutex.Lock()
+	ret, specificReturn := fake.readTlsCertificateReturnsOnCall[len(fake.readTlsCertificateArgsForCall)]
+	fake.readTlsCertificateArgsForCall = append(fake.readTlsCertificateArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.ReadTlsCertificateStub
+	fakeReturns := fake.readTlsCertificateReturns
+	fake.recordInvocation("ReadTlsCertificate", []interface{}{arg1})
+	fake.readTlsCertificateMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeDB) ReadTlsCertificateCallCount() int {
+	fake.readTlsCertificateMutex.RLock()
+	defer fake.readTlsCertificateMutex.RUnlock()
+	return len(fake.readTlsCertificateArgsForCall)
+}
+
+func (fake *FakeDB) ReadTlsCertificateCalls(stub func(string) (models.TlsCertificate, error)) {
+	fake.readTlsCertificateMutex.Lock()
+	defer fake.readTlsCertificateMutex.Unlock()
+	fake.ReadTlsCertificateStub = stub
+}
+
+func (fake *FakeDB) ReadTlsCertificateArgsForCall(i int) string {
+	fake.readTlsCertificateMutex.RLock()
+	defer fake.readTlsCertificateMutex.RUnlock()
+	argsForCall := fake.readTlsCertificateArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeDB) ReadTlsCertificateReturns(result1 models.TlsCertificate, result2 error) {
+	fake.readTlsCertificateMutex.Lock()
+	defer fake.readTlsCertificateMutex.Unlock()
+	fake.ReadTlsCertificateStub = nil
+	fake.readTlsCertificateReturns = struct {
+		result1 models.TlsCertificate
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDB) ReadTlsCertificateReturnsOnCall(i int, result1 models.TlsCertificate, result2 error) {
+	fake.readTlsCertificateMutex.Lock()
+	defer fake.readTlsCertificateMutex.Unlock()
+	fake.ReadTlsCertificateStub = nil
+	if fake.readTlsCertificateReturnsOnCall == nil {
+		fake.readTlsCertificateReturnsOnCall = make(map[int]struct {
+			result1 models.TlsCertificate
+			result2 error
+		})
+	}
+	fake.readTlsCertificateReturnsOnCall[i] = struct {
+		result1 models.TlsCertificate
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDB) ReadTlsCertificates() ([]models.TlsCertificate, error) {
+	fake.readTlsCertificatesMutex.Lock()
+	ret, specificReturn := fake.readTlsCertificatesReturnsOnCall[len(fake.readTlsCertificatesArgsForCall)]
+	fake.readTlsCertificatesArgsForCall = append(fake.readTlsCertificatesArgsForCall, struct {
+	}{})
+	stub := fake.ReadTlsCertificatesStub
+	fakeReturns := fake.readTlsCertificatesReturns
+	fake.recordInvocation("ReadTlsCertificates", []interface{}{})
+	fake.readTlsCertificatesMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeDB) ReadTlsCertificatesCallCount() int {
+	fake.readTlsCertificatesMutex.RLock()
+	defer fake.readTlsCertificatesMutex.RUnlock()
+	return len(fake.readTlsCertificatesArgsForCall)
+}
+
+func (fake *FakeDB) ReadTlsCertificatesCalls(stub func() ([]models.TlsCertificate, error)) {
+	fake.readTlsCertificatesMutex.Lock()
+	defer fake.readTlsCertificatesMutex.Unlock()
+	fake.ReadTlsCertificatesStub = stub
+}
+
+func (fake *FakeDB) ReadTlsCertificatesReturns(result1 []models.TlsCertificate, result2 error) {
+	fake.readTlsCertificatesMutex.Lock()
+	defer fake.readTlsCertificatesMutex.Unlock()
+	fake.ReadTlsCertificatesStub = nil
+	fake.readTlsCertificatesReturns = struct {
+		result1 []models.TlsCertificate
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDB) ReadTlsCertificatesReturnsOnCall(i int, result1 []models.TlsCertificate, result2 error) {
+	fake.readTlsCertificatesMutex.Lock()
+	defer fake.readTlsCertificatesMutex.Unlock()
+	fake.ReadTlsCertificatesStub = nil
+	if fake.readTlsCertificatesReturnsOnCall == nil {
+		fake.readTlsCertificatesReturnsOnCall = make(map[int]struct {
+			result1 []models.TlsCertificate
+			result2 error
+		})
+	}
+	fake.readTlsCertificatesReturnsOnCall[i] = struct {
+		result1 []models.TlsCertificate
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDB) ReadUdpRouteMappings() ([]models.UdpRouteMapping, error) {
+	fake.readUdpRouteMappingsMutex.Lock()
+	ret, specificReturn := fake.readUdpRouteMappingsReturnsOnCall[len(fake.readUdpRouteMappingsArgsForCall)]
+	fake.readUdpRouteMappingsArgsForCall = append(fake.readUdpRouteMappingsArgsForCall, struct {
+	}{})
+	stub := fake.ReadUdpRouteMappingsStub
+	fakeReturns := fake.readUdpRouteMappingsReturns
+	fake.recordInvocation("ReadUdpRouteMappings", []interface{}{})
+	fake.readUdpRouteMappingsMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeDB) ReadUdpRouteMappingsCallCount() int {
+	fake.readUdpRouteMappingsMutex.RLock()
+	defer fake.readUdpRouteMappingsMutex.RUnlock()
+	return len(fake.readUdpRouteMappingsArgsForCall)
+}
+
+func (fake *FakeDB) ReadUdpRouteMappingsCalls(stub func() ([]models.UdpRouteMapping, error)) {
+	fake.readUdpRouteMappingsMutex.Lock()
+	defer fake.readUdpRouteMappingsMutex.Unlock()
+	fake.ReadUdpRouteMappingsStub = stub
+}
+
+func (fake *FakeDB) ReadUdpRouteMappingsReturns(result1 []models.UdpRouteMapping, result2 error) {
+	fake.readUdpRouteMappingsMutex.Lock()
+	defer fake.readUdpRouteMappingsMutex.Unlock()
+	fake.ReadUdpRouteMappingsStub = nil
+	fake.readUdpRouteMappingsReturns = struct {
+		result1 []models.UdpRouteMapping
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDB) ReadUdpRouteMappingsReturnsOnCall(i int, result1 []models.UdpRouteMapping, result2 error) {
+	fake.readUdpRouteMappingsMutex.Lock()
+	defer fake.readUdpRouteMappingsMutex.Unlock()
+	fake.ReadUdpRouteMappingsStub = nil
+	if fake.readUdpRouteMappingsReturnsOnCall == nil {
+		fake.readUdpRouteMappingsReturnsOnCall = make(map[int]struct {
+			result1 []models.UdpRouteMapping
+			result2 error
+		})
+	}
+	fake.readUdpRouteMappingsReturnsOnCall[i] = struct {
+		result1 []models.UdpRouteMapping
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDB) SaveBackendTlsProfile(arg1 models.BackendTlsProfile) (models.BackendTlsProfile, error) {
+	fake.saveBackendTlsProfileMutex.Lock()
+	ret, specificReturn := fake.saveBackendTlsProfileReturnsOnCall[len(fake.saveBackendTlsProfileArgsForCall)]
+	fake.saveBackendTlsProfileArgsForCall = append(fake.saveBackendTlsProfileArgsForCall, struct {
+		arg1 models.BackendTlsProfile
+	}{arg1})
+	stub := fake.SaveBackendTlsProfileStub
+	fakeReturns := fake.saveBackendTlsProfileReturns
+	fake.recordInvocation("SaveBackendTlsProfile", []interface{}{arg1})
+	fake.saveBackendTlsProfileMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeDB) SaveBackendTlsProfileCallCount() int {
+	fake.saveBackendTlsProfileMutex.RLock()
+	defer fake.saveBackendTlsProfileMutex.RUnlock()
+	return len(fake.saveBackendTlsProfileArgsForCall)
+}
+
+func (fake *FakeDB) SaveBackendTlsProfileCalls(stub func(models.BackendTlsProfile) (models.BackendTlsProfile, error)) {
+	fake.saveBackendTlsProfileMutex.Lock()
+	defer fake.saveBackendTlsProfileMutex.Unlock()
+	fake.SaveBackendTlsProfileStub = stub
+}
+
+func (fake *FakeDB) SaveBackendTlsProfileArgsForCall(i int) models.BackendTlsProfile {
+	fake.saveBackendTlsProfileMutex.RLock()
+	defer fake.saveBackendTlsProfileMutex.RUnlock()
+	argsForCall := fake.saveBackendTlsProfileArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeDB) SaveBackendTlsProfileReturns(result1 models.BackendTlsProfile, result2 error) {
+	fake.saveBackendTlsProfileMutex.Lock()
+	defer fake.saveBackendTlsProfileMutex.Unlock()
+	fake.SaveBackendTlsProfileStub = nil
+	fake.saveBackendTlsProfileReturns = struct {
+		result1 models.BackendTlsProfile
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDB) SaveBackendTlsProfileReturnsOnCall(i int, result1 models.BackendTlsProfile, result2 error) {
+	fake.saveBackendTlsProfileMutex.Lock()
+	defer fake.saveBackendTlsProfileMutex.Unlock()
+	fake.SaveBackendTlsProfileStub = nil
+	if fake.saveBackendTlsProfileReturnsOnCall == nil {
+		fake.saveBackendTlsProfileReturnsOnCall = make(map[int]struct {
+			result1 models.BackendTlsProfile
+			result2 error
+		})
+	}
+	fake.saveBackendTlsProfileReturnsOnCall[i] = struct {
+		result1 models.BackendTlsProfile
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDB) SavePortReservation(arg1 models.PortReservation) (models.PortReservation, error) {
+	fake.savePortReservationMutex.Lock()
+	ret, specificReturn := fake.savePortReservationReturnsOnCall[len(fake.savePortReservationArgsForCall)]
+	fake.savePortReservationArgsForCall = append(fake.savePortReservationArgsForCall, struct {
+		arg1 models.PortReservation
+	}{arg1})
+	stub := fake.SavePortReservationStub
+	fakeReturns := fake.savePortReservationReturns
+	fake.recordInvocation("SavePortReservation", []interface{}{arg1})
+	fake.savePortReservationMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeDB) SavePortReservationCallCount() int {
+	fake.savePortReservationMutex.RLock()
+	defer fake.savePortReservationMutex.RUnlock()
+	return len(fake.savePortReservationArgsForCall)
+}
+
+func (fake *FakeDB) SavePortReservationCalls(stub func(models.PortReservation) (models.PortReservation, error)) {
+	fake.savePortReservationMutex.Lock()
+	defer fake.savePortReservationMutex.Unlock()
+	fake.SavePortReservationStub = stub
+}
+
+func (fake *FakeDB) SavePortReservationArgsForCall(i int) models.PortReservation {
+	fake.savePortReservationMutex.RLock()
+	defer fake.savePortReservationMutex.RUnlock()
+	argsForCall := fake.savePortReservationArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeDB) SavePortReservationReturns(result1 models.PortReservation, result2 error) {
+	fake.savePortReservationMutex.Lock()
+	defer fake.savePortReservationMutex.Unlock()
+	fake.SavePortReservationStub = nil
+	fake.savePortReservationReturns = struct {
+		result1 models.PortReservation
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDB) SavePortReservationReturnsOnCall(i int, result1 models.PortReservation, result2 error) {
+	fake.savePortReservationMutex.Lock()
+	defer fake.savePortReservationMutex.Unlock()
+	fake.SavePortReservationStub = nil
+	if fake.savePortReservationReturnsOnCall == nil {
+		fake.savePortReservationReturnsOnCall = make(map[int]struct {
+			result1 models.PortReservation
+			result2 error
+		})
+	}
+	fake.savePortReservationReturnsOnCall[i] = struct {
+		result1 models.PortReservation
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeDB) SaveRoute(arg1 models.Route) error {
 	fake.saveRouteMutex.Lock()
 	ret, specificReturn := fake.saveRouteReturnsOnCall[len(fake.saveRouteArgsForCall)]
@@ -1013,6 +2387,70 @@ func (fake *FakeDB) SaveRouterGroupReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeDB) SaveRouterGroupAndDeleteStrandedTcpRouteMappings(arg1 models.RouterGroup) ([]models.TcpRouteMapping, error) {
+	fake.saveRouterGroupAndDeleteStrandedTcpRouteMappingsMutex.Lock()
+	ret, specificReturn := fake.saveRouterGroupAndDeleteStrandedTcpRouteMappingsReturnsOnCall[len(fake.saveRouterGroupAndDeleteStrandedTcpRouteMappingsArgsForCall)]
+	fake.saveRouterGroupAndDeleteStrandedTcpRouteMappingsArgsForCall = append(fake.saveRouterGroupAndDeleteStrandedTcpRouteMappingsArgsForCall, struct {
+		arg1 models.RouterGroup
+	}{arg1})
+	stub := fake.SaveRouterGroupAndDeleteStrandedTcpRouteMappingsStub
+	fakeReturns := fake.saveRouterGroupAndDeleteStrandedTcpRouteMappingsReturns
+	fake.recordInvocation("SaveRouterGroupAndDeleteStrandedTcpRouteMappings", []interface{}{arg1})
+	fake.saveRouterGroupAndDeleteStrandedTcpRouteMappingsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeDB) SaveRouterGroupAndDeleteStrandedTcpRouteMappingsCallCount() int {
+	fake.saveRouterGroupAndDeleteStrandedTcpRouteMappingsMutex.RLock()
+	defer fake.saveRouterGroupAndDeleteStrandedTcpRouteMappingsMutex.RUnlock()
+	return len(fake.saveRouterGroupAndDeleteStrandedTcpRouteMappingsArgsForCall)
+}
+
+func (fake *FakeDB) SaveRouterGroupAndDeleteStrandedTcpRouteMappingsCalls(stub func(models.RouterGroup) ([]models.TcpRouteMapping, error)) {
+	fake.saveRouterGroupAndDeleteStrandedTcpRouteMappingsMutex.Lock()
+	defer fake.saveRouterGroupAndDeleteStrandedTcpRouteMappingsMutex.Unlock()
+	fake.SaveRouterGroupAndDeleteStrandedTcpRouteMappingsStub = stub
+}
+
+func (fake *FakeDB) SaveRouterGroupAndDeleteStrandedTcpRouteMappingsArgsForCall(i int) models.RouterGroup {
+	fake.saveRouterGroupAndDeleteStrandedTcpRouteMappingsMutex.RLock()
+	defer fake.saveRouterGroupAndDeleteStrandedTcpRouteMappingsMutex.RUnlock()
+	argsForCall := fake.saveRouterGroupAndDeleteStrandedTcpRouteMappingsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeDB) SaveRouterGroupAndDeleteStrandedTcpRouteMappingsReturns(result1 []models.TcpRouteMapping, result2 error) {
+	fake.saveRouterGroupAndDeleteStrandedTcpRouteMappingsMutex.Lock()
+	defer fake.saveRouterGroupAndDeleteStrandedTcpRouteMappingsMutex.Unlock()
+	fake.SaveRouterGroupAndDeleteStrandedTcpRouteMappingsStub = nil
+	fake.saveRouterGroupAndDeleteStrandedTcpRouteMappingsReturns = struct {
+		result1 []models.TcpRouteMapping
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDB) SaveRouterGroupAndDeleteStrandedTcpRouteMappingsReturnsOnCall(i int, result1 []models.TcpRouteMapping, result2 error) {
+	fake.saveRouterGroupAndDeleteStrandedTcpRouteMappingsMutex.Lock()
+	defer fake.saveRouterGroupAndDeleteStrandedTcpRouteMappingsMutex.Unlock()
+	fake.SaveRouterGroupAndDeleteStrandedTcpRouteMappingsStub = nil
+	if fake.saveRouterGroupAndDeleteStrandedTcpRouteMappingsReturnsOnCall == nil {
+		fake.saveRouterGroupAndDeleteStrandedTcpRouteMappingsReturnsOnCall = make(map[int]struct {
+			result1 []models.TcpRouteMapping
+			result2 error
+		})
+	}
+	fake.saveRouterGroupAndDeleteStrandedTcpRouteMappingsReturnsOnCall[i] = struct {
+		result1 []models.TcpRouteMapping
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeDB) SaveTcpRouteMapping(arg1 models.TcpRouteMapping) error {
 	fake.saveTcpRouteMappingMutex.Lock()
 	ret, specificReturn := fake.saveTcpRouteMappingReturnsOnCall[len(fake.saveTcpRouteMappingArgsForCall)]
@@ -1074,6 +2512,131 @@ func (fake *FakeDB) SaveTcpRouteMappingReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeDB) SaveTlsCertificate(arg1 models.TlsCertificate) (models.TlsCertificate, error) {
+	fake.saveTlsCertificateMutex.Lock()
+	ret, specificReturn := fake.saveTlsCertificateReturnsOnCall[len(fake.saveTlsCertificateArgsForCall)]
+	fake.saveTlsCertificateArgsForCall = append(fake.saveTlsCertificateArgsForCall, struct {
+		arg1 models.TlsCertificate
+	}{arg1})
+	stub := fake.SaveTlsCertificateStub
+	fakeReturns := fake.saveTlsCertificateReturns
+	fake.recordInvocation("SaveTlsCertificate", []interface{}{arg1})
+	fake.saveTlsCertificateMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeDB) SaveTlsCertificateCallCount() int {
+	fake.saveTlsCertificateMutex.RLock()
+	defer fake.saveTlsCertificateMutex.RUnlock()
+	return len(fake.saveTlsCertificateArgsForCall)
+}
+
+func (fake *FakeDB) SaveTlsCertificateCalls(stub func(models.TlsCertificate) (models.TlsCertificate, error)) {
+	fake.saveTlsCertificateMutex.Lock()
+	defer fake.saveTlsCertificateMutex.Unlock()
+	fake.SaveTlsCertificateStub = stub
+}
+
+func (fake *FakeDB) SaveTlsCertificateArgsForCall(i int) models.TlsCertificate {
+	fake.saveTlsCertificateMutex.RLock()
+	defer fake.saveTlsCertificateMutex.RUnlock()
+	argsForCall := fake.saveTlsCertificateArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeDB) SaveTlsCertificateReturns(result1 models.TlsCertificate, result2 error) {
+	fake.saveTlsCertificateMutex.Lock()
+	defer fake.saveTlsCertificateMutex.Unlock()
+	fake.SaveTlsCertificateStub = nil
+	fake.saveTlsCertificateReturns = struct {
+		result1 models.TlsCertificate
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDB) SaveTlsCertificateReturnsOnCall(i int, result1 models.TlsCertificate, result2 error) {
+	fake.saveTlsCertificateMutex.Lock()
+	defer fake.saveTlsCertificateMutex.Unlock()
+	fake.SaveTlsCertificateStub = nil
+	if fake.saveTlsCertificateReturnsOnCall == nil {
+		fake.saveTlsCertificateReturnsOnCall = make(map[int]struct {
+			result1 models.TlsCertificate
+			result2 error
+		})
+	}
+	fake.saveTlsCertificateReturnsOnCall[i] = struct {
+		result1 models.TlsCertificate
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDB) SaveUdpRouteMapping(arg1 models.UdpRouteMapping) error {
+	fake.saveUdpRouteMappingMutex.Lock()
+	ret, specificReturn := fake.saveUdpRouteMappingReturnsOnCall[len(fake.saveUdpRouteMappingArgsForCall)]
+	fake.saveUdpRouteMappingArgsForCall = append(fake.saveUdpRouteMappingArgsForCall, struct {
+		arg1 models.UdpRouteMapping
+	}{arg1})
+	stub := fake.SaveUdpRouteMappingStub
+	fakeReturns := fake.saveUdpRouteMappingReturns
+	fake.recordInvocation("SaveUdpRouteMapping", []interface{}{arg1})
+	fake.saveUdpRouteMappingMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeDB) SaveUdpRouteMappingCallCount() int {
+	fake.saveUdpRouteMappingMutex.RLock()
+	defer fake.saveUdpRouteMappingMutex.RUnlock()
+	return len(fake.saveUdpRouteMappingArgsForCall)
+}
+
+func (fake *FakeDB) SaveUdpRouteMappingCalls(stub func(models.UdpRouteMapping) error) {
+	fake.saveUdpRouteMappingMutex.Lock()
+	defer fake.saveUdpRouteMappingMutex.Unlock()
+	fake.SaveUdpRouteMappingStub = stub
+}
+
+func (fake *FakeDB) SaveUdpRouteMappingArgsForCall(i int) models.UdpRouteMapping {
+	fake.saveUdpRouteMappingMutex.RLock()
+	defer fake.saveUdpRouteMappingMutex.RUnlock()
+	argsForCall := fake.saveUdpRouteMappingArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeDB) SaveUdpRouteMappingReturns(result1 error) {
+	fake.saveUdpRouteMappingMutex.Lock()
+	defer fake.saveUdpRouteMappingMutex.Unlock()
+	fake.SaveUdpRouteMappingStub = nil
+	fake.saveUdpRouteMappingReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeDB) SaveUdpRouteMappingReturnsOnCall(i int, result1 error) {
+	fake.saveUdpRouteMappingMutex.Lock()
+	defer fake.saveUdpRouteMappingMutex.Unlock()
+	fake.SaveUdpRouteMappingStub = nil
+	if fake.saveUdpRouteMappingReturnsOnCall == nil {
+		fake.saveUdpRouteMappingReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.saveUdpRouteMappingReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeDB) UnlockRouterGroupReads() {
 	fake.unlockRouterGroupReadsMutex.Lock()
 	fake.unlockRouterGroupReadsArgsForCall = append(fake.unlockRouterGroupReadsArgsForCall, struct {
@@ -1192,22 +2755,48 @@ func (fake *FakeDB) WatchChangesReturnsOnCall(i int, result1 <-chan db.Event, re
 func (fake *FakeDB) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.allocateTcpRouteMappingMutex.RLock()
+	defer fake.allocateTcpRouteMappingMutex.RUnlock()
 	fake.cancelWatchesMutex.RLock()
 	defer fake.cancelWatchesMutex.RUnlock()
+	fake.deleteBackendTlsProfileMutex.RLock()
+	defer fake.deleteBackendTlsProfileMutex.RUnlock()
+	fake.deletePortReservationMutex.RLock()
+	defer fake.deletePortReservationMutex.RUnlock()
 	fake.deleteRouteMutex.RLock()
 	defer fake.deleteRouteMutex.RUnlock()
 	fake.deleteRouterGroupMutex.RLock()
 	defer fake.deleteRouterGroupMutex.RUnlock()
+	fake.deleteRouterGroupCascadeMutex.RLock()
+	defer fake.deleteRouterGroupCascadeMutex.RUnlock()
 	fake.deleteTcpRouteMappingMutex.RLock()
 	defer fake.deleteTcpRouteMappingMutex.RUnlock()
+	fake.deleteTlsCertificateMutex.RLock()
+	defer fake.deleteTlsCertificateMutex.RUnlock()
+	fake.deleteUdpRouteMappingMutex.RLock()
+	defer fake.deleteUdpRouteMappingMutex.RUnlock()
+	fake.findOverlappingTcpRouteMappingsMutex.RLock()
+	defer fake.findOverlappingTcpRouteMappingsMutex.RUnlock()
 	fake.findSimilarTcpRouteMappingsMutex.RLock()
 	defer fake.findSimilarTcpRouteMappingsMutex.RUnlock()
 	fake.lockRouterGroupReadsMutex.RLock()
 	defer fake.lockRouterGroupReadsMutex.RUnlock()
 	fake.lockRouterGroupWritesMutex.RLock()
 	defer fake.lockRouterGroupWritesMutex.RUnlock()
+	fake.readBackendTlsProfileMutex.RLock()
+	defer fake.readBackendTlsProfileMutex.RUnlock()
+	fake.readBackendTlsProfileByNameMutex.RLock()
+	defer fake.readBackendTlsProfileByNameMutex.RUnlock()
+	fake.readBackendTlsProfilesMutex.RLock()
+	defer fake.readBackendTlsProfilesMutex.RUnlock()
 	fake.readFilteredTcpRouteMappingsMutex.RLock()
 	defer fake.readFilteredTcpRouteMappingsMutex.RUnlock()
+	fake.readFilteredUdpRouteMappingsMutex.RLock()
+	defer fake.readFilteredUdpRouteMappingsMutex.RUnlock()
+	fake.readPortReservationMutex.RLock()
+	defer fake.readPortReservationMutex.RUnlock()
+	fake.readPortReservationsMutex.RLock()
+	defer fake.readPortReservationsMutex.RUnlock()
 	fake.readRouterGroupMutex.RLock()
 	defer fake.readRouterGroupMutex.RUnlock()
 	fake.readRouterGroupByNameMutex.RLock()
@@ -1218,12 +2807,28 @@ func (fake *FakeDB) Invocations() map[string][][]interface{} {
 	defer fake.readRoutesMutex.RUnlock()
 	fake.readTcpRouteMappingsMutex.RLock()
 	defer fake.readTcpRouteMappingsMutex.RUnlock()
+	fake.readTlsCertificateMutex.RLock()
+	defer fake.readTlsCertificateMutex.RUnlock()
+	fake.readTlsCertificatesMutex.RLock()
+	defer fake.readTlsCertificatesMutex.RUnlock()
+	fake.readUdpRouteMappingsMutex.RLock()
+	defer fake.readUdpRouteMappingsMutex.RUnlock()
+	fake.saveBackendTlsProfileMutex.RLock()
+	defer fake.saveBackendTlsProfileMutex.RUnlock()
+	fake.savePortReservationMutex.RLock()
+	defer fake.savePortReservationMutex.RUnlock()
 	fake.saveRouteMutex.RLock()
 	defer fake.saveRouteMutex.RUnlock()
 	fake.saveRouterGroupMutex.RLock()
 	defer fake.saveRouterGroupMutex.RUnlock()
+	fake.saveRouterGroupAndDeleteStrandedTcpRouteMappingsMutex.RLock()
+	defer fake.saveRouterGroupAndDeleteStrandedTcpRouteMappingsMutex.RUnlock()
 	fake.saveTcpRouteMappingMutex.RLock()
 	defer fake.saveTcpRouteMappingMutex.RUnlock()
+	fake.saveTlsCertificateMutex.RLock()
+	defer fake.saveTlsCertificateMutex.RUnlock()
+	fake.saveUdpRouteMappingMutex.RLock()
+	defer fake.saveUdpRouteMappingMutex.RUnlock()
 	fake.unlockRouterGroupReadsMutex.RLock()
 	defer fake.unlockRouterGroupReadsMutex.RUnlock()
 	fake.unlockRouterGroupWritesMutex.RLock()
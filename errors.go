@@ -18,15 +18,19 @@ func NewError(errType Type, message string) Error {
 }
 
 const (
-	ResponseError               Type = "ResponseError"
-	ResourceNotFoundError       Type = "ResourceNotFoundError"
-	ProcessRequestError         Type = "ProcessRequestError"
-	RouteInvalidError           Type = "RouteInvalidError"
-	RouteServiceUrlInvalidError Type = "RouteServiceUrlInvalidError"
-	DBCommunicationError        Type = "DBCommunicationError"
-	GuidGenerationError         Type = "GuidGenerationError"
-	UnauthorizedError           Type = "UnauthorizedError"
-	TcpRouteMappingInvalidError Type = "TcpRouteMappingInvalidError"
-	DBConflictError             Type = "DBConflictError"
-	PortRangeExhaustedError     Type = "PortRangeExhaustedError"
+	ResponseError                 Type = "ResponseError"
+	ResourceNotFoundError         Type = "ResourceNotFoundError"
+	ProcessRequestError           Type = "ProcessRequestError"
+	RouteInvalidError             Type = "RouteInvalidError"
+	RouteServiceUrlInvalidError   Type = "RouteServiceUrlInvalidError"
+	DBCommunicationError          Type = "DBCommunicationError"
+	GuidGenerationError           Type = "GuidGenerationError"
+	UnauthorizedError             Type = "UnauthorizedError"
+	TcpRouteMappingInvalidError   Type = "TcpRouteMappingInvalidError"
+	UdpRouteMappingInvalidError   Type = "UdpRouteMappingInvalidError"
+	DBConflictError               Type = "DBConflictError"
+	PortRangeExhaustedError       Type = "PortRangeExhaustedError"
+	RouterGroupPortsInUseError    Type = "RouterGroupPortsInUseError"
+	RouterGroupInUseError         Type = "RouterGroupInUseError"
+	RouterGroupQuotaExceededError Type = "RouterGroupQuotaExceededError"
 )
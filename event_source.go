@@ -55,6 +55,27 @@ func NewTcpEventSource(raw RawEventSource) TcpEventSource {
 	}
 }
 
+//go:generate counterfeiter -o fake_routing_api/fake_udp_event_source.go . UdpEventSource
+type UdpEventSource interface {
+	Next() (UdpEvent, error)
+	Close() error
+}
+
+type UdpEvent struct {
+	UdpRouteMapping models.UdpRouteMapping
+	Action          string
+}
+
+type udpEventSource struct {
+	rawEventSource RawEventSource
+}
+
+func NewUdpEventSource(raw RawEventSource) UdpEventSource {
+	return &udpEventSource{
+		rawEventSource: raw,
+	}
+}
+
 func (e *eventSource) Next() (Event, error) {
 	rawEvent, err := e.rawEventSource.Next()
 	if err != nil {
@@ -95,6 +116,26 @@ func (e *tcpEventSource) Close() error {
 	return doClose(e.rawEventSource)
 }
 
+func (e *udpEventSource) Next() (UdpEvent, error) {
+	rawEvent, err := e.rawEventSource.Next()
+	if err != nil {
+		return UdpEvent{}, err
+	}
+
+	trace.DumpJSON("EVENT", rawEvent)
+
+	event, err := convertRawToUdpEvent(rawEvent)
+	if err != nil {
+		return UdpEvent{}, err
+	}
+
+	return event, nil
+}
+
+func (e *udpEventSource) Close() error {
+	return doClose(e.rawEventSource)
+}
+
 func doClose(rawEventSource RawEventSource) error {
 	err := rawEventSource.Close()
 	if err != nil {
@@ -125,3 +166,14 @@ func convertRawToTcpEvent(event sse.Event) (TcpEvent, error) {
 
 	return TcpEvent{Action: event.Name, TcpRouteMapping: route}, nil
 }
+
+func convertRawToUdpEvent(event sse.Event) (UdpEvent, error) {
+	var route models.UdpRouteMapping
+
+	err := json.Unmarshal(event.Data, &route)
+	if err != nil {
+		return UdpEvent{}, err
+	}
+
+	return UdpEvent{Action: event.Name, UdpRouteMapping: route}, nil
+}
@@ -227,4 +227,58 @@ var _ = Describe("EventSource", func() {
 			})
 		})
 	})
+
+	Describe("Udp events", func() {
+		var udpEventSource routing_api.UdpEventSource
+
+		BeforeEach(func() {
+			udpEventSource = routing_api.NewUdpEventSource(fakeRawEventSource)
+		})
+
+		Describe("Next", func() {
+			It("returns the error when the event source returns an error", func() {
+				fakeRawEventSource.NextReturns(sse.Event{}, errors.New("boom"))
+				_, err := udpEventSource.Next()
+				Expect(err.Error()).To(Equal("boom"))
+			})
+
+			It("returns the unmarshalled event", func() {
+				rawEvent := sse.Event{
+					ID:    "1",
+					Name:  "Test",
+					Data:  []byte(`{"router_group_guid": "rguid1", "port":5300, "backend_port":53,"backend_ip":"1.1.1.1","modification_tag":{"guid":"my-guid","index":5},"instance_id":"instance-id"}`),
+					Retry: 1,
+				}
+
+				modTag := models.ModificationTag{
+					Guid:  "my-guid",
+					Index: 5,
+				}
+				udpMapping := models.NewUdpRouteMapping("rguid1", 5300, "1.1.1.1", 53, "instance-id", 5, modTag)
+				udpMapping.TTL = nil
+
+				fakeRawEventSource.NextReturns(rawEvent, nil)
+				event, err := udpEventSource.Next()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(event).To(Equal(routing_api.UdpEvent{
+					UdpRouteMapping: udpMapping,
+					Action:          "Test",
+				}))
+			})
+
+			It("returns the error when the event has invalid json", func() {
+				fakeRawEventSource.NextReturns(sse.Event{ID: "1", Name: "Invalid", Data: []byte("This isn't valid json")}, nil)
+				_, err := udpEventSource.Next()
+				Expect(err).To(HaveOccurred())
+			})
+		})
+
+		Describe("Close", func() {
+			It("closes the event source", func() {
+				err := udpEventSource.Close()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(fakeRawEventSource.CloseCallCount()).To(Equal(1))
+			})
+		})
+	})
 })
@@ -9,6 +9,57 @@ import (
 )
 
 type FakeClient struct {
+	BackendTlsProfileStub        func(string) (models.BackendTlsProfile, error)
+	backendTlsProfileMutex       sync.RWMutex
+	backendTlsProfileArgsForCall []struct {
+		arg1 string
+	}
+	backendTlsProfileReturns struct {
+		result1 models.BackendTlsProfile
+		result2 error
+	}
+	backendTlsProfileReturnsOnCall map[int]struct {
+		result1 models.BackendTlsProfile
+		result2 error
+	}
+	BackendTlsProfilesStub        func() ([]models.BackendTlsProfile, error)
+	backendTlsProfilesMutex       sync.RWMutex
+	backendTlsProfilesArgsForCall []struct {
+	}
+	backendTlsProfilesReturns struct {
+		result1 []models.BackendTlsProfile
+		result2 error
+	}
+	backendTlsProfilesReturnsOnCall map[int]struct {
+		result1 []models.BackendTlsProfile
+		result2 error
+	}
+	CreateBackendTlsProfileStub        func(models.BackendTlsProfile) (models.BackendTlsProfile, error)
+	createBackendTlsProfileMutex       sync.RWMutex
+	createBackendTlsProfileArgsForCall []struct {
+		arg1 models.BackendTlsProfile
+	}
+	createBackendTlsProfileReturns struct {
+		result1 models.BackendTlsProfile
+		result2 error
+	}
+	createBackendTlsProfileReturnsOnCall map[int]struct {
+		result1 models.BackendTlsProfile
+		result2 error
+	}
+	CreatePortReservationStub        func(models.PortReservation) (models.PortReservation, error)
+	createPortReservationMutex       sync.RWMutex
+	createPortReservationArgsForCall []struct {
+		arg1 models.PortReservation
+	}
+	createPortReservationReturns struct {
+		result1 models.PortReservation
+		result2 error
+	}
+	createPortReservationReturnsOnCall map[int]struct {
+		result1 models.PortReservation
+		result2 error
+	}
 	CreateRouterGroupStub        func(models.RouterGroup) error
 	createRouterGroupMutex       sync.RWMutex
 	createRouterGroupArgsForCall []struct {
@@ -20,6 +71,43 @@ type FakeClient struct {
 	createRouterGroupReturnsOnCall map[int]struct {
 		result1 error
 	}
+	CreateTcpRouteMappingsStub        func([]models.TcpRouteMapping) ([]models.TcpRouteMapping, error)
+	createTcpRouteMappingsMutex       sync.RWMutex
+	createTcpRouteMappingsArgsForCall []struct {
+		arg1 []models.TcpRouteMapping
+	}
+	createTcpRouteMappingsReturns struct {
+		result1 []models.TcpRouteMapping
+		result2 error
+	}
+	createTcpRouteMappingsReturnsOnCall map[int]struct {
+		result1 []models.TcpRouteMapping
+		result2 error
+	}
+	CreateTlsCertificateStub        func(models.TlsCertificate) (models.TlsCertificate, error)
+	createTlsCertificateMutex       sync.RWMutex
+	createTlsCertificateArgsForCall []struct {
+		arg1 models.TlsCertificate
+	}
+	createTlsCertificateReturns struct {
+		result1 models.TlsCertificate
+		result2 error
+	}
+	createTlsCertificateReturnsOnCall map[int]struct {
+		result1 models.TlsCertificate
+		result2 error
+	}
+	DeleteBackendTlsProfileStub        func(string) error
+	deleteBackendTlsProfileMutex       sync.RWMutex
+	deleteBackendTlsProfileArgsForCall []struct {
+		arg1 string
+	}
+	deleteBackendTlsProfileReturns struct {
+		result1 error
+	}
+	deleteBackendTlsProfileReturnsOnCall map[int]struct {
+		result1 error
+	}
 	DeleteRouterGroupStub        func(models.RouterGroup) error
 	deleteRouterGroupMutex       sync.RWMutex
 	deleteRouterGroupArgsForCall []struct {
@@ -53,6 +141,28 @@ type FakeClient struct {
 	deleteTcpRouteMappingsReturnsOnCall map[int]struct {
 		result1 error
 	}
+	DeleteTlsCertificateStub        func(string) error
+	deleteTlsCertificateMutex       sync.RWMutex
+	deleteTlsCertificateArgsForCall []struct {
+		arg1 string
+	}
+	deleteTlsCertificateReturns struct {
+		result1 error
+	}
+	deleteTlsCertificateReturnsOnCall map[int]struct {
+		result1 error
+	}
+	DeleteUdpRouteMappingsStub        func([]models.UdpRouteMapping) error
+	deleteUdpRouteMappingsMutex       sync.RWMutex
+	deleteUdpRouteMappingsArgsForCall []struct {
+		arg1 []models.UdpRouteMapping
+	}
+	deleteUdpRouteMappingsReturns struct {
+		result1 error
+	}
+	deleteUdpRouteMappingsReturnsOnCall map[int]struct {
+		result1 error
+	}
 	FilteredTcpRouteMappingsStub        func([]string) ([]models.TcpRouteMapping, error)
 	filteredTcpRouteMappingsMutex       sync.RWMutex
 	filteredTcpRouteMappingsArgsForCall []struct {
@@ -66,6 +176,55 @@ type FakeClient struct {
 		result1 []models.TcpRouteMapping
 		result2 error
 	}
+	FilteredUdpRouteMappingsStub        func([]string) ([]models.UdpRouteMapping, error)
+	filteredUdpRouteMappingsMutex       sync.RWMutex
+	filteredUdpRouteMappingsArgsForCall []struct {
+		arg1 []string
+	}
+	filteredUdpRouteMappingsReturns struct {
+		result1 []models.UdpRouteMapping
+		result2 error
+	}
+	filteredUdpRouteMappingsReturnsOnCall map[int]struct {
+		result1 []models.UdpRouteMapping
+		result2 error
+	}
+	PortReservationStub        func(string) (models.PortReservation, error)
+	portReservationMutex       sync.RWMutex
+	portReservationArgsForCall []struct {
+		arg1 string
+	}
+	portReservationReturns struct {
+		result1 models.PortReservation
+		result2 error
+	}
+	portReservationReturnsOnCall map[int]struct {
+		result1 models.PortReservation
+		result2 error
+	}
+	PortReservationsStub        func() ([]models.PortReservation, error)
+	portReservationsMutex       sync.RWMutex
+	portReservationsArgsForCall []struct {
+	}
+	portReservationsReturns struct {
+		result1 []models.PortReservation
+		result2 error
+	}
+	portReservationsReturnsOnCall map[int]struct {
+		result1 []models.PortReservation
+		result2 error
+	}
+	ReleasePortReservationStub        func(string) error
+	releasePortReservationMutex       sync.RWMutex
+	releasePortReservationArgsForCall []struct {
+		arg1 string
+	}
+	releasePortReservationReturns struct {
+		result1 error
+	}
+	releasePortReservationReturnsOnCall map[int]struct {
+		result1 error
+	}
 	ReservePortStub        func(string, string) (int, error)
 	reservePortMutex       sync.RWMutex
 	reservePortArgsForCall []struct {
@@ -80,6 +239,32 @@ type FakeClient struct {
 		result1 int
 		result2 error
 	}
+	RouterGroupStub        func(string) (models.RouterGroup, error)
+	routerGroupMutex       sync.RWMutex
+	routerGroupArgsForCall []struct {
+		arg1 string
+	}
+	routerGroupReturns struct {
+		result1 models.RouterGroup
+		result2 error
+	}
+	routerGroupReturnsOnCall map[int]struct {
+		result1 models.RouterGroup
+		result2 error
+	}
+	RouterGroupPortsStub        func(string) (models.PortOccupancy, error)
+	routerGroupPortsMutex       sync.RWMutex
+	routerGroupPortsArgsForCall []struct {
+		arg1 string
+	}
+	routerGroupPortsReturns struct {
+		result1 models.PortOccupancy
+		result2 error
+	}
+	routerGroupPortsReturnsOnCall map[int]struct {
+		result1 models.PortOccupancy
+		result2 error
+	}
 	RouterGroupWithNameStub        func(string) (models.RouterGroup, error)
 	routerGroupWithNameMutex       sync.RWMutex
 	routerGroupWithNameArgsForCall []struct {
@@ -172,6 +357,31 @@ type FakeClient struct {
 		result1 routing_api.TcpEventSource
 		result2 error
 	}
+	SubscribeToUdpEventsStub        func() (routing_api.UdpEventSource, error)
+	subscribeToUdpEventsMutex       sync.RWMutex
+	subscribeToUdpEventsArgsForCall []struct {
+	}
+	subscribeToUdpEventsReturns struct {
+		result1 routing_api.UdpEventSource
+		result2 error
+	}
+	subscribeToUdpEventsReturnsOnCall map[int]struct {
+		result1 routing_api.UdpEventSource
+		result2 error
+	}
+	SubscribeToUdpEventsWithMaxRetriesStub        func(uint16) (routing_api.UdpEventSource, error)
+	subscribeToUdpEventsWithMaxRetriesMutex       sync.RWMutex
+	subscribeToUdpEventsWithMaxRetriesArgsForCall []struct {
+		arg1 uint16
+	}
+	subscribeToUdpEventsWithMaxRetriesReturns struct {
+		result1 routing_api.UdpEventSource
+		result2 error
+	}
+	subscribeToUdpEventsWithMaxRetriesReturnsOnCall map[int]struct {
+		result1 routing_api.UdpEventSource
+		result2 error
+	}
 	TcpRouteMappingsStub        func() ([]models.TcpRouteMapping, error)
 	tcpRouteMappingsMutex       sync.RWMutex
 	tcpRouteMappingsArgsForCall []struct {
@@ -184,6 +394,56 @@ type FakeClient struct {
 		result1 []models.TcpRouteMapping
 		result2 error
 	}
+	TlsCertificateStub        func(string) (models.TlsCertificate, error)
+	tlsCertificateMutex       sync.RWMutex
+	tlsCertificateArgsForCall []struct {
+		arg1 string
+	}
+	tlsCertificateReturns struct {
+		result1 models.TlsCertificate
+		result2 error
+	}
+	tlsCertificateReturnsOnCall map[int]struct {
+		result1 models.TlsCertificate
+		result2 error
+	}
+	TlsCertificatesStub        func() ([]models.TlsCertificate, error)
+	tlsCertificatesMutex       sync.RWMutex
+	tlsCertificatesArgsForCall []struct {
+	}
+	tlsCertificatesReturns struct {
+		result1 []models.TlsCertificate
+		result2 error
+	}
+	tlsCertificatesReturnsOnCall map[int]struct {
+		result1 []models.TlsCertificate
+		result2 error
+	}
+	UdpRouteMappingsStub        func() ([]models.UdpRouteMapping, error)
+	udpRouteMappingsMutex       sync.RWMutex
+	udpRouteMappingsArgsForCall []struct {
+	}
+	udpRouteMappingsReturns struct {
+		result1 []models.UdpRouteMapping
+		result2 error
+	}
+	udpRouteMappingsReturnsOnCall map[int]struct {
+		result1 []models.UdpRouteMapping
+		result2 error
+	}
+	UpdateBackendTlsProfileStub        func(models.BackendTlsProfile) (models.BackendTlsProfile, error)
+	updateBackendTlsProfileMutex       sync.RWMutex
+	updateBackendTlsProfileArgsForCall []struct {
+		arg1 models.BackendTlsProfile
+	}
+	updateBackendTlsProfileReturns struct {
+		result1 models.BackendTlsProfile
+		result2 error
+	}
+	updateBackendTlsProfileReturnsOnCall map[int]struct {
+		result1 models.BackendTlsProfile
+		result2 error
+	}
 	UpdateRouterGroupStub        func(models.RouterGroup) error
 	updateRouterGroupMutex       sync.RWMutex
 	updateRouterGroupArgsForCall []struct {
@@ -195,6 +455,19 @@ type FakeClient struct {
 	updateRouterGroupReturnsOnCall map[int]struct {
 		result1 error
 	}
+	UpdateTlsCertificateStub        func(models.TlsCertificate) (models.TlsCertificate, error)
+	updateTlsCertificateMutex       sync.RWMutex
+	updateTlsCertificateArgsForCall []struct {
+		arg1 models.TlsCertificate
+	}
+	updateTlsCertificateReturns struct {
+		result1 models.TlsCertificate
+		result2 error
+	}
+	updateTlsCertificateReturnsOnCall map[int]struct {
+		result1 models.TlsCertificate
+		result2 error
+	}
 	UpsertRoutesStub        func([]models.Route) error
 	upsertRoutesMutex       sync.RWMutex
 	upsertRoutesArgsForCall []struct {
@@ -217,10 +490,269 @@ type FakeClient struct {
 	upsertTcpRouteMappingsReturnsOnCall map[int]struct {
 		result1 error
 	}
+	UpsertUdpRouteMappingsStub        func([]models.UdpRouteMapping) error
+	upsertUdpRouteMappingsMutex       sync.RWMutex
+	upsertUdpRouteMappingsArgsForCall []struct {
+		arg1 []models.UdpRouteMapping
+	}
+	upsertUdpRouteMappingsReturns struct {
+		result1 error
+	}
+	upsertUdpRouteMappingsReturnsOnCall map[int]struct {
+		result1 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
 
+func (fake *FakeClient) BackendTlsProfile(arg1 string) (models.BackendTlsProfile, error) {
+	fake.backendTlsProfileMutex.Lock()
+	ret, specificReturn := fake.backendTlsProfileReturnsOnCall[len(fake.backendTlsProfileArgsForCall)]
+	fake.backendTlsProfileArgsForCall = append(fake.backendTlsProfileArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.BackendTlsProfileStub
+	fakeReturns := fake.backendTlsProfileReturns
+	fake.recordInvocation("BackendTlsProfile", []interface{}{arg1})
+	fake.backendTlsProfileMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) BackendTlsProfileCallCount() int {
+	fake.backendTlsProfileMutex.RLock()
+	defer fake.backendTlsProfileMutex.RUnlock()
+	return len(fake.backendTlsProfileArgsForCall)
+}
+
+func (fake *FakeClient) BackendTlsProfileCalls(stub func(string) (models.BackendTlsProfile, error)) {
+	fake.backendTlsProfileMutex.Lock()
+	defer fake.backendTlsProfileMutex.Unlock()
+	fake.BackendTlsProfileStub = stub
+}
+
+func (fake *FakeClient) BackendTlsProfileArgsForCall(i int) string {
+	fake.backendTlsProfileMutex.RLock()
+	defer fake.backendTlsProfileMutex.RUnlock()
+	argsForCall := fake.backendTlsProfileArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeClient) BackendTlsProfileReturns(result1 models.BackendTlsProfile, result2 error) {
+	fake.backendTlsProfileMutex.Lock()
+	defer fake.backendTlsProfileMutex.Unlock()
+	fake.BackendTlsProfileStub = nil
+	fake.backendTlsProfileReturns = struct {
+		result1 models.BackendTlsProfile
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) BackendTlsProfileReturnsOnCall(i int, result1 models.BackendTlsProfile, result2 error) {
+	fake.backendTlsProfileMutex.Lock()
+	defer fake.backendTlsProfileMutex.Unlock()
+	fake.BackendTlsProfileStub = nil
+	if fake.backendTlsProfileReturnsOnCall == nil {
+		fake.backendTlsProfileReturnsOnCall = make(map[int]struct {
+			result1 models.BackendTlsProfile
+			result2 error
+		})
+	}
+	fake.backendTlsProfileReturnsOnCall[i] = struct {
+		result1 models.BackendTlsProfile
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) BackendTlsProfiles() ([]models.BackendTlsProfile, error) {
+	fake.backendTlsProfilesMutex.Lock()
+	ret, specificReturn := fake.backendTlsProfilesReturnsOnCall[len(fake.backendTlsProfilesArgsForCall)]
+	fake.backendTlsProfilesArgsForCall = append(fake.backendTlsProfilesArgsForCall, struct {
+	}{})
+	stub := fake.BackendTlsProfilesStub
+	fakeReturns := fake.backendTlsProfilesReturns
+	fake.recordInvocation("BackendTlsProfiles", []interface{}{})
+	fake.backendTlsProfilesMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) BackendTlsProfilesCallCount() int {
+	fake.backendTlsProfilesMutex.RLock()
+	defer fake.backendTlsProfilesMutex.RUnlock()
+	return len(fake.backendTlsProfilesArgsForCall)
+}
+
+func (fake *FakeClient) BackendTlsProfilesCalls(stub func() ([]models.BackendTlsProfile, error)) {
+	fake.backendTlsProfilesMutex.Lock()
+	defer fake.backendTlsProfilesMutex.Unlock()
+	fake.BackendTlsProfilesStub = stub
+}
+
+func (fake *FakeClient) BackendTlsProfilesReturns(result1 []models.BackendTlsProfile, result2 error) {
+	fake.backendTlsProfilesMutex.Lock()
+	defer fake.backendTlsProfilesMutex.Unlock()
+	fake.BackendTlsProfilesStub = nil
+	fake.backendTlsProfilesReturns = struct {
+		result1 []models.BackendTlsProfile
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) BackendTlsProfilesReturnsOnCall(i int, result1 []models.BackendTlsProfile, result2 error) {
+	fake.backendTlsProfilesMutex.Lock()
+	defer fake.backendTlsProfilesMutex.Unlock()
+	fake.BackendTlsProfilesStub = nil
+	if fake.backendTlsProfilesReturnsOnCall == nil {
+		fake.backendTlsProfilesReturnsOnCall = make(map[int]struct {
+			result1 []models.BackendTlsProfile
+			result2 error
+		})
+	}
+	fake.backendTlsProfilesReturnsOnCall[i] = struct {
+		result1 []models.BackendTlsProfile
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) CreateBackendTlsProfile(arg1 models.BackendTlsProfile) (models.BackendTlsProfile, error) {
+	fake.createBackendTlsProfileMutex.Lock()
+	ret, specificReturn := fake.createBackendTlsProfileReturnsOnCall[len(fake.createBackendTlsProfileArgsForCall)]
+	fake.createBackendTlsProfileArgsForCall = append(fake.createBackendTlsProfileArgsForCall, struct {
+		arg1 models.BackendTlsProfile
+	}{arg1})
+	stub := fake.CreateBackendTlsProfileStub
+	fakeReturns := fake.createBackendTlsProfileReturns
+	fake.recordInvocation("CreateBackendTlsProfile", []interface{}{arg1})
+	fake.createBackendTlsProfileMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) CreateBackendTlsProfileCallCount() int {
+	fake.createBackendTlsProfileMutex.RLock()
+	defer fake.createBackendTlsProfileMutex.RUnlock()
+	return len(fake.createBackendTlsProfileArgsForCall)
+}
+
+func (fake *FakeClient) CreateBackendTlsProfileCalls(stub func(models.BackendTlsProfile) (models.BackendTlsProfile, error)) {
+	fake.createBackendTlsProfileMutex.Lock()
+	defer fake.createBackendTlsProfileMutex.Unlock()
+	fake.CreateBackendTlsProfileStub = stub
+}
+
+func (fake *FakeClient) CreateBackendTlsProfileArgsForCall(i int) models.BackendTlsProfile {
+	fake.createBackendTlsProfileMutex.RLock()
+	defer fake.createBackendTlsProfileMutex.RUnlock()
+	argsForCall := fake.createBackendTlsProfileArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeClient) CreateBackendTlsProfileReturns(result1 models.BackendTlsProfile, result2 error) {
+	fake.createBackendTlsProfileMutex.Lock()
+	defer fake.createBackendTlsProfileMutex.Unlock()
+	fake.CreateBackendTlsProfileStub = nil
+	fake.createBackendTlsProfileReturns = struct {
+		result1 models.BackendTlsProfile
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) CreateBackendTlsProfileReturnsOnCall(i int, result1 models.BackendTlsProfile, result2 error) {
+	fake.createBackendTlsProfileMutex.Lock()
+	defer fake.createBackendTlsProfileMutex.Unlock()
+	fake.CreateBackendTlsProfileStub = nil
+	if fake.createBackendTlsProfileReturnsOnCall == nil {
+		fake.createBackendTlsProfileReturnsOnCall = make(map[int]struct {
+			result1 models.BackendTlsProfile
+			result2 error
+		})
+	}
+	fake.createBackendTlsProfileReturnsOnCall[i] = struct {
+		result1 models.BackendTlsProfile
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) CreatePortReservation(arg1 models.PortReservation) (models.PortReservation, error) {
+	fake.createPortReservationMutex.Lock()
+	ret, specificReturn := fake.createPortReservationReturnsOnCall[len(fake.createPortReservationArgsForCall)]
+	fake.createPortReservationArgsForCall = append(fake.createPortReservationArgsForCall, struct {
+		arg1 models.PortReservation
+	}{arg1})
+	stub := fake.CreatePortReservationStub
+	fakeReturns := fake.createPortReservationReturns
+	fake.recordInvocation("CreatePortReservation", []interface{}{arg1})
+	fake.createPortReservationMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) CreatePortReservationCallCount() int {
+	fake.createPortReservationMutex.RLock()
+	defer fake.createPortReservationMutex.RUnlock()
+	return len(fake.createPortReservationArgsForCall)
+}
+
+func (fake *FakeClient) CreatePortReservationCalls(stub func(models.PortReservation) (models.PortReservation, error)) {
+	fake.createPortReservationMutex.Lock()
+	defer fake.createPortReservationMutex.Unlock()
+	fake.CreatePortReservationStub = stub
+}
+
+func (fake *FakeClient) CreatePortReservationArgsForCall(i int) models.PortReservation {
+	fake.createPortReservationMutex.RLock()
+	defer fake.createPortReservationMutex.RUnlock()
+	argsForCall := fake.createPortReservationArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeClient) CreatePortReservationReturns(result1 models.PortReservation, result2 error) {
+	fake.createPortReservationMutex.Lock()
+	defer fake.createPortReservationMutex.Unlock()
+	fake.CreatePortReservationStub = nil
+	fake.createPortReservationReturns = struct {
+		result1 models.PortReservation
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) CreatePortReservationReturnsOnCall(i int, result1 models.PortReservation, result2 error) {
+	fake.createPortReservationMutex.Lock()
+	defer fake.createPortReservationMutex.Unlock()
+	fake.CreatePortReservationStub = nil
+	if fake.createPortReservationReturnsOnCall == nil {
+		fake.createPortReservationReturnsOnCall = make(map[int]struct {
+			result1 models.PortReservation
+			result2 error
+		})
+	}
+	fake.createPortReservationReturnsOnCall[i] = struct {
+		result1 models.PortReservation
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeClient) CreateRouterGroup(arg1 models.RouterGroup) error {
 	fake.createRouterGroupMutex.Lock()
 	ret, specificReturn := fake.createRouterGroupReturnsOnCall[len(fake.createRouterGroupArgsForCall)]
@@ -282,18 +814,212 @@ func (fake *FakeClient) CreateRouterGroupReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
-func (fake *FakeClient) DeleteRouterGroup(arg1 models.RouterGroup) error {
-	fake.deleteRouterGroupMutex.Lock()
-	ret, specificReturn := fake.deleteRouterGroupReturnsOnCall[len(fake.deleteRouterGroupArgsForCall)]
-	fake.deleteRouterGroupArgsForCall = append(fake.deleteRouterGroupArgsForCall, struct {
-		arg1 models.RouterGroup
-	}{arg1})
-	stub := fake.DeleteRouterGroupStub
-	fakeReturns := fake.deleteRouterGroupReturns
-	fake.recordInvocation("DeleteRouterGroup", []interface{}{arg1})
-	fake.deleteRouterGroupMutex.Unlock()
-	if stub != nil {
-		return stub(arg1)
+func (fake *FakeClient) CreateTcpRouteMappings(arg1 []models.TcpRouteMapping) ([]models.TcpRouteMapping, error) {
+	var arg1Copy []models.TcpRouteMapping
+	if arg1 != nil {
+		arg1Copy = make([]models.TcpRouteMapping, len(arg1))
+		copy(arg1Copy, arg1)
+	}
+	fake.createTcpRouteMappingsMutex.Lock()
+	ret, specificReturn := fake.createTcpRouteMappingsReturnsOnCall[len(fake.createTcpRouteMappingsArgsForCall)]
+	fake.createTcpRouteMappingsArgsForCall = append(fake.createTcpRouteMappingsArgsForCall, struct {
+		arg1 []models.TcpRouteMapping
+	}{arg1Copy})
+	stub := fake.CreateTcpRouteMappingsStub
+	fakeReturns := fake.createTcpRouteMappingsReturns
+	fake.recordInvocation("CreateTcpRouteMappings", []interface{}{arg1Copy})
+	fake.createTcpRouteMappingsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) CreateTcpRouteMappingsCallCount() int {
+	fake.createTcpRouteMappingsMutex.RLock()
+	defer fake.createTcpRouteMappingsMutex.RUnlock()
+	return len(fake.createTcpRouteMappingsArgsForCall)
+}
+
+func (fake *FakeClient) CreateTcpRouteMappingsCalls(stub func([]models.TcpRouteMapping) ([]models.TcpRouteMapping, error)) {
+	fake.createTcpRouteMappingsMutex.Lock()
+	defer fake.createTcpRouteMappingsMutex.Unlock()
+	fake.CreateTcpRouteMappingsStub = stub
+}
+
+func (fake *FakeClient) CreateTcpRouteMappingsArgsForCall(i int) []models.TcpRouteMapping {
+	fake.createTcpRouteMappingsMutex.RLock()
+	defer fake.createTcpRouteMappingsMutex.RUnlock()
+	argsForCall := fake.createTcpRouteMappingsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeClient) CreateTcpRouteMappingsReturns(result1 []models.TcpRouteMapping, result2 error) {
+	fake.createTcpRouteMappingsMutex.Lock()
+	defer fake.createTcpRouteMappingsMutex.Unlock()
+	fake.CreateTcpRouteMappingsStub = nil
+	fake.createTcpRouteMappingsReturns = struct {
+		result1 []models.TcpRouteMapping
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) CreateTcpRouteMappingsReturnsOnCall(i int, result1 []models.TcpRouteMapping, result2 error) {
+	fake.createTcpRouteMappingsMutex.Lock()
+	defer fake.createTcpRouteMappingsMutex.Unlock()
+	fake.CreateTcpRouteMappingsStub = nil
+	if fake.createTcpRouteMappingsReturnsOnCall == nil {
+		fake.createTcpRouteMappingsReturnsOnCall = make(map[int]struct {
+			result1 []models.TcpRouteMapping
+			result2 error
+		})
+	}
+	fake.createTcpRouteMappingsReturnsOnCall[i] = struct {
+		result1 []models.TcpRouteMapping
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) CreateTlsCertificate(arg1 models.TlsCertificate) (models.TlsCertificate, error) {
+	fake.createTlsCertificateMutex.Lock()
+	ret, specificReturn := fake.createTlsCertificateReturnsOnCall[len(fake.createTlsCertificateArgsForCall)]
+	fake.createTlsCertificateArgsForCall = append(fake.createTlsCertificateArgsForCall, struct {
+		arg1 models.TlsCertificate
+	}{arg1})
+	stub := fake.CreateTlsCertificateStub
+	fakeReturns := fake.createTlsCertificateReturns
+	fake.recordInvocation("CreateTlsCertificate", []interface{}{arg1})
+	fake.createTlsCertificateMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) CreateTlsCertificateCallCount() int {
+	fake.createTlsCertificateMutex.RLock()
+	defer fake.createTlsCertificateMutex.RUnlock()
+	return len(fake.createTlsCertificateArgsForCall)
+}
+
+func (fake *FakeClient) CreateTlsCertificateCalls(stub func(models.TlsCertificate) (models.TlsCertificate, error)) {
+	fake.createTlsCertificateMutex.Lock()
+	defer fake.createTlsCertificateMutex.Unlock()
+	fake.CreateTlsCertificateStub = stub
+}
+
+func (fake *FakeClient) CreateTlsCertificateArgsForCall(i int) models.TlsCertificate {
+	fake.createTlsCertificateMutex.RLock()
+	defer fake.createTlsCertificateMutex.RUnlock()
+	argsForCall := fake.createTlsCertificateArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeClient) CreateTlsCertificateReturns(result1 models.TlsCertificate, result2 error) {
+	fake.createTlsCertificateMutex.Lock()
+	defer fake.createTlsCertificateMutex.Unlock()
+	fake.CreateTlsCertificateStub = nil
+	fake.createTlsCertificateReturns = struct {
+		result1 models.TlsCertificate
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) CreateTlsCertificateReturnsOnCall(i int, result1 models.TlsCertificate, result2 error) {
+	fake.createTlsCertificateMutex.Lock()
+	defer fake.createTlsCertificateMutex.Unlock()
+	fake.CreateTlsCertificateStub = nil
+	if fake.createTlsCertificateReturnsOnCall == nil {
+		fake.createTlsCertificateReturnsOnCall = make(map[int]struct {
+			result1 models.TlsCertificate
+			result2 error
+		})
+	}
+	fake.createTlsCertificateReturnsOnCall[i] = struct {
+		result1 models.TlsCertificate
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) DeleteBackendTlsProfile(arg1 string) error {
+	fake.deleteBackendTlsProfileMutex.Lock()
+	ret, specificReturn := fake.deleteBackendTlsProfileReturnsOnCall[len(fake.deleteBackendTlsProfileArgsForCall)]
+	fake.deleteBackendTlsProfileArgsForCall = append(fake.deleteBackendTlsProfileArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.DeleteBackendTlsProfileStub
+	fakeReturns := fake.deleteBackendTlsProfileReturns
+	fake.recordInvocation("DeleteBackendTlsProfile", []interface{}{arg1})
+	fake.deleteBackendTlsProfileMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeClient) DeleteBackendTlsProfileCallCount() int {
+	fake.deleteBackendTlsProfileMutex.RLock()
+	defer fake.deleteBackendTlsProfileMutex.RUnlock()
+	return len(fake.deleteBackendTlsProfileArgsForCall)
+}
+
+func (fake *FakeClient) DeleteBackendTlsProfileCalls(stub func(string) error) {
+	fake.deleteBackendTlsProfileMutex.Lock()
+	defer fake.deleteBackendTlsProfileMutex.Unlock()
+	fake.DeleteBackendTlsProfileStub = stub
+}
+
+func (fake *FakeClient) DeleteBackendTlsProfileArgsForCall(i int) string {
+	fake.deleteBackendTlsProfileMutex.RLock()
+	defer fake.deleteBackendTlsProfileMutex.RUnlock()
+	argsForCall := fake.deleteBackendTlsProfileArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeClient) DeleteBackendTlsProfileReturns(result1 error) {
+	fake.deleteBackendTlsProfileMutex.Lock()
+	defer fake.deleteBackendTlsProfileMutex.Unlock()
+	fake.DeleteBackendTlsProfileStub = nil
+	fake.deleteBackendTlsProfileReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeClient) DeleteBackendTlsProfileReturnsOnCall(i int, result1 error) {
+	fake.deleteBackendTlsProfileMutex.Lock()
+	defer fake.deleteBackendTlsProfileMutex.Unlock()
+	fake.DeleteBackendTlsProfileStub = nil
+	if fake.deleteBackendTlsProfileReturnsOnCall == nil {
+		fake.deleteBackendTlsProfileReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.deleteBackendTlsProfileReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeClient) DeleteRouterGroup(arg1 models.RouterGroup) error {
+	fake.deleteRouterGroupMutex.Lock()
+	ret, specificReturn := fake.deleteRouterGroupReturnsOnCall[len(fake.deleteRouterGroupArgsForCall)]
+	fake.deleteRouterGroupArgsForCall = append(fake.deleteRouterGroupArgsForCall, struct {
+		arg1 models.RouterGroup
+	}{arg1})
+	stub := fake.DeleteRouterGroupStub
+	fakeReturns := fake.deleteRouterGroupReturns
+	fake.recordInvocation("DeleteRouterGroup", []interface{}{arg1})
+	fake.deleteRouterGroupMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1
@@ -475,6 +1201,133 @@ func (fake *FakeClient) DeleteTcpRouteMappingsReturnsOnCall(i int, result1 error
 	}{result1}
 }
 
+func (fake *FakeClient) DeleteTlsCertificate(arg1 string) error {
+	fake.deleteTlsCertificateMutex.Lock()
+	ret, specificReturn := fake.deleteTlsCertificateReturnsOnCall[len(fake.deleteTlsCertificateArgsForCall)]
+	fake.deleteTlsCertificateArgsForCall = append(fake.deleteTlsCertificateArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.DeleteTlsCertificateStub
+	fakeReturns := fake.deleteTlsCertificateReturns
+	fake.recordInvocation("DeleteTlsCertificate", []interface{}{arg1})
+	fake.deleteTlsCertificateMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeClient) DeleteTlsCertificateCallCount() int {
+	fake.deleteTlsCertificateMutex.RLock()
+	defer fake.deleteTlsCertificateMutex.RUnlock()
+	return len(fake.deleteTlsCertificateArgsForCall)
+}
+
+func (fake *FakeClient) DeleteTlsCertificateCalls(stub func(string) error) {
+	fake.deleteTlsCertificateMutex.Lock()
+	defer fake.deleteTlsCertificateMutex.Unlock()
+	fake.DeleteTlsCertificateStub = stub
+}
+
+func (fake *FakeClient) DeleteTlsCertificateArgsForCall(i int) string {
+	fake.deleteTlsCertificateMutex.RLock()
+	defer fake.deleteTlsCertificateMutex.RUnlock()
+	argsForCall := fake.deleteTlsCertificateArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeClient) DeleteTlsCertificateReturns(result1 error) {
+	fake.deleteTlsCertificateMutex.Lock()
+	defer fake.deleteTlsCertificateMutex.Unlock()
+	fake.DeleteTlsCertificateStub = nil
+	fake.deleteTlsCertificateReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeClient) DeleteTlsCertificateReturnsOnCall(i int, result1 error) {
+	fake.deleteTlsCertificateMutex.Lock()
+	defer fake.deleteTlsCertificateMutex.Unlock()
+	fake.DeleteTlsCertificateStub = nil
+	if fake.deleteTlsCertificateReturnsOnCall == nil {
+		fake.deleteTlsCertificateReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.deleteTlsCertificateReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeClient) DeleteUdpRouteMappings(arg1 []models.UdpRouteMapping) error {
+	var arg1Copy []models.UdpRouteMapping
+	if arg1 != nil {
+		arg1Copy = make([]models.UdpRouteMapping, len(arg1))
+		copy(arg1Copy, arg1)
+	}
+	fake.deleteUdpRouteMappingsMutex.Lock()
+	ret, specificReturn := fake.deleteUdpRouteMappingsReturnsOnCall[len(fake.deleteUdpRouteMappingsArgsForCall)]
+	fake.deleteUdpRouteMappingsArgsForCall = append(fake.deleteUdpRouteMappingsArgsForCall, struct {
+		arg1 []models.UdpRouteMapping
+	}{arg1Copy})
+	stub := fake.DeleteUdpRouteMappingsStub
+	fakeReturns := fake.deleteUdpRouteMappingsReturns
+	fake.recordInvocation("DeleteUdpRouteMappings", []interface{}{arg1Copy})
+	fake.deleteUdpRouteMappingsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeClient) DeleteUdpRouteMappingsCallCount() int {
+	fake.deleteUdpRouteMappingsMutex.RLock()
+	defer fake.deleteUdpRouteMappingsMutex.RUnlock()
+	return len(fake.deleteUdpRouteMappingsArgsForCall)
+}
+
+func (fake *FakeClient) DeleteUdpRouteMappingsCalls(stub func([]models.UdpRouteMapping) error) {
+	fake.deleteUdpRouteMappingsMutex.Lock()
+	defer fake.deleteUdpRouteMappingsMutex.Unlock()
+	fake.DeleteUdpRouteMappingsStub = stub
+}
+
+func (fake *FakeClient) DeleteUdpRouteMappingsArgsForCall(i int) []models.UdpRouteMapping {
+	fake.deleteUdpRouteMappingsMutex.RLock()
+	defer fake.deleteUdpRouteMappingsMutex.RUnlock()
+	argsForCall := fake.deleteUdpRouteMappingsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeClient) DeleteUdpRouteMappingsReturns(result1 error) {
+	fake.deleteUdpRouteMappingsMutex.Lock()
+	defer fake.deleteUdpRouteMappingsMutex.Unlock()
+	fake.DeleteUdpRouteMappingsStub = nil
+	fake.deleteUdpRouteMappingsReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeClient) DeleteUdpRouteMappingsReturnsOnCall(i int, result1 error) {
+	fake.deleteUdpRouteMappingsMutex.Lock()
+	defer fake.deleteUdpRouteMappingsMutex.Unlock()
+	fake.DeleteUdpRouteMappingsStub = nil
+	if fake.deleteUdpRouteMappingsReturnsOnCall == nil {
+		fake.deleteUdpRouteMappingsReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.deleteUdpRouteMappingsReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeClient) FilteredTcpRouteMappings(arg1 []string) ([]models.TcpRouteMapping, error) {
 	var arg1Copy []string
 	if arg1 != nil {
@@ -544,6 +1397,256 @@ func (fake *FakeClient) FilteredTcpRouteMappingsReturnsOnCall(i int, result1 []m
 	}{result1, result2}
 }
 
+func (fake *FakeClient) FilteredUdpRouteMappings(arg1 []string) ([]models.UdpRouteMapping, error) {
+	var arg1Copy []string
+	if arg1 != nil {
+		arg1Copy = make([]string, len(arg1))
+		copy(arg1Copy, arg1)
+	}
+	fake.filteredUdpRouteMappingsMutex.Lock()
+	ret, specificReturn := fake.filteredUdpRouteMappingsReturnsOnCall[len(fake.filteredUdpRouteMappingsArgsForCall)]
+	fake.filteredUdpRouteMappingsArgsForCall = append(fake.filteredUdpRouteMappingsArgsForCall, struct {
+		arg1 []string
+	}{arg1Copy})
+	stub := fake.FilteredUdpRouteMappingsStub
+	fakeReturns := fake.filteredUdpRouteMappingsReturns
+	fake.recordInvocation("FilteredUdpRouteMappings", []interface{}{arg1Copy})
+	fake.filteredUdpRouteMappingsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) FilteredUdpRouteMappingsCallCount() int {
+	fake.filteredUdpRouteMappingsMutex.RLock()
+	defer fake.filteredUdpRouteMappingsMutex.RUnlock()
+	return len(fake.filteredUdpRouteMappingsArgsForCall)
+}
+
+func (fake *FakeClient) FilteredUdpRouteMappingsCalls(stub func([]string) ([]models.UdpRouteMapping, error)) {
+	fake.filteredUdpRouteMappingsMutex.Lock()
+	defer fake.filteredUdpRouteMappingsMutex.Unlock()
+	fake.FilteredUdpRouteMappingsStub = stub
+}
+
+func (fake *FakeClient) FilteredUdpRouteMappingsArgsForCall(i int) []string {
+	fake.filteredUdpRouteMappingsMutex.RLock()
+	defer fake.filteredUdpRouteMappingsMutex.RUnlock()
+	argsForCall := fake.filteredUdpRouteMappingsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeClient) FilteredUdpRouteMappingsReturns(result1 []models.UdpRouteMapping, result2 error) {
+	fake.filteredUdpRouteMappingsMutex.Lock()
+	defer fake.filteredUdpRouteMappingsMutex.Unlock()
+	fake.FilteredUdpRouteMappingsStub = nil
+	fake.filteredUdpRouteMappingsReturns = struct {
+		result1 []models.UdpRouteMapping
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) FilteredUdpRouteMappingsReturnsOnCall(i int, result1 []models.UdpRouteMapping, result2 error) {
+	fake.filteredUdpRouteMappingsMutex.Lock()
+	defer fake.filteredUdpRouteMappingsMutex.Unlock()
+	fake.FilteredUdpRouteMappingsStub = nil
+	if fake.filteredUdpRouteMappingsReturnsOnCall == nil {
+		fake.filteredUdpRouteMappingsReturnsOnCall = make(map[int]struct {
+			result1 []models.UdpRouteMapping
+			result2 error
+		})
+	}
+	fake.filteredUdpRouteMappingsReturnsOnCall[i] = struct {
+		result1 []models.UdpRouteMapping
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) PortReservation(arg1 string) (models.PortReservation, error) {
+	fake.portReservationMutex.Lock()
+	ret, specificReturn := fake.portReservationReturnsOnCall[len(fake.portReservationArgsForCall)]
+	fake.portReservationArgsForCall = append(fake.portReservationArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.PortReservationStub
+	fakeReturns := fake.portReservationReturns
+	fake.recordInvocation("PortReservation", []interface{}{arg1})
+	fake.portReservationMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) PortReservationCallCount() int {
+	fake.portReservationMutex.RLock()
+	defer fake.portReservationMutex.RUnlock()
+	return len(fake.portReservationArgsForCall)
+}
+
+func (fake *FakeClient) PortReservationCalls(stub func(string) (models.PortReservation, error)) {
+	fake.portReservationMutex.Lock()
+	defer fake.portReservationMutex.Unlock()
+	fake.PortReservationStub = stub
+}
+
+func (fake *FakeClient) PortReservationArgsForCall(i int) string {
+	fake.portReservationMutex.RLock()
+	defer fake.portReservationMutex.RUnlock()
+	argsForCall := fake.portReservationArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeClient) PortReservationReturns(result1 models.PortReservation, result2 error) {
+	fake.portReservationMutex.Lock()
+	defer fake.portReservationMutex.Unlock()
+	fake.PortReservationStub = nil
+	fake.portReservationReturns = struct {
+		result1 models.PortReservation
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) PortReservationReturnsOnCall(i int, result1 models.PortReservation, result2 error) {
+	fake.portReservationMutex.Lock()
+	defer fake.portReservationMutex.Unlock()
+	fake.PortReservationStub = nil
+	if fake.portReservationReturnsOnCall == nil {
+		fake.portReservationReturnsOnCall = make(map[int]struct {
+			result1 models.PortReservation
+			result2 error
+		})
+	}
+	fake.portReservationReturnsOnCall[i] = struct {
+		result1 models.PortReservation
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) PortReservations() ([]models.PortReservation, error) {
+	fake.portReservationsMutex.Lock()
+	ret, specificReturn := fake.portReservationsReturnsOnCall[len(fake.portReservationsArgsForCall)]
+	fake.portReservationsArgsForCall = append(fake.portReservationsArgsForCall, struct {
+	}{})
+	stub := fake.PortReservationsStub
+	fakeReturns := fake.portReservationsReturns
+	fake.recordInvocation("PortReservations", []interface{}{})
+	fake.portReservationsMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) PortReservationsCallCount() int {
+	fake.portReservationsMutex.RLock()
+	defer fake.portReservationsMutex.RUnlock()
+	return len(fake.portReservationsArgsForCall)
+}
+
+func (fake *FakeClient) PortReservationsCalls(stub func() ([]models.PortReservation, error)) {
+	fake.portReservationsMutex.Lock()
+	defer fake.portReservationsMutex.Unlock()
+	fake.PortReservationsStub = stub
+}
+
+func (fake *FakeClient) PortReservationsReturns(result1 []models.PortReservation, result2 error) {
+	fake.portReservationsMutex.Lock()
+	defer fake.portReservationsMutex.Unlock()
+	fake.PortReservationsStub = nil
+	fake.portReservationsReturns = struct {
+		result1 []models.PortReservation
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) PortReservationsReturnsOnCall(i int, result1 []models.PortReservation, result2 error) {
+	fake.portReservationsMutex.Lock()
+	defer fake.portReservationsMutex.Unlock()
+	fake.PortReservationsStub = nil
+	if fake.portReservationsReturnsOnCall == nil {
+		fake.portReservationsReturnsOnCall = make(map[int]struct {
+			result1 []models.PortReservation
+			result2 error
+		})
+	}
+	fake.portReservationsReturnsOnCall[i] = struct {
+		result1 []models.PortReservation
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) ReleasePortReservation(arg1 string) error {
+	fake.releasePortReservationMutex.Lock()
+	ret, specificReturn := fake.releasePortReservationReturnsOnCall[len(fake.releasePortReservationArgsForCall)]
+	fake.releasePortReservationArgsForCall = append(fake.releasePortReservationArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.ReleasePortReservationStub
+	fakeReturns := fake.releasePortReservationReturns
+	fake.recordInvocation("ReleasePortReservation", []interface{}{arg1})
+	fake.releasePortReservationMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeClient) ReleasePortReservationCallCount() int {
+	fake.releasePortReservationMutex.RLock()
+	defer fake.releasePortReservationMutex.RUnlock()
+	return len(fake.releasePortReservationArgsForCall)
+}
+
+func (fake *FakeClient) ReleasePortReservationCalls(stub func(string) error) {
+	fake.releasePortReservationMutex.Lock()
+	defer fake.releasePortReservationMutex.Unlock()
+	fake.ReleasePortReservationStub = stub
+}
+
+func (fake *FakeClient) ReleasePortReservationArgsForCall(i int) string {
+	fake.releasePortReservationMutex.RLock()
+	defer fake.releasePortReservationMutex.RUnlock()
+	argsForCall := fake.releasePortReservationArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeClient) ReleasePortReservationReturns(result1 error) {
+	fake.releasePortReservationMutex.Lock()
+	defer fake.releasePortReservationMutex.Unlock()
+	fake.ReleasePortReservationStub = nil
+	fake.releasePortReservationReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeClient) ReleasePortReservationReturnsOnCall(i int, result1 error) {
+	fake.releasePortReservationMutex.Lock()
+	defer fake.releasePortReservationMutex.Unlock()
+	fake.ReleasePortReservationStub = nil
+	if fake.releasePortReservationReturnsOnCall == nil {
+		fake.releasePortReservationReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.releasePortReservationReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeClient) ReservePort(arg1 string, arg2 string) (int, error) {
 	fake.reservePortMutex.Lock()
 	ret, specificReturn := fake.reservePortReturnsOnCall[len(fake.reservePortArgsForCall)]
@@ -556,7 +1659,135 @@ func (fake *FakeClient) ReservePort(arg1 string, arg2 string) (int, error) {
 	fake.recordInvocation("ReservePort", []interface{}{arg1, arg2})
 	fake.reservePortMutex.Unlock()
 	if stub != nil {
-		return stub(arg1, arg2)
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) ReservePortCallCount() int {
+	fake.reservePortMutex.RLock()
+	defer fake.reservePortMutex.RUnlock()
+	return len(fake.reservePortArgsForCall)
+}
+
+func (fake *FakeClient) ReservePortCalls(stub func(string, string) (int, error)) {
+	fake.reservePortMutex.Lock()
+	defer fake.reservePortMutex.Unlock()
+	fake.ReservePortStub = stub
+}
+
+func (fake *FakeClient) ReservePortArgsForCall(i int) (string, string) {
+	fake.reservePortMutex.RLock()
+	defer fake.reservePortMutex.RUnlock()
+	argsForCall := fake.reservePortArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeClient) ReservePortReturns(result1 int, result2 error) {
+	fake.reservePortMutex.Lock()
+	defer fake.reservePortMutex.Unlock()
+	fake.ReservePortStub = nil
+	fake.reservePortReturns = struct {
+		result1 int
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) ReservePortReturnsOnCall(i int, result1 int, result2 error) {
+	fake.reservePortMutex.Lock()
+	defer fake.reservePortMutex.Unlock()
+	fake.ReservePortStub = nil
+	if fake.reservePortReturnsOnCall == nil {
+		fake.reservePortReturnsOnCall = make(map[int]struct {
+			result1 int
+			result2 error
+		})
+	}
+	fake.reservePortReturnsOnCall[i] = struct {
+		result1 int
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) RouterGroup(arg1 string) (models.RouterGroup, error) {
+	fake.routerGroupMutex.Lock()
+	ret, specificReturn := fake.routerGroupReturnsOnCall[len(fake.routerGroupArgsForCall)]
+	fake.routerGroupArgsForCall = append(fake.routerGroupArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.RouterGroupStub
+	fakeReturns := fake.routerGroupReturns
+	fake.recordInvocation("RouterGroup", []interface{}{arg1})
+	fake.routerGroupMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) RouterGroupCallCount() int {
+	fake.routerGroupMutex.RLock()
+	defer fake.routerGroupMutex.RUnlock()
+	return len(fake.routerGroupArgsForCall)
+}
+
+func (fake *FakeClient) RouterGroupCalls(stub func(string) (models.RouterGroup, error)) {
+	fake.routerGroupMutex.Lock()
+	defer fake.routerGroupMutex.Unlock()
+	fake.RouterGroupStub = stub
+}
+
+func (fake *FakeClient) RouterGroupArgsForCall(i int) string {
+	fake.routerGroupMutex.RLock()
+	defer fake.routerGroupMutex.RUnlock()
+	argsForCall := fake.routerGroupArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeClient) RouterGroupReturns(result1 models.RouterGroup, result2 error) {
+	fake.routerGroupMutex.Lock()
+	defer fake.routerGroupMutex.Unlock()
+	fake.RouterGroupStub = nil
+	fake.routerGroupReturns = struct {
+		result1 models.RouterGroup
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) RouterGroupReturnsOnCall(i int, result1 models.RouterGroup, result2 error) {
+	fake.routerGroupMutex.Lock()
+	defer fake.routerGroupMutex.Unlock()
+	fake.RouterGroupStub = nil
+	if fake.routerGroupReturnsOnCall == nil {
+		fake.routerGroupReturnsOnCall = make(map[int]struct {
+			result1 models.RouterGroup
+			result2 error
+		})
+	}
+	fake.routerGroupReturnsOnCall[i] = struct {
+		result1 models.RouterGroup
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) RouterGroupPorts(arg1 string) (models.PortOccupancy, error) {
+	fake.routerGroupPortsMutex.Lock()
+	ret, specificReturn := fake.routerGroupPortsReturnsOnCall[len(fake.routerGroupPortsArgsForCall)]
+	fake.routerGroupPortsArgsForCall = append(fake.routerGroupPortsArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.RouterGroupPortsStub
+	fakeReturns := fake.routerGroupPortsReturns
+	fake.recordInvocation("RouterGroupPorts", []interface{}{arg1})
+	fake.routerGroupPortsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
@@ -564,47 +1795,47 @@ func (fake *FakeClient) ReservePort(arg1 string, arg2 string) (int, error) {
 	return fakeReturns.result1, fakeReturns.result2
 }
 
-func (fake *FakeClient) ReservePortCallCount() int {
-	fake.reservePortMutex.RLock()
-	defer fake.reservePortMutex.RUnlock()
-	return len(fake.reservePortArgsForCall)
+func (fake *FakeClient) RouterGroupPortsCallCount() int {
+	fake.routerGroupPortsMutex.RLock()
+	defer fake.routerGroupPortsMutex.RUnlock()
+	return len(fake.routerGroupPortsArgsForCall)
 }
 
-func (fake *FakeClient) ReservePortCalls(stub func(string, string) (int, error)) {
-	fake.reservePortMutex.Lock()
-	defer fake.reservePortMutex.Unlock()
-	fake.ReservePortStub = stub
+func (fake *FakeClient) RouterGroupPortsCalls(stub func(string) (models.PortOccupancy, error)) {
+	fake.routerGroupPortsMutex.Lock()
+	defer fake.routerGroupPortsMutex.Unlock()
+	fake.RouterGroupPortsStub = stub
 }
 
-func (fake *FakeClient) ReservePortArgsForCall(i int) (string, string) {
-	fake.reservePortMutex.RLock()
-	defer fake.reservePortMutex.RUnlock()
-	argsForCall := fake.reservePortArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2
+func (fake *FakeClient) RouterGroupPortsArgsForCall(i int) string {
+	fake.routerGroupPortsMutex.RLock()
+	defer fake.routerGroupPortsMutex.RUnlock()
+	argsForCall := fake.routerGroupPortsArgsForCall[i]
+	return argsForCall.arg1
 }
 
-func (fake *FakeClient) ReservePortReturns(result1 int, result2 error) {
-	fake.reservePortMutex.Lock()
-	defer fake.reservePortMutex.Unlock()
-	fake.ReservePortStub = nil
-	fake.reservePortReturns = struct {
-		result1 int
+func (fake *FakeClient) RouterGroupPortsReturns(result1 models.PortOccupancy, result2 error) {
+	fake.routerGroupPortsMutex.Lock()
+	defer fake.routerGroupPortsMutex.Unlock()
+	fake.RouterGroupPortsStub = nil
+	fake.routerGroupPortsReturns = struct {
+		result1 models.PortOccupancy
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeClient) ReservePortReturnsOnCall(i int, result1 int, result2 error) {
-	fake.reservePortMutex.Lock()
-	defer fake.reservePortMutex.Unlock()
-	fake.ReservePortStub = nil
-	if fake.reservePortReturnsOnCall == nil {
-		fake.reservePortReturnsOnCall = make(map[int]struct {
-			result1 int
+func (fake *FakeClient) RouterGroupPortsReturnsOnCall(i int, result1 models.PortOccupancy, result2 error) {
+	fake.routerGroupPortsMutex.Lock()
+	defer fake.routerGroupPortsMutex.Unlock()
+	fake.RouterGroupPortsStub = nil
+	if fake.routerGroupPortsReturnsOnCall == nil {
+		fake.routerGroupPortsReturnsOnCall = make(map[int]struct {
+			result1 models.PortOccupancy
 			result2 error
 		})
 	}
-	fake.reservePortReturnsOnCall[i] = struct {
-		result1 int
+	fake.routerGroupPortsReturnsOnCall[i] = struct {
+		result1 models.PortOccupancy
 		result2 error
 	}{result1, result2}
 }
@@ -1057,6 +2288,126 @@ func (fake *FakeClient) SubscribeToTcpEventsWithMaxRetriesReturnsOnCall(i int, r
 	}{result1, result2}
 }
 
+func (fake *FakeClient) SubscribeToUdpEvents() (routing_api.UdpEventSource, error) {
+	fake.subscribeToUdpEventsMutex.Lock()
+	ret, specificReturn := fake.subscribeToUdpEventsReturnsOnCall[len(fake.subscribeToUdpEventsArgsForCall)]
+	fake.subscribeToUdpEventsArgsForCall = append(fake.subscribeToUdpEventsArgsForCall, struct {
+	}{})
+	stub := fake.SubscribeToUdpEventsStub
+	fakeReturns := fake.subscribeToUdpEventsReturns
+	fake.recordInvocation("SubscribeToUdpEvents", []interface{}{})
+	fake.subscribeToUdpEventsMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) SubscribeToUdpEventsCallCount() int {
+	fake.subscribeToUdpEventsMutex.RLock()
+	defer fake.subscribeToUdpEventsMutex.RUnlock()
+	return len(fake.subscribeToUdpEventsArgsForCall)
+}
+
+func (fake *FakeClient) SubscribeToUdpEventsCalls(stub func() (routing_api.UdpEventSource, error)) {
+	fake.subscribeToUdpEventsMutex.Lock()
+	defer fake.subscribeToUdpEventsMutex.Unlock()
+	fake.SubscribeToUdpEventsStub = stub
+}
+
+func (fake *FakeClient) SubscribeToUdpEventsReturns(result1 routing_api.UdpEventSource, result2 error) {
+	fake.subscribeToUdpEventsMutex.Lock()
+	defer fake.subscribeToUdpEventsMutex.Unlock()
+	fake.SubscribeToUdpEventsStub = nil
+	fake.subscribeToUdpEventsReturns = struct {
+		result1 routing_api.UdpEventSource
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) SubscribeToUdpEventsReturnsOnCall(i int, result1 routing_api.UdpEventSource, result2 error) {
+	fake.subscribeToUdpEventsMutex.Lock()
+	defer fake.subscribeToUdpEventsMutex.Unlock()
+	fake.SubscribeToUdpEventsStub = nil
+	if fake.subscribeToUdpEventsReturnsOnCall == nil {
+		fake.subscribeToUdpEventsReturnsOnCall = make(map[int]struct {
+			result1 routing_api.UdpEventSource
+			result2 error
+		})
+	}
+	fake.subscribeToUdpEventsReturnsOnCall[i] = struct {
+		result1 routing_api.UdpEventSource
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) SubscribeToUdpEventsWithMaxRetries(arg1 uint16) (routing_api.UdpEventSource, error) {
+	fake.subscribeToUdpEventsWithMaxRetriesMutex.Lock()
+	ret, specificReturn := fake.subscribeToUdpEventsWithMaxRetriesReturnsOnCall[len(fake.subscribeToUdpEventsWithMaxRetriesArgsForCall)]
+	fake.subscribeToUdpEventsWithMaxRetriesArgsForCall = append(fake.subscribeToUdpEventsWithMaxRetriesArgsForCall, struct {
+		arg1 uint16
+	}{arg1})
+	stub := fake.SubscribeToUdpEventsWithMaxRetriesStub
+	fakeReturns := fake.subscribeToUdpEventsWithMaxRetriesReturns
+	fake.recordInvocation("SubscribeToUdpEventsWithMaxRetries", []interface{}{arg1})
+	fake.subscribeToUdpEventsWithMaxRetriesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) SubscribeToUdpEventsWithMaxRetriesCallCount() int {
+	fake.subscribeToUdpEventsWithMaxRetriesMutex.RLock()
+	defer fake.subscribeToUdpEventsWithMaxRetriesMutex.RUnlock()
+	return len(fake.subscribeToUdpEventsWithMaxRetriesArgsForCall)
+}
+
+func (fake *FakeClient) SubscribeToUdpEventsWithMaxRetriesCalls(stub func(uint16) (routing_api.UdpEventSource, error)) {
+	fake.subscribeToUdpEventsWithMaxRetriesMutex.Lock()
+	defer fake.subscribeToUdpEventsWithMaxRetriesMutex.Unlock()
+	fake.SubscribeToUdpEventsWithMaxRetriesStub = stub
+}
+
+func (fake *FakeClient) SubscribeToUdpEventsWithMaxRetriesArgsForCall(i int) uint16 {
+	fake.subscribeToUdpEventsWithMaxRetriesMutex.RLock()
+	defer fake.subscribeToUdpEventsWithMaxRetriesMutex.RUnlock()
+	argsForCall := fake.subscribeToUdpEventsWithMaxRetriesArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeClient) SubscribeToUdpEventsWithMaxRetriesReturns(result1 routing_api.UdpEventSource, result2 error) {
+	fake.subscribeToUdpEventsWithMaxRetriesMutex.Lock()
+	defer fake.subscribeToUdpEventsWithMaxRetriesMutex.Unlock()
+	fake.SubscribeToUdpEventsWithMaxRetriesStub = nil
+	fake.subscribeToUdpEventsWithMaxRetriesReturns = struct {
+		result1 routing_api.UdpEventSource
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) SubscribeToUdpEventsWithMaxRetriesReturnsOnCall(i int, result1 routing_api.UdpEventSource, result2 error) {
+	fake.subscribeToUdpEventsWithMaxRetriesMutex.Lock()
+	defer fake.subscribeToUdpEventsWithMaxRetriesMutex.Unlock()
+	fake.SubscribeToUdpEventsWithMaxRetriesStub = nil
+	if fake.subscribeToUdpEventsWithMaxRetriesReturnsOnCall == nil {
+		fake.subscribeToUdpEventsWithMaxRetriesReturnsOnCall = make(map[int]struct {
+			result1 routing_api.UdpEventSource
+			result2 error
+		})
+	}
+	fake.subscribeToUdpEventsWithMaxRetriesReturnsOnCall[i] = struct {
+		result1 routing_api.UdpEventSource
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeClient) TcpRouteMappings() ([]models.TcpRouteMapping, error) {
 	fake.tcpRouteMappingsMutex.Lock()
 	ret, specificReturn := fake.tcpRouteMappingsReturnsOnCall[len(fake.tcpRouteMappingsArgsForCall)]
@@ -1113,6 +2464,246 @@ func (fake *FakeClient) TcpRouteMappingsReturnsOnCall(i int, result1 []models.Tc
 	}{result1, result2}
 }
 
+func (fake *FakeClient) TlsCertificate(arg1 string) (models.TlsCertificate, error) {
+	fake.tlsCertificateMutex.Lock()
+	ret, specificReturn := fake.tlsCertificateReturnsOnCall[len(fake.tlsCertificateArgsForCall)]
+	fake.tlsCertificateArgsForCall = append(fake.tlsCertificateArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.TlsCertificateStub
+	fakeReturns := fake.tlsCertificateReturns
+	fake.recordInvocation("TlsCertificate", []interface{}{arg1})
+	fake.tlsCertificateMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) TlsCertificateCallCount() int {
+	fake.tlsCertificateMutex.RLock()
+	defer fake.tlsCertificateMutex.RUnlock()
+	return len(fake.tlsCertificateArgsForCall)
+}
+
+func (fake *FakeClient) TlsCertificateCalls(stub func(string) (models.TlsCertificate, error)) {
+	fake.tlsCertificateMutex.Lock()
+	defer fake.tlsCertificateMutex.Unlock()
+	fake.TlsCertificateStub = stub
+}
+
+func (fake *FakeClient) TlsCertificateArgsForCall(i int) string {
+	fake.tlsCertificateMutex.RLock()
+	defer fake.tlsCertificateMutex.RUnlock()
+	argsForCall := fake.tlsCertificateArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeClient) TlsCertificateReturns(result1 models.TlsCertificate, result2 error) {
+	fake.tlsCertificateMutex.Lock()
+	defer fake.tlsCertificateMutex.Unlock()
+	fake.TlsCertificateStub = nil
+	fake.tlsCertificateReturns = struct {
+		result1 models.TlsCertificate
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) TlsCertificateReturnsOnCall(i int, result1 models.TlsCertificate, result2 error) {
+	fake.tlsCertificateMutex.Lock()
+	defer fake.tlsCertificateMutex.Unlock()
+	fake.TlsCertificateStub = nil
+	if fake.tlsCertificateReturnsOnCall == nil {
+		fake.tlsCertificateReturnsOnCall = make(map[int]struct {
+			result1 models.TlsCertificate
+			result2 error
+		})
+	}
+	fake.tlsCertificateReturnsOnCall[i] = struct {
+		result1 models.TlsCertificate
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) TlsCertificates() ([]models.TlsCertificate, error) {
+	fake.tlsCertificatesMutex.Lock()
+	ret, specificReturn := fake.tlsCertificatesReturnsOnCall[len(fake.tlsCertificatesArgsForCall)]
+	fake.tlsCertificatesArgsForCall = append(fake.tlsCertificatesArgsForCall, struct {
+	}{})
+	stub := fake.TlsCertificatesStub
+	fakeReturns := fake.tlsCertificatesReturns
+	fake.recordInvocation("TlsCertificates", []interface{}{})
+	fake.tlsCertificatesMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) TlsCertificatesCallCount() int {
+	fake.tlsCertificatesMutex.RLock()
+	defer fake.tlsCertificatesMutex.RUnlock()
+	return len(fake.tlsCertificatesArgsForCall)
+}
+
+func (fake *FakeClient) TlsCertificatesCalls(stub func() ([]models.TlsCertificate, error)) {
+	fake.tlsCertificatesMutex.Lock()
+	defer fake.tlsCertificatesMutex.Unlock()
+	fake.TlsCertificatesStub = stub
+}
+
+func (fake *FakeClient) TlsCertificatesReturns(result1 []models.TlsCertificate, result2 error) {
+	fake.tlsCertificatesMutex.Lock()
+	defer fake.tlsCertificatesMutex.Unlock()
+	fake.TlsCertificatesStub = nil
+	fake.tlsCertificatesReturns = struct {
+		result1 []models.TlsCertificate
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) TlsCertificatesReturnsOnCall(i int, result1 []models.TlsCertificate, result2 error) {
+	fake.tlsCertificatesMutex.Lock()
+	defer fake.tlsCertificatesMutex.Unlock()
+	fake.TlsCertificatesStub = nil
+	if fake.tlsCertificatesReturnsOnCall == nil {
+		fake.tlsCertificatesReturnsOnCall = make(map[int]struct {
+			result1 []models.TlsCertificate
+			result2 error
+		})
+	}
+	fake.tlsCertificatesReturnsOnCall[i] = struct {
+		result1 []models.TlsCertificate
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) UdpRouteMappings() ([]models.UdpRouteMapping, error) {
+	fake.udpRouteMappingsMutex.Lock()
+	ret, specificReturn := fake.udpRouteMappingsReturnsOnCall[len(fake.udpRouteMappingsArgsForCall)]
+	fake.udpRouteMappingsArgsForCall = append(fake.udpRouteMappingsArgsForCall, struct {
+	}{})
+	stub := fake.UdpRouteMappingsStub
+	fakeReturns := fake.udpRouteMappingsReturns
+	fake.recordInvocation("UdpRouteMappings", []interface{}{})
+	fake.udpRouteMappingsMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) UdpRouteMappingsCallCount() int {
+	fake.udpRouteMappingsMutex.RLock()
+	defer fake.udpRouteMappingsMutex.RUnlock()
+	return len(fake.udpRouteMappingsArgsForCall)
+}
+
+func (fake *FakeClient) UdpRouteMappingsCalls(stub func() ([]models.UdpRouteMapping, error)) {
+	fake.udpRouteMappingsMutex.Lock()
+	defer fake.udpRouteMappingsMutex.Unlock()
+	fake.UdpRouteMappingsStub = stub
+}
+
+func (fake *FakeClient) UdpRouteMappingsReturns(result1 []models.UdpRouteMapping, result2 error) {
+	fake.udpRouteMappingsMutex.Lock()
+	defer fake.udpRouteMappingsMutex.Unlock()
+	fake.UdpRouteMappingsStub = nil
+	fake.udpRouteMappingsReturns = struct {
+		result1 []models.UdpRouteMapping
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) UdpRouteMappingsReturnsOnCall(i int, result1 []models.UdpRouteMapping, result2 error) {
+	fake.udpRouteMappingsMutex.Lock()
+	defer fake.udpRouteMappingsMutex.Unlock()
+	fake.UdpRouteMappingsStub = nil
+	if fake.udpRouteMappingsReturnsOnCall == nil {
+		fake.udpRouteMappingsReturnsOnCall = make(map[int]struct {
+			result1 []models.UdpRouteMapping
+			result2 error
+		})
+	}
+	fake.udpRouteMappingsReturnsOnCall[i] = struct {
+		result1 []models.UdpRouteMapping
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) UpdateBackendTlsProfile(arg1 models.BackendTlsProfile) (models.BackendTlsProfile, error) {
+	fake.updateBackendTlsProfileMutex.Lock()
+	ret, specificReturn := fake.updateBackendTlsProfileReturnsOnCall[len(fake.updateBackendTlsProfileArgsForCall)]
+	fake.updateBackendTlsProfileArgsForCall = append(fake.updateBackendTlsProfileArgsForCall, struct {
+		arg1 models.BackendTlsProfile
+	}{arg1})
+	stub := fake.UpdateBackendTlsProfileStub
+	fakeReturns := fake.updateBackendTlsProfileReturns
+	fake.recordInvocation("UpdateBackendTlsProfile", []interface{}{arg1})
+	fake.updateBackendTlsProfileMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) UpdateBackendTlsProfileCallCount() int {
+	fake.updateBackendTlsProfileMutex.RLock()
+	defer fake.updateBackendTlsProfileMutex.RUnlock()
+	return len(fake.updateBackendTlsProfileArgsForCall)
+}
+
+func (fake *FakeClient) UpdateBackendTlsProfileCalls(stub func(models.BackendTlsProfile) (models.BackendTlsProfile, error)) {
+	fake.updateBackendTlsProfileMutex.Lock()
+	defer fake.updateBackendTlsProfileMutex.Unlock()
+	fake.UpdateBackendTlsProfileStub = stub
+}
+
+func (fake *FakeClient) UpdateBackendTlsProfileArgsForCall(i int) models.BackendTlsProfile {
+	fake.updateBackendTlsProfileMutex.RLock()
+	defer fake.updateBackendTlsProfileMutex.RUnlock()
+	argsForCall := fake.updateBackendTlsProfileArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeClient) UpdateBackendTlsProfileReturns(result1 models.BackendTlsProfile, result2 error) {
+	fake.updateBackendTlsProfileMutex.Lock()
+	defer fake.updateBackendTlsProfileMutex.Unlock()
+	fake.UpdateBackendTlsProfileStub = nil
+	fake.updateBackendTlsProfileReturns = struct {
+		result1 models.BackendTlsProfile
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) UpdateBackendTlsProfileReturnsOnCall(i int, result1 models.BackendTlsProfile, result2 error) {
+	fake.updateBackendTlsProfileMutex.Lock()
+	defer fake.updateBackendTlsProfileMutex.Unlock()
+	fake.UpdateBackendTlsProfileStub = nil
+	if fake.updateBackendTlsProfileReturnsOnCall == nil {
+		fake.updateBackendTlsProfileReturnsOnCall = make(map[int]struct {
+			result1 models.BackendTlsProfile
+			result2 error
+		})
+	}
+	fake.updateBackendTlsProfileReturnsOnCall[i] = struct {
+		result1 models.BackendTlsProfile
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeClient) UpdateRouterGroup(arg1 models.RouterGroup) error {
 	fake.updateRouterGroupMutex.Lock()
 	ret, specificReturn := fake.updateRouterGroupReturnsOnCall[len(fake.updateRouterGroupArgsForCall)]
@@ -1174,6 +2765,70 @@ func (fake *FakeClient) UpdateRouterGroupReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeClient) UpdateTlsCertificate(arg1 models.TlsCertificate) (models.TlsCertificate, error) {
+	fake.updateTlsCertificateMutex.Lock()
+	ret, specificReturn := fake.updateTlsCertificateReturnsOnCall[len(fake.updateTlsCertificateArgsForCall)]
+	fake.updateTlsCertificateArgsForCall = append(fake.updateTlsCertificateArgsForCall, struct {
+		arg1 models.TlsCertificate
+	}{arg1})
+	stub := fake.UpdateTlsCertificateStub
+	fakeReturns := fake.updateTlsCertificateReturns
+	fake.recordInvocation("UpdateTlsCertificate", []interface{}{arg1})
+	fake.updateTlsCertificateMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) UpdateTlsCertificateCallCount() int {
+	fake.updateTlsCertificateMutex.RLock()
+	defer fake.updateTlsCertificateMutex.RUnlock()
+	return len(fake.updateTlsCertificateArgsForCall)
+}
+
+func (fake *FakeClient) UpdateTlsCertificateCalls(stub func(models.TlsCertificate) (models.TlsCertificate, error)) {
+	fake.updateTlsCertificateMutex.Lock()
+	defer fake.updateTlsCertificateMutex.Unlock()
+	fake.UpdateTlsCertificateStub = stub
+}
+
+func (fake *FakeClient) UpdateTlsCertificateArgsForCall(i int) models.TlsCertificate {
+	fake.updateTlsCertificateMutex.RLock()
+	defer fake.updateTlsCertificateMutex.RUnlock()
+	argsForCall := fake.updateTlsCertificateArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeClient) UpdateTlsCertificateReturns(result1 models.TlsCertificate, result2 error) {
+	fake.updateTlsCertificateMutex.Lock()
+	defer fake.updateTlsCertificateMutex.Unlock()
+	fake.UpdateTlsCertificateStub = nil
+	fake.updateTlsCertificateReturns = struct {
+		result1 models.TlsCertificate
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) UpdateTlsCertificateReturnsOnCall(i int, result1 models.TlsCertificate, result2 error) {
+	fake.updateTlsCertificateMutex.Lock()
+	defer fake.updateTlsCertificateMutex.Unlock()
+	fake.UpdateTlsCertificateStub = nil
+	if fake.updateTlsCertificateReturnsOnCall == nil {
+		fake.updateTlsCertificateReturnsOnCall = make(map[int]struct {
+			result1 models.TlsCertificate
+			result2 error
+		})
+	}
+	fake.updateTlsCertificateReturnsOnCall[i] = struct {
+		result1 models.TlsCertificate
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeClient) UpsertRoutes(arg1 []models.Route) error {
 	var arg1Copy []models.Route
 	if arg1 != nil {
@@ -1306,21 +2961,117 @@ func (fake *FakeClient) UpsertTcpRouteMappingsReturnsOnCall(i int, result1 error
 	}{result1}
 }
 
+func (fake *FakeClient) UpsertUdpRouteMappings(arg1 []models.UdpRouteMapping) error {
+	var arg1Copy []models.UdpRouteMapping
+	if arg1 != nil {
+		arg1Copy = make([]models.UdpRouteMapping, len(arg1))
+		copy(arg1Copy, arg1)
+	}
+	fake.upsertUdpRouteMappingsMutex.Lock()
+	ret, specificReturn := fake.upsertUdpRouteMappingsReturnsOnCall[len(fake.upsertUdpRouteMappingsArgsForCall)]
+	fake.upsertUdpRouteMappingsArgsForCall = append(fake.upsertUdpRouteMappingsArgsForCall, struct {
+		arg1 []models.UdpRouteMapping
+	}{arg1Copy})
+	stub := fake.UpsertUdpRouteMappingsStub
+	fakeReturns := fake.upsertUdpRouteMappingsReturns
+	fake.recordInvocation("UpsertUdpRouteMappings", []interface{}{arg1Copy})
+	fake.upsertUdpRouteMappingsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeClient) UpsertUdpRouteMappingsCallCount() int {
+	fake.upsertUdpRouteMappingsMutex.RLock()
+	defer fake.upsertUdpRouteMappingsMutex.RUnlock()
+	return len(fake.upsertUdpRouteMappingsArgsForCall)
+}
+
+func (fake *FakeClient) UpsertUdpRouteMappingsCalls(stub func([]models.UdpRouteMapping) error) {
+	fake.upsertUdpRouteMappingsMutex.Lock()
+	defer fake.upsertUdpRouteMappingsMutex.Unlock()
+	fake.UpsertUdpRouteMappingsStub = stub
+}
+
+func (fake *FakeClient) UpsertUdpRouteMappingsArgsForCall(i int) []models.UdpRouteMapping {
+	fake.upsertUdpRouteMappingsMutex.RLock()
+	defer fake.upsertUdpRouteMappingsMutex.RUnlock()
+	argsForCall := fake.upsertUdpRouteMappingsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeClient) UpsertUdpRouteMappingsReturns(result1 error) {
+	fake.upsertUdpRouteMappingsMutex.Lock()
+	defer fake.upsertUdpRouteMappingsMutex.Unlock()
+	fake.UpsertUdpRouteMappingsStub = nil
+	fake.upsertUdpRouteMappingsReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeClient) UpsertUdpRouteMappingsReturnsOnCall(i int, result1 error) {
+	fake.upsertUdpRouteMappingsMutex.Lock()
+	defer fake.upsertUdpRouteMappingsMutex.Unlock()
+	fake.UpsertUdpRouteMappingsStub = nil
+	if fake.upsertUdpRouteMappingsReturnsOnCall == nil {
+		fake.upsertUdpRouteMappingsReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.upsertUdpRouteMappingsReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeClient) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.backendTlsProfileMutex.RLock()
+	defer fake.backendTlsProfileMutex.RUnlock()
+	fake.backendTlsProfilesMutex.RLock()
+	defer fake.backendTlsProfilesMutex.RUnlock()
+	fake.createBackendTlsProfileMutex.RLock()
+	defer fake.createBackendTlsProfileMutex.RUnlock()
+	fake.createPortReservationMutex.RLock()
+	defer fake.createPortReservationMutex.RUnlock()
 	fake.createRouterGroupMutex.RLock()
 	defer fake.createRouterGroupMutex.RUnlock()
+	fake.createTcpRouteMappingsMutex.RLock()
+	defer fake.createTcpRouteMappingsMutex.RUnlock()
+	fake.createTlsCertificateMutex.RLock()
+	defer fake.createTlsCertificateMutex.RUnlock()
+	fake.deleteBackendTlsProfileMutex.RLock()
+	defer fake.deleteBackendTlsProfileMutex.RUnlock()
 	fake.deleteRouterGroupMutex.RLock()
 	defer fake.deleteRouterGroupMutex.RUnlock()
 	fake.deleteRoutesMutex.RLock()
 	defer fake.deleteRoutesMutex.RUnlock()
 	fake.deleteTcpRouteMappingsMutex.RLock()
 	defer fake.deleteTcpRouteMappingsMutex.RUnlock()
+	fake.deleteTlsCertificateMutex.RLock()
+	defer fake.deleteTlsCertificateMutex.RUnlock()
+	fake.deleteUdpRouteMappingsMutex.RLock()
+	defer fake.deleteUdpRouteMappingsMutex.RUnlock()
 	fake.filteredTcpRouteMappingsMutex.RLock()
 	defer fake.filteredTcpRouteMappingsMutex.RUnlock()
+	fake.filteredUdpRouteMappingsMutex.RLock()
+	defer fake.filteredUdpRouteMappingsMutex.RUnlock()
+	fake.portReservationMutex.RLock()
+	defer fake.portReservationMutex.RUnlock()
+	fake.portReservationsMutex.RLock()
+	defer fake.portReservationsMutex.RUnlock()
+	fake.releasePortReservationMutex.RLock()
+	defer fake.releasePortReservationMutex.RUnlock()
 	fake.reservePortMutex.RLock()
 	defer fake.reservePortMutex.RUnlock()
+	fake.routerGroupMutex.RLock()
+	defer fake.routerGroupMutex.RUnlock()
+	fake.routerGroupPortsMutex.RLock()
+	defer fake.routerGroupPortsMutex.RUnlock()
 	fake.routerGroupWithNameMutex.RLock()
 	defer fake.routerGroupWithNameMutex.RUnlock()
 	fake.routerGroupsMutex.RLock()
@@ -1337,14 +3088,30 @@ func (fake *FakeClient) Invocations() map[string][][]interface{} {
 	defer fake.subscribeToTcpEventsMutex.RUnlock()
 	fake.subscribeToTcpEventsWithMaxRetriesMutex.RLock()
 	defer fake.subscribeToTcpEventsWithMaxRetriesMutex.RUnlock()
+	fake.subscribeToUdpEventsMutex.RLock()
+	defer fake.subscribeToUdpEventsMutex.RUnlock()
+	fake.subscribeToUdpEventsWithMaxRetriesMutex.RLock()
+	defer fake.subscribeToUdpEventsWithMaxRetriesMutex.RUnlock()
 	fake.tcpRouteMappingsMutex.RLock()
 	defer fake.tcpRouteMappingsMutex.RUnlock()
+	fake.tlsCertificateMutex.RLock()
+	defer fake.tlsCertificateMutex.RUnlock()
+	fake.tlsCertificatesMutex.RLock()
+	defer fake.tlsCertificatesMutex.RUnlock()
+	fake.udpRouteMappingsMutex.RLock()
+	defer fake.udpRouteMappingsMutex.RUnlock()
+	fake.updateBackendTlsProfileMutex.RLock()
+	defer fake.updateBackendTlsProfileMutex.RUnlock()
 	fake.updateRouterGroupMutex.RLock()
 	defer fake.updateRouterGroupMutex.RUnlock()
+	fake.updateTlsCertificateMutex.RLock()
+	defer fake.updateTlsCertificateMutex.RUnlock()
 	fake.upsertRoutesMutex.RLock()
 	defer fake.upsertRoutesMutex.RUnlock()
 	fake.upsertTcpRouteMappingsMutex.RLock()
 	defer fake.upsertTcpRouteMappingsMutex.RUnlock()
+	fake.upsertUdpRouteMappingsMutex.RLock()
+	defer fake.upsertUdpRouteMappingsMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
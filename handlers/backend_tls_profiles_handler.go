@@ -0,0 +1,202 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"code.cloudfoundry.org/lager/v3"
+	"code.cloudfoundry.org/routing-api/db"
+	"code.cloudfoundry.org/routing-api/models"
+	"code.cloudfoundry.org/routing-api/uaaclient"
+	"github.com/tedsuo/rata"
+)
+
+type BackendTlsProfilesHandler struct {
+	uaaClient uaaclient.TokenValidator
+	logger    lager.Logger
+	db        db.DB
+}
+
+func NewBackendTlsProfilesHandler(uaaClient uaaclient.TokenValidator, logger lager.Logger, database db.DB) *BackendTlsProfilesHandler {
+	return &BackendTlsProfilesHandler{
+		uaaClient: uaaClient,
+		logger:    logger,
+		db:        database,
+	}
+}
+
+func (h *BackendTlsProfilesHandler) List(w http.ResponseWriter, req *http.Request) {
+	log := h.logger.Session("list-backend-tls-profiles")
+
+	err := h.uaaClient.ValidateToken(req.Header.Get("Authorization"), BackendTlsProfilesReadScope)
+	if err != nil {
+		handleUnauthorizedError(w, err, log)
+		return
+	}
+
+	profiles, err := h.db.ReadBackendTlsProfiles()
+	if err != nil {
+		handleDBCommunicationError(w, err, log)
+		return
+	}
+
+	query := req.URL.Query()
+	name := query.Get("name")
+	isolationSegment := query.Get("isolation_segment")
+
+	filtered := []models.BackendTlsProfile{}
+	for _, profile := range profiles {
+		if name != "" && profile.Name != name {
+			continue
+		}
+		if isolationSegment != "" && profile.IsolationSegment != isolationSegment {
+			continue
+		}
+		if profile.ClientPrivateKey == "" {
+			log.Info("client-private-key-not-decrypted", lager.Data{"guid": profile.Guid})
+		}
+		filtered = append(filtered, profile)
+	}
+
+	writeJSONResponse(w, http.StatusOK, filtered, log)
+}
+
+func (h *BackendTlsProfilesHandler) Get(w http.ResponseWriter, req *http.Request) {
+	log := h.logger.Session("get-backend-tls-profile")
+
+	err := h.uaaClient.ValidateToken(req.Header.Get("Authorization"), BackendTlsProfilesReadScope)
+	if err != nil {
+		handleUnauthorizedError(w, err, log)
+		return
+	}
+
+	guid := rata.Param(req, "guid")
+	profile, err := h.db.ReadBackendTlsProfile(guid)
+	if err != nil {
+		handleDBCommunicationError(w, err, log)
+		return
+	}
+	if profile.Guid == "" {
+		handleNotFoundError(w, fmt.Errorf("backend tls profile '%s' not found", guid), log)
+		return
+	}
+
+	writeJSONResponse(w, http.StatusOK, profile, log)
+}
+
+func (h *BackendTlsProfilesHandler) Create(w http.ResponseWriter, req *http.Request) {
+	log := h.logger.Session("create-backend-tls-profile")
+
+	err := h.uaaClient.ValidateToken(req.Header.Get("Authorization"), BackendTlsProfilesWriteScope)
+	if err != nil {
+		handleUnauthorizedError(w, err, log)
+		return
+	}
+
+	var profile models.BackendTlsProfile
+	err = json.NewDecoder(req.Body).Decode(&profile)
+	if err != nil {
+		handleProcessRequestError(w, err, log)
+		return
+	}
+	profile.Guid = ""
+
+	log.Info("request", lager.Data{"backend_tls_profile": profile.String()})
+
+	h.save(w, profile, http.StatusCreated, log)
+}
+
+// Update replaces the client identity, trusted CA, expected SAN and isolation
+// segment of the backend tls profile. Its name cannot be changed.
+func (h *BackendTlsProfilesHandler) Update(w http.ResponseWriter, req *http.Request) {
+	log := h.logger.Session("update-backend-tls-profile")
+
+	err := h.uaaClient.ValidateToken(req.Header.Get("Authorization"), BackendTlsProfilesWriteScope)
+	if err != nil {
+		handleUnauthorizedError(w, err, log)
+		return
+	}
+
+	var profile models.BackendTlsProfile
+	err = json.NewDecoder(req.Body).Decode(&profile)
+	if err != nil {
+		handleProcessRequestError(w, err, log)
+		return
+	}
+	profile.Guid = rata.Param(req, "guid")
+
+	log.Info("request", lager.Data{"backend_tls_profile": profile.String()})
+
+	h.save(w, profile, http.StatusOK, log)
+}
+
+func (h *BackendTlsProfilesHandler) save(w http.ResponseWriter, profile models.BackendTlsProfile, status int, log lager.Logger) {
+	profile.Normalize()
+	err := profile.Validate(time.Now())
+	if err != nil {
+		handleProcessRequestError(w, err, log)
+		return
+	}
+
+	leaf, err := profile.ClientLeaf()
+	if err != nil {
+		handleProcessRequestError(w, err, log)
+		return
+	}
+	profile.NotAfter = leaf.NotAfter
+
+	profile, err = h.db.SaveBackendTlsProfile(profile)
+	if err != nil {
+		if dberr, ok := err.(db.DBError); ok {
+			switch dberr.Type {
+			case db.UniqueField:
+				handleDBConflictError(w, err, log)
+				return
+			case db.KeyNotFound:
+				handleNotFoundError(w, err, log)
+				return
+			case db.NonUpdatableField:
+				handleProcessRequestError(w, err, log)
+				return
+			}
+		}
+		handleDBCommunicationError(w, err, log)
+		return
+	}
+
+	// create and update do not echo the client private key back, only get and
+	// list return it, to clients holding the read scope
+	profile.ClientPrivateKey = ""
+	writeJSONResponse(w, status, profile, log)
+}
+
+func (h *BackendTlsProfilesHandler) Delete(w http.ResponseWriter, req *http.Request) {
+	log := h.logger.Session("delete-backend-tls-profile")
+
+	err := h.uaaClient.ValidateToken(req.Header.Get("Authorization"), BackendTlsProfilesWriteScope)
+	if err != nil {
+		handleUnauthorizedError(w, err, log)
+		return
+	}
+
+	guid := rata.Param(req, "guid")
+	err = h.db.DeleteBackendTlsProfile(guid)
+	if err != nil {
+		if dberr, ok := err.(db.DBError); ok {
+			switch dberr.Type {
+			case db.KeyNotFound:
+				handleNotFoundError(w, fmt.Errorf("backend tls profile '%s' not found", guid), log)
+				return
+			case db.InUse:
+				handleDBConflictError(w, err, log)
+				return
+			}
+		}
+		handleDBCommunicationError(w, err, log)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
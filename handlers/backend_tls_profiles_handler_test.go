@@ -0,0 +1,406 @@
+package handlers_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"code.cloudfoundry.org/lager/v3/lagertest"
+	routing_api "code.cloudfoundry.org/routing-api"
+	"code.cloudfoundry.org/routing-api/db"
+	fake_db "code.cloudfoundry.org/routing-api/db/fakes"
+	"code.cloudfoundry.org/routing-api/handlers"
+	"code.cloudfoundry.org/routing-api/models"
+	"code.cloudfoundry.org/routing-api/test_helpers"
+	fake_client "code.cloudfoundry.org/routing-api/uaaclient/fakes"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/tedsuo/rata"
+)
+
+var _ = Describe("BackendTlsProfilesHandler", func() {
+	var (
+		backendTlsProfilesHandler         *handlers.BackendTlsProfilesHandler
+		handler                           http.Handler
+		request                           *http.Request
+		responseRecorder                  *httptest.ResponseRecorder
+		fakeClient                        *fake_client.FakeTokenValidator
+		fakeDb                            *fake_db.FakeDB
+		logger                            *lagertest.TestLogger
+		clientCertChain, clientPrivateKey string
+		trustedCA                         string
+		notAfter                          time.Time
+	)
+
+	BeforeEach(func() {
+		logger = lagertest.NewTestLogger("test-backend-tls-profiles")
+		fakeClient = &fake_client.FakeTokenValidator{}
+		fakeDb = &fake_db.FakeDB{}
+		backendTlsProfilesHandler = handlers.NewBackendTlsProfilesHandler(fakeClient, logger, fakeDb)
+		responseRecorder = httptest.NewRecorder()
+
+		var err error
+		notAfter = time.Now().Add(24 * time.Hour).Truncate(time.Second)
+		clientCertChain, clientPrivateKey, err = test_helpers.CreateClientCertificate(notAfter)
+		Expect(err).NotTo(HaveOccurred())
+		trustedCA, _, err = test_helpers.CreateHostnameCertificate([]string{"backend.internal"}, notAfter)
+		Expect(err).NotTo(HaveOccurred())
+
+		handler, err = rata.NewRouter(
+			rata.Routes{
+				routing_api.RoutesMap[routing_api.CreateBackendTlsProfile],
+				routing_api.RoutesMap[routing_api.ListBackendTlsProfiles],
+				routing_api.RoutesMap[routing_api.GetBackendTlsProfile],
+				routing_api.RoutesMap[routing_api.UpdateBackendTlsProfile],
+				routing_api.RoutesMap[routing_api.DeleteBackendTlsProfile],
+			},
+			rata.Handlers{
+				routing_api.CreateBackendTlsProfile: http.HandlerFunc(backendTlsProfilesHandler.Create),
+				routing_api.ListBackendTlsProfiles:  http.HandlerFunc(backendTlsProfilesHandler.List),
+				routing_api.GetBackendTlsProfile:    http.HandlerFunc(backendTlsProfilesHandler.Get),
+				routing_api.UpdateBackendTlsProfile: http.HandlerFunc(backendTlsProfilesHandler.Update),
+				routing_api.DeleteBackendTlsProfile: http.HandlerFunc(backendTlsProfilesHandler.Delete),
+			},
+		)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	Describe("Create", func() {
+		var profile models.BackendTlsProfile
+
+		BeforeEach(func() {
+			profile = models.NewBackendTlsProfile("iso-seg-1", clientCertChain, clientPrivateKey, trustedCA, "Backend.Internal")
+			fakeDb.SaveBackendTlsProfileStub = func(p models.BackendTlsProfile) (models.BackendTlsProfile, error) {
+				p.Guid = "profile-guid"
+				return p, nil
+			}
+		})
+
+		JustBeforeEach(func() {
+			body, err := json.Marshal(profile)
+			Expect(err).NotTo(HaveOccurred())
+			request, err = http.NewRequest("POST", "/routing/v1/backend_tls_profiles", bytes.NewReader(body))
+			Expect(err).NotTo(HaveOccurred())
+			handler.ServeHTTP(responseRecorder, request)
+		})
+
+		It("checks for routing.backend_tls_profiles.write scope", func() {
+			_, permission := fakeClient.ValidateTokenArgsForCall(0)
+			Expect(permission).To(ConsistOf(handlers.BackendTlsProfilesWriteScope))
+		})
+
+		It("saves the normalized profile with the expiry of its client certificate", func() {
+			Expect(fakeDb.SaveBackendTlsProfileCallCount()).To(Equal(1))
+			saved := fakeDb.SaveBackendTlsProfileArgsForCall(0)
+			Expect(saved.Guid).To(BeEmpty())
+			Expect(saved.Name).To(Equal("iso-seg-1"))
+			Expect(saved.ExpectedSAN).To(Equal("backend.internal"))
+			Expect(saved.ClientPrivateKey).To(Equal(clientPrivateKey))
+			Expect(saved.NotAfter).To(BeTemporally("==", notAfter))
+		})
+
+		It("returns the profile without its client private key", func() {
+			Expect(responseRecorder.Code).To(Equal(http.StatusCreated))
+			var created models.BackendTlsProfile
+			Expect(json.Unmarshal(responseRecorder.Body.Bytes(), &created)).To(Succeed())
+			Expect(created.Guid).To(Equal("profile-guid"))
+			Expect(created.Name).To(Equal("iso-seg-1"))
+			Expect(responseRecorder.Body.String()).NotTo(ContainSubstring("client_private_key"))
+		})
+
+		Context("when the trusted CA is missing", func() {
+			BeforeEach(func() {
+				profile.TrustedCA = ""
+			})
+
+			It("returns a 400 Bad Request", func() {
+				Expect(fakeDb.SaveBackendTlsProfileCallCount()).To(Equal(0))
+				Expect(responseRecorder.Code).To(Equal(http.StatusBadRequest))
+				Expect(responseRecorder.Body.String()).To(ContainSubstring("each backend tls profile requires a trusted_ca"))
+			})
+		})
+
+		Context("when the client certificate has expired", func() {
+			BeforeEach(func() {
+				var err error
+				profile.ClientCertChain, profile.ClientPrivateKey, err = test_helpers.CreateClientCertificate(time.Now().Add(-time.Hour))
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("returns a 400 Bad Request", func() {
+				Expect(fakeDb.SaveBackendTlsProfileCallCount()).To(Equal(0))
+				Expect(responseRecorder.Code).To(Equal(http.StatusBadRequest))
+				Expect(responseRecorder.Body.String()).To(ContainSubstring("client_cert_chain expired at"))
+			})
+		})
+
+		Context("when there already is a profile with the name", func() {
+			BeforeEach(func() {
+				fakeDb.SaveBackendTlsProfileStub = nil
+				fakeDb.SaveBackendTlsProfileReturns(models.BackendTlsProfile{}, db.DBError{Type: db.UniqueField, Message: "already exists"})
+			})
+
+			It("returns a 409 Conflict", func() {
+				Expect(responseRecorder.Code).To(Equal(http.StatusConflict))
+				Expect(responseRecorder.Body.String()).To(ContainSubstring("DBConflictError"))
+			})
+		})
+
+		Context("when the db fails to save the profile", func() {
+			BeforeEach(func() {
+				fakeDb.SaveBackendTlsProfileStub = nil
+				fakeDb.SaveBackendTlsProfileReturns(models.BackendTlsProfile{}, errors.New("db communication failed"))
+			})
+
+			It("returns a DB communication error", func() {
+				Expect(responseRecorder.Code).To(Equal(http.StatusServiceUnavailable))
+			})
+		})
+
+		Context("when authorization token is invalid", func() {
+			BeforeEach(func() {
+				fakeClient.ValidateTokenReturns(errors.New("kaboom"))
+			})
+
+			It("returns Unauthorized error", func() {
+				Expect(fakeDb.SaveBackendTlsProfileCallCount()).To(Equal(0))
+				Expect(responseRecorder.Code).To(Equal(http.StatusUnauthorized))
+			})
+		})
+	})
+
+	Describe("Update", func() {
+		var profile models.BackendTlsProfile
+
+		BeforeEach(func() {
+			profile = models.NewBackendTlsProfile("iso-seg-1", clientCertChain, clientPrivateKey, trustedCA, "")
+			fakeDb.SaveBackendTlsProfileStub = func(p models.BackendTlsProfile) (models.BackendTlsProfile, error) {
+				return p, nil
+			}
+		})
+
+		JustBeforeEach(func() {
+			body, err := json.Marshal(profile)
+			Expect(err).NotTo(HaveOccurred())
+			request, err = http.NewRequest("PUT", "/routing/v1/backend_tls_profiles/profile-guid", bytes.NewReader(body))
+			Expect(err).NotTo(HaveOccurred())
+			handler.ServeHTTP(responseRecorder, request)
+		})
+
+		It("updates the profile with the guid", func() {
+			_, permission := fakeClient.ValidateTokenArgsForCall(0)
+			Expect(permission).To(ConsistOf(handlers.BackendTlsProfilesWriteScope))
+
+			Expect(fakeDb.SaveBackendTlsProfileCallCount()).To(Equal(1))
+			saved := fakeDb.SaveBackendTlsProfileArgsForCall(0)
+			Expect(saved.Guid).To(Equal("profile-guid"))
+			Expect(saved.ClientCertChain).To(Equal(clientCertChain))
+
+			Expect(responseRecorder.Code).To(Equal(http.StatusOK))
+			Expect(responseRecorder.Body.String()).NotTo(ContainSubstring("client_private_key"))
+		})
+
+		Context("when the profile does not exist", func() {
+			BeforeEach(func() {
+				fakeDb.SaveBackendTlsProfileStub = nil
+				fakeDb.SaveBackendTlsProfileReturns(models.BackendTlsProfile{}, db.DBError{Type: db.KeyNotFound, Message: "does not exist"})
+			})
+
+			It("returns a 404 Not Found", func() {
+				Expect(responseRecorder.Code).To(Equal(http.StatusNotFound))
+			})
+		})
+
+		Context("when the name is changed", func() {
+			BeforeEach(func() {
+				fakeDb.SaveBackendTlsProfileStub = nil
+				fakeDb.SaveBackendTlsProfileReturns(models.BackendTlsProfile{}, db.DBError{Type: db.NonUpdatableField, Message: "cannot be changed"})
+			})
+
+			It("returns a 400 Bad Request", func() {
+				Expect(responseRecorder.Code).To(Equal(http.StatusBadRequest))
+			})
+		})
+	})
+
+	Describe("List", func() {
+		BeforeEach(func() {
+			isolated := models.NewBackendTlsProfile("iso-seg-1", clientCertChain, clientPrivateKey, trustedCA, "")
+			isolated.IsolationSegment = "iso-seg-1"
+			fakeDb.ReadBackendTlsProfilesReturns([]models.BackendTlsProfile{
+				models.NewBackendTlsProfile("shared", clientCertChain, clientPrivateKey, trustedCA, ""),
+				isolated,
+			}, nil)
+		})
+
+		It("checks for routing.backend_tls_profiles.read scope", func() {
+			var err error
+			request, err = http.NewRequest("GET", "/routing/v1/backend_tls_profiles", nil)
+			Expect(err).NotTo(HaveOccurred())
+			handler.ServeHTTP(responseRecorder, request)
+
+			_, permission := fakeClient.ValidateTokenArgsForCall(0)
+			Expect(permission).To(ConsistOf(handlers.BackendTlsProfilesReadScope))
+		})
+
+		It("returns all profiles with their client private keys", func() {
+			var err error
+			request, err = http.NewRequest("GET", "/routing/v1/backend_tls_profiles", nil)
+			Expect(err).NotTo(HaveOccurred())
+			handler.ServeHTTP(responseRecorder, request)
+
+			Expect(responseRecorder.Code).To(Equal(http.StatusOK))
+			var profiles []models.BackendTlsProfile
+			Expect(json.Unmarshal(responseRecorder.Body.Bytes(), &profiles)).To(Succeed())
+			Expect(profiles).To(HaveLen(2))
+			Expect(profiles[0].ClientPrivateKey).To(Equal(clientPrivateKey))
+		})
+
+		Context("when a client private key could not be decrypted", func() {
+			BeforeEach(func() {
+				undecrypted := models.NewBackendTlsProfile("other", clientCertChain, clientPrivateKey, trustedCA, "")
+				undecrypted.ClientPrivateKey = ""
+				fakeDb.ReadBackendTlsProfilesReturns([]models.BackendTlsProfile{
+					models.NewBackendTlsProfile("shared", clientCertChain, clientPrivateKey, trustedCA, ""),
+					undecrypted,
+				}, nil)
+			})
+
+			It("still returns every profile and logs the one without its key", func() {
+				var err error
+				request, err = http.NewRequest("GET", "/routing/v1/backend_tls_profiles", nil)
+				Expect(err).NotTo(HaveOccurred())
+				handler.ServeHTTP(responseRecorder, request)
+
+				Expect(responseRecorder.Code).To(Equal(http.StatusOK))
+				var profiles []models.BackendTlsProfile
+				Expect(json.Unmarshal(responseRecorder.Body.Bytes(), &profiles)).To(Succeed())
+				Expect(profiles).To(HaveLen(2))
+				Expect(profiles[0].ClientPrivateKey).To(Equal(clientPrivateKey))
+				Expect(profiles[1].ClientPrivateKey).To(BeEmpty())
+				Expect(logger.LogMessages()).To(ContainElement(HaveSuffix("client-private-key-not-decrypted")))
+			})
+		})
+
+		It("filters by name", func() {
+			var err error
+			request, err = http.NewRequest("GET", "/routing/v1/backend_tls_profiles?name=shared", nil)
+			Expect(err).NotTo(HaveOccurred())
+			handler.ServeHTTP(responseRecorder, request)
+
+			Expect(responseRecorder.Code).To(Equal(http.StatusOK))
+			var profiles []models.BackendTlsProfile
+			Expect(json.Unmarshal(responseRecorder.Body.Bytes(), &profiles)).To(Succeed())
+			Expect(profiles).To(HaveLen(1))
+			Expect(profiles[0].Name).To(Equal("shared"))
+		})
+
+		It("filters by isolation segment", func() {
+			var err error
+			request, err = http.NewRequest("GET", "/routing/v1/backend_tls_profiles?isolation_segment=iso-seg-1", nil)
+			Expect(err).NotTo(HaveOccurred())
+			handler.ServeHTTP(responseRecorder, request)
+
+			Expect(responseRecorder.Code).To(Equal(http.StatusOK))
+			var profiles []models.BackendTlsProfile
+			Expect(json.Unmarshal(responseRecorder.Body.Bytes(), &profiles)).To(Succeed())
+			Expect(profiles).To(HaveLen(1))
+			Expect(profiles[0].Name).To(Equal("iso-seg-1"))
+		})
+
+		Context("when the client private keys cannot be decrypted", func() {
+			BeforeEach(func() {
+				fakeDb.ReadBackendTlsProfilesReturns(nil, db.ErrNoEncryptionKey)
+			})
+
+			It("returns a DB communication error", func() {
+				var err error
+				request, err = http.NewRequest("GET", "/routing/v1/backend_tls_profiles", nil)
+				Expect(err).NotTo(HaveOccurred())
+				handler.ServeHTTP(responseRecorder, request)
+
+				Expect(responseRecorder.Code).To(Equal(http.StatusServiceUnavailable))
+			})
+		})
+	})
+
+	Describe("Get", func() {
+		It("returns the profile", func() {
+			profile := models.NewBackendTlsProfile("iso-seg-1", clientCertChain, clientPrivateKey, trustedCA, "")
+			profile.Guid = "profile-guid"
+			fakeDb.ReadBackendTlsProfileReturns(profile, nil)
+
+			var err error
+			request, err = http.NewRequest("GET", "/routing/v1/backend_tls_profiles/profile-guid", nil)
+			Expect(err).NotTo(HaveOccurred())
+			handler.ServeHTTP(responseRecorder, request)
+
+			_, permission := fakeClient.ValidateTokenArgsForCall(0)
+			Expect(permission).To(ConsistOf(handlers.BackendTlsProfilesReadScope))
+			Expect(fakeDb.ReadBackendTlsProfileArgsForCall(0)).To(Equal("profile-guid"))
+			Expect(responseRecorder.Code).To(Equal(http.StatusOK))
+			Expect(responseRecorder.Body.String()).To(ContainSubstring(`"name":"iso-seg-1"`))
+		})
+
+		Context("when the profile does not exist", func() {
+			It("returns a 404 Not Found", func() {
+				var err error
+				request, err = http.NewRequest("GET", "/routing/v1/backend_tls_profiles/not-exist", nil)
+				Expect(err).NotTo(HaveOccurred())
+				handler.ServeHTTP(responseRecorder, request)
+
+				Expect(responseRecorder.Code).To(Equal(http.StatusNotFound))
+				Expect(responseRecorder.Body.String()).To(ContainSubstring("ResourceNotFoundError"))
+			})
+		})
+	})
+
+	Describe("Delete", func() {
+		It("deletes the profile", func() {
+			var err error
+			request, err = http.NewRequest("DELETE", "/routing/v1/backend_tls_profiles/profile-guid", nil)
+			Expect(err).NotTo(HaveOccurred())
+			handler.ServeHTTP(responseRecorder, request)
+
+			_, permission := fakeClient.ValidateTokenArgsForCall(0)
+			Expect(permission).To(ConsistOf(handlers.BackendTlsProfilesWriteScope))
+			Expect(fakeDb.DeleteBackendTlsProfileCallCount()).To(Equal(1))
+			Expect(fakeDb.DeleteBackendTlsProfileArgsForCall(0)).To(Equal("profile-guid"))
+			Expect(responseRecorder.Code).To(Equal(http.StatusNoContent))
+		})
+
+		Context("when the profile does not exist", func() {
+			BeforeEach(func() {
+				fakeDb.DeleteBackendTlsProfileReturns(db.DeleteBackendTlsProfileError)
+			})
+
+			It("returns a 404 Not Found", func() {
+				var err error
+				request, err = http.NewRequest("DELETE", "/routing/v1/backend_tls_profiles/not-exist", nil)
+				Expect(err).NotTo(HaveOccurred())
+				handler.ServeHTTP(responseRecorder, request)
+
+				Expect(responseRecorder.Code).To(Equal(http.StatusNotFound))
+			})
+		})
+
+		Context("when tcp routes use the profile", func() {
+			BeforeEach(func() {
+				fakeDb.DeleteBackendTlsProfileReturns(db.DBError{Type: db.InUse, Message: "Delete Fails: Backend Tls Profile is used by 1 tcp routes"})
+			})
+
+			It("returns a 409 Conflict", func() {
+				var err error
+				request, err = http.NewRequest("DELETE", "/routing/v1/backend_tls_profiles/profile-guid", nil)
+				Expect(err).NotTo(HaveOccurred())
+				handler.ServeHTTP(responseRecorder, request)
+
+				Expect(responseRecorder.Code).To(Equal(http.StatusConflict))
+				Expect(responseRecorder.Body.String()).To(ContainSubstring("is used by 1 tcp routes"))
+			})
+		})
+	})
+})
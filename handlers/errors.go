@@ -9,6 +9,7 @@ import (
 	"code.cloudfoundry.org/lager/v3"
 	routing_api "code.cloudfoundry.org/routing-api"
 	"code.cloudfoundry.org/routing-api/metrics"
+	"code.cloudfoundry.org/routing-api/models"
 )
 
 func handleProcessRequestError(w http.ResponseWriter, procErr error, log lager.Logger) {
@@ -39,6 +40,69 @@ func handleApiError(w http.ResponseWriter, apiErr *routing_api.Error, log lager.
 	log.Error("error writing to request", writeErr)
 }
 
+func handlePortRangeExhaustedError(w http.ResponseWriter, err error, log lager.Logger) {
+	log.Error("error", err)
+	retErr := marshalRoutingApiError(routing_api.NewError(routing_api.PortRangeExhaustedError, err.Error()), log)
+
+	w.WriteHeader(http.StatusConflict)
+	_, writeErr := w.Write(retErr)
+	log.Error("error writing to request", writeErr)
+}
+
+func handleRouterGroupQuotaExceededError(w http.ResponseWriter, err error, log lager.Logger) {
+	log.Error("error", err)
+	retErr := marshalRoutingApiError(routing_api.NewError(routing_api.RouterGroupQuotaExceededError, err.Error()), log)
+
+	w.WriteHeader(http.StatusConflict)
+	_, writeErr := w.Write(retErr)
+	log.Error("error writing to request", writeErr)
+}
+
+func handleDBConflictError(w http.ResponseWriter, err error, log lager.Logger) {
+	log.Error("error", err)
+	retErr := marshalRoutingApiError(routing_api.NewError(routing_api.DBConflictError, err.Error()), log)
+
+	w.WriteHeader(http.StatusConflict)
+	_, writeErr := w.Write(retErr)
+	log.Error("error writing to request", writeErr)
+}
+
+func handleRouterGroupPortsInUseError(w http.ResponseWriter, message string, stranded []models.TcpRouteMapping, log lager.Logger) {
+	log.Info("router-group-ports-in-use", lager.Data{"tcp_route_mappings": stranded})
+	retErr, jsonErr := json.Marshal(struct {
+		routing_api.Error
+		TcpRouteMappings []models.TcpRouteMapping `json:"tcp_routes"`
+	}{
+		Error:            routing_api.NewError(routing_api.RouterGroupPortsInUseError, message),
+		TcpRouteMappings: stranded,
+	})
+	if jsonErr != nil {
+		log.Error("could-not-marshal-json", jsonErr)
+	}
+
+	w.WriteHeader(http.StatusConflict)
+	_, writeErr := w.Write(retErr)
+	log.Error("error writing to request", writeErr)
+}
+
+func handleRouterGroupInUseError(w http.ResponseWriter, message string, dependents models.RouterGroupDependents, log lager.Logger) {
+	log.Info("router-group-in-use", lager.Data{"dependents": dependents})
+	retErr, jsonErr := json.Marshal(struct {
+		routing_api.Error
+		models.RouterGroupDependents
+	}{
+		Error:                 routing_api.NewError(routing_api.RouterGroupInUseError, message),
+		RouterGroupDependents: dependents,
+	})
+	if jsonErr != nil {
+		log.Error("could-not-marshal-json", jsonErr)
+	}
+
+	w.WriteHeader(http.StatusConflict)
+	_, writeErr := w.Write(retErr)
+	log.Error("error writing to request", writeErr)
+}
+
 func handleDBCommunicationError(w http.ResponseWriter, err error, log lager.Logger) {
 	log.Error("error", err)
 	retErr := marshalRoutingApiError(routing_api.NewError(routing_api.DBCommunicationError, err.Error()), log)
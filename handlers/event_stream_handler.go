@@ -39,7 +39,7 @@ func (h *EventStreamHandler) EventStream(w http.ResponseWriter, req *http.Reques
 		}
 	}()
 	log := h.logger.Session("event-stream-handler")
-	h.handleEventStream(log, db.HTTP_WATCH, w, req)
+	h.handleEventStream(log, db.HTTP_WATCH, RoutingRoutesReadScope, w, req)
 }
 
 func (h *EventStreamHandler) TcpEventStream(w http.ResponseWriter, req *http.Request) {
@@ -54,17 +54,47 @@ func (h *EventStreamHandler) TcpEventStream(w http.ResponseWriter, req *http.Req
 		}
 	}()
 	log := h.logger.Session("tcp-event-stream-handler")
-	h.handleEventStream(log, db.TCP_WATCH, w, req)
+	h.handleEventStream(log, db.TCP_WATCH, RoutingRoutesReadScope, w, req)
 }
 
-func (h *EventStreamHandler) handleEventStream(log lager.Logger, filterKey string,
+func (h *EventStreamHandler) UdpEventStream(w http.ResponseWriter, req *http.Request) {
+	log := h.logger.Session("udp-event-stream-handler")
+	h.handleEventStream(log, db.UDP_WATCH, RoutingRoutesReadScope, w, req)
+}
+
+func (h *EventStreamHandler) PortReservationEventStream(w http.ResponseWriter, req *http.Request) {
+	log := h.logger.Session("port-reservation-event-stream-handler")
+	h.handleEventStream(log, db.PORT_RESERVATION_WATCH, RouterGroupsReadScope, w, req)
+}
+
+func (h *EventStreamHandler) RouterGroupEventStream(w http.ResponseWriter, req *http.Request) {
+	log := h.logger.Session("router-group-event-stream-handler")
+	h.handleEventStream(log, db.ROUTER_GROUP_WATCH, RouterGroupsReadScope, w, req)
+}
+
+func (h *EventStreamHandler) TlsCertificateEventStream(w http.ResponseWriter, req *http.Request) {
+	log := h.logger.Session("tls-certificate-event-stream-handler")
+	h.handleEventStream(log, db.TLS_CERTIFICATE_WATCH, TlsCertificatesReadScope, w, req)
+}
+
+func (h *EventStreamHandler) BackendTlsProfileEventStream(w http.ResponseWriter, req *http.Request) {
+	log := h.logger.Session("backend-tls-profile-event-stream-handler")
+	h.handleEventStream(log, db.BACKEND_TLS_PROFILE_WATCH, BackendTlsProfilesReadScope, w, req)
+}
+
+func (h *EventStreamHandler) handleEventStream(log lager.Logger, filterKey string, scope string,
 	w http.ResponseWriter, req *http.Request) {
 
-	err := h.uaaClient.ValidateToken(req.Header.Get("Authorization"), RoutingRoutesReadScope)
+	err := h.uaaClient.ValidateToken(req.Header.Get("Authorization"), scope)
 	if err != nil {
 		handleUnauthorizedError(w, err, log)
 		return
 	}
+	selector, err := labelSelectorFromRequest(req)
+	if err != nil {
+		handleProcessRequestError(w, err, log)
+		return
+	}
 	flusher := w.(http.Flusher)
 	reqCtx := req.Context()
 	closeNotifier := reqCtx.Done()
@@ -88,6 +118,10 @@ func (h *EventStreamHandler) handleEventStream(log lager.Logger, filterKey strin
 				return
 			}
 
+			if !eventMatchesLabelSelector(selector, event.Value) {
+				continue
+			}
+
 			err = sse.Event{
 				ID:   strconv.Itoa(eventID),
 				Name: eventType.String(),
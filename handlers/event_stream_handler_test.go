@@ -278,5 +278,225 @@ var _ = Describe("EventsHandler", func() {
 				})
 			})
 		})
+
+		Describe("TcpEventStream with a label selector", func() {
+			var labelSelector string
+
+			BeforeEach(func() {
+				labelSelector = "env%3Dprod"
+				eventStreamDone = make(chan struct{})
+				server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					r.URL.RawQuery = "label_selector=" + labelSelector
+					handler.TcpEventStream(w, r)
+					close(eventStreamDone)
+				}))
+
+				resultsChan := make(chan db.Event, 3)
+				resultsChan <- db.Event{Type: db.UpdateEvent, Value: `{"port":52000,"labels":{"env":"staging"}}`}
+				resultsChan <- db.Event{Type: db.UpdateEvent, Value: `{"port":52001}`}
+				resultsChan <- db.Event{Type: db.UpdateEvent, Value: `{"port":52002,"labels":{"env":"prod"}}`}
+				database.WatchChangesReturns(resultsChan, nil, emptyCancelFunc)
+			})
+
+			It("only emits events whose labels match the selector", func() {
+				reader := sse.NewReadCloser(response.Body)
+
+				event, err := reader.Next()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(event).To(Equal(sse.Event{ID: "0", Name: "Upsert", Data: []byte(`{"port":52002,"labels":{"env":"prod"}}`)}))
+			})
+
+			Context("when the selector is invalid", func() {
+				BeforeEach(func() {
+					labelSelector = "env+within+(prod)"
+				})
+
+				It("returns a 400 without watching the db", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+					Eventually(eventStreamDone).Should(BeClosed())
+					Expect(database.WatchChangesCallCount()).To(Equal(0))
+				})
+			})
+		})
+
+		Describe("UdpEventStream", func() {
+			BeforeEach(func() {
+				eventStreamDone = make(chan struct{})
+				server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					handler.UdpEventStream(w, r)
+					close(eventStreamDone)
+				}))
+			})
+
+			It("checks for routing.routes.read scope", func() {
+				_, permission := fakeClient.ValidateTokenArgsForCall(0)
+				Expect(permission).To(ConsistOf(handlers.RoutingRoutesReadScope))
+			})
+
+			Context("when there are changes in db", func() {
+				BeforeEach(func() {
+					resultsChan := make(chan db.Event, 1)
+					resultsChan <- db.Event{Type: db.UpdateEvent, Value: "valuable-string"}
+					database.WatchChangesReturns(resultsChan, nil, emptyCancelFunc)
+				})
+
+				It("emits events from changes in the db", func() {
+					reader := sse.NewReadCloser(response.Body)
+
+					event, err := reader.Next()
+					Expect(err).NotTo(HaveOccurred())
+
+					expectedEvent := sse.Event{ID: "0", Name: "Upsert", Data: []byte("valuable-string")}
+
+					Expect(event).To(Equal(expectedEvent))
+					filterString := database.WatchChangesArgsForCall(0)
+					Expect(filterString).To(Equal(db.UDP_WATCH))
+				})
+			})
+		})
+
+		Describe("PortReservationEventStream", func() {
+			BeforeEach(func() {
+				eventStreamDone = make(chan struct{})
+				server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					handler.PortReservationEventStream(w, r)
+					close(eventStreamDone)
+				}))
+			})
+
+			It("checks for routing.router_groups.read scope", func() {
+				_, permission := fakeClient.ValidateTokenArgsForCall(0)
+				Expect(permission).To(ConsistOf(handlers.RouterGroupsReadScope))
+			})
+
+			Context("when there are changes in db", func() {
+				BeforeEach(func() {
+					resultsChan := make(chan db.Event, 1)
+					resultsChan <- db.Event{Type: db.CreateEvent, Value: "valuable-string"}
+					database.WatchChangesReturns(resultsChan, nil, emptyCancelFunc)
+				})
+
+				It("emits events from changes in the db", func() {
+					reader := sse.NewReadCloser(response.Body)
+
+					event, err := reader.Next()
+					Expect(err).NotTo(HaveOccurred())
+
+					expectedEvent := sse.Event{ID: "0", Name: "Upsert", Data: []byte("valuable-string")}
+
+					Expect(event).To(Equal(expectedEvent))
+					filterString := database.WatchChangesArgsForCall(0)
+					Expect(filterString).To(Equal(db.PORT_RESERVATION_WATCH))
+				})
+			})
+		})
+
+		Describe("RouterGroupEventStream", func() {
+			BeforeEach(func() {
+				eventStreamDone = make(chan struct{})
+				server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					handler.RouterGroupEventStream(w, r)
+					close(eventStreamDone)
+				}))
+			})
+
+			It("checks for routing.router_groups.read scope", func() {
+				_, permission := fakeClient.ValidateTokenArgsForCall(0)
+				Expect(permission).To(ConsistOf(handlers.RouterGroupsReadScope))
+			})
+
+			Context("when there are changes in db", func() {
+				BeforeEach(func() {
+					resultsChan := make(chan db.Event, 1)
+					resultsChan <- db.Event{Type: db.CreateEvent, Value: "valuable-string"}
+					database.WatchChangesReturns(resultsChan, nil, emptyCancelFunc)
+				})
+
+				It("emits events from changes in the db", func() {
+					reader := sse.NewReadCloser(response.Body)
+
+					event, err := reader.Next()
+					Expect(err).NotTo(HaveOccurred())
+
+					expectedEvent := sse.Event{ID: "0", Name: "Upsert", Data: []byte("valuable-string")}
+
+					Expect(event).To(Equal(expectedEvent))
+					filterString := database.WatchChangesArgsForCall(0)
+					Expect(filterString).To(Equal(db.ROUTER_GROUP_WATCH))
+				})
+			})
+		})
+
+		Describe("TlsCertificateEventStream", func() {
+			BeforeEach(func() {
+				eventStreamDone = make(chan struct{})
+				server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					handler.TlsCertificateEventStream(w, r)
+					close(eventStreamDone)
+				}))
+			})
+
+			It("checks for routing.tls_certificates.read scope", func() {
+				_, permission := fakeClient.ValidateTokenArgsForCall(0)
+				Expect(permission).To(ConsistOf(handlers.TlsCertificatesReadScope))
+			})
+
+			Context("when there are changes in db", func() {
+				BeforeEach(func() {
+					resultsChan := make(chan db.Event, 1)
+					resultsChan <- db.Event{Type: db.UpdateEvent, Value: "valuable-string"}
+					database.WatchChangesReturns(resultsChan, nil, emptyCancelFunc)
+				})
+
+				It("emits events from changes in the db", func() {
+					reader := sse.NewReadCloser(response.Body)
+
+					event, err := reader.Next()
+					Expect(err).NotTo(HaveOccurred())
+
+					expectedEvent := sse.Event{ID: "0", Name: "Upsert", Data: []byte("valuable-string")}
+
+					Expect(event).To(Equal(expectedEvent))
+					filterString := database.WatchChangesArgsForCall(0)
+					Expect(filterString).To(Equal(db.TLS_CERTIFICATE_WATCH))
+				})
+			})
+		})
+
+		Describe("BackendTlsProfileEventStream", func() {
+			BeforeEach(func() {
+				eventStreamDone = make(chan struct{})
+				server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					handler.BackendTlsProfileEventStream(w, r)
+					close(eventStreamDone)
+				}))
+			})
+
+			It("checks for routing.backend_tls_profiles.read scope", func() {
+				_, permission := fakeClient.ValidateTokenArgsForCall(0)
+				Expect(permission).To(ConsistOf(handlers.BackendTlsProfilesReadScope))
+			})
+
+			Context("when there are changes in db", func() {
+				BeforeEach(func() {
+					resultsChan := make(chan db.Event, 1)
+					resultsChan <- db.Event{Type: db.UpdateEvent, Value: "valuable-string"}
+					database.WatchChangesReturns(resultsChan, nil, emptyCancelFunc)
+				})
+
+				It("emits events from changes in the db", func() {
+					reader := sse.NewReadCloser(response.Body)
+
+					event, err := reader.Next()
+					Expect(err).NotTo(HaveOccurred())
+
+					expectedEvent := sse.Event{ID: "0", Name: "Upsert", Data: []byte("valuable-string")}
+
+					Expect(event).To(Equal(expectedEvent))
+					filterString := database.WatchChangesArgsForCall(0)
+					Expect(filterString).To(Equal(db.BACKEND_TLS_PROFILE_WATCH))
+				})
+			})
+		})
 	})
 })
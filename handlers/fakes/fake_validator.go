@@ -10,11 +10,12 @@ import (
 )
 
 type FakeRouteValidator struct {
-	ValidateCreateStub        func([]models.Route, int) *routing_api.Error
+	ValidateCreateStub        func([]models.Route, models.RouterGroups, int) *routing_api.Error
 	validateCreateMutex       sync.RWMutex
 	validateCreateArgsForCall []struct {
 		arg1 []models.Route
-		arg2 int
+		arg2 models.RouterGroups
+		arg3 int
 	}
 	validateCreateReturns struct {
 		result1 *routing_api.Error
@@ -49,6 +50,19 @@ type FakeRouteValidator struct {
 	validateCreateTcpRouteMappingsReturnsOnCall map[int]struct {
 		result1 *routing_api.Error
 	}
+	ValidateCreateUdpRouteMappingStub        func(models.UdpRouteMapping, models.RouterGroups, int) *routing_api.Error
+	validateCreateUdpRouteMappingMutex       sync.RWMutex
+	validateCreateUdpRouteMappingArgsForCall []struct {
+		arg1 models.UdpRouteMapping
+		arg2 models.RouterGroups
+		arg3 int
+	}
+	validateCreateUdpRouteMappingReturns struct {
+		result1 *routing_api.Error
+	}
+	validateCreateUdpRouteMappingReturnsOnCall map[int]struct {
+		result1 *routing_api.Error
+	}
 	ValidateDeleteStub        func([]models.Route) *routing_api.Error
 	validateDeleteMutex       sync.RWMutex
 	validateDeleteArgsForCall []struct {
@@ -71,11 +85,58 @@ type FakeRouteValidator struct {
 	validateDeleteTcpRouteMappingReturnsOnCall map[int]struct {
 		result1 *routing_api.Error
 	}
+	ValidateDeleteUdpRouteMappingStub        func([]models.UdpRouteMapping) *routing_api.Error
+	validateDeleteUdpRouteMappingMutex       sync.RWMutex
+	validateDeleteUdpRouteMappingArgsForCall []struct {
+		arg1 []models.UdpRouteMapping
+	}
+	validateDeleteUdpRouteMappingReturns struct {
+		result1 *routing_api.Error
+	}
+	validateDeleteUdpRouteMappingReturnsOnCall map[int]struct {
+		result1 *routing_api.Error
+	}
+	ValidateTcpRouteMappingBackendTlsProfileStub        func(models.TcpRouteMapping, models.BackendTlsProfile) *routing_api.Error
+	validateTcpRouteMappingBackendTlsProfileMutex       sync.RWMutex
+	validateTcpRouteMappingBackendTlsProfileArgsForCall []struct {
+		arg1 models.TcpRouteMapping
+		arg2 models.BackendTlsProfile
+	}
+	validateTcpRouteMappingBackendTlsProfileReturns struct {
+		result1 *routing_api.Error
+	}
+	validateTcpRouteMappingBackendTlsProfileReturnsOnCall map[int]struct {
+		result1 *routing_api.Error
+	}
+	ValidateTcpRouteMappingOverlapsStub        func(models.TcpRouteMapping, []models.TcpRouteMapping) *routing_api.Error
+	validateTcpRouteMappingOverlapsMutex       sync.RWMutex
+	validateTcpRouteMappingOverlapsArgsForCall []struct {
+		arg1 models.TcpRouteMapping
+		arg2 []models.TcpRouteMapping
+	}
+	validateTcpRouteMappingOverlapsReturns struct {
+		result1 *routing_api.Error
+	}
+	validateTcpRouteMappingOverlapsReturnsOnCall map[int]struct {
+		result1 *routing_api.Error
+	}
+	ValidateTcpRouteMappingTlsCertificateStub        func(models.TcpRouteMapping, models.TlsCertificate) *routing_api.Error
+	validateTcpRouteMappingTlsCertificateMutex       sync.RWMutex
+	validateTcpRouteMappingTlsCertificateArgsForCall []struct {
+		arg1 models.TcpRouteMapping
+		arg2 models.TlsCertificate
+	}
+	validateTcpRouteMappingTlsCertificateReturns struct {
+		result1 *routing_api.Error
+	}
+	validateTcpRouteMappingTlsCertificateReturnsOnCall map[int]struct {
+		result1 *routing_api.Error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
 
-func (fake *FakeRouteValidator) ValidateCreate(arg1 []models.Route, arg2 int) *routing_api.Error {
+func (fake *FakeRouteValidator) ValidateCreate(arg1 []models.Route, arg2 models.RouterGroups, arg3 int) *routing_api.Error {
 	var arg1Copy []models.Route
 	if arg1 != nil {
 		arg1Copy = make([]models.Route, len(arg1))
@@ -85,14 +146,15 @@ func (fake *FakeRouteValidator) ValidateCreate(arg1 []models.Route, arg2 int) *r
 	ret, specificReturn := fake.validateCreateReturnsOnCall[len(fake.validateCreateArgsForCall)]
 	fake.validateCreateArgsForCall = append(fake.validateCreateArgsForCall, struct {
 		arg1 []models.Route
-		arg2 int
-	}{arg1Copy, arg2})
+		arg2 models.RouterGroups
+		arg3 int
+	}{arg1Copy, arg2, arg3})
 	stub := fake.ValidateCreateStub
 	fakeReturns := fake.validateCreateReturns
-	fake.recordInvocation("ValidateCreate", []interface{}{arg1Copy, arg2})
+	fake.recordInvocation("ValidateCreate", []interface{}{arg1Copy, arg2, arg3})
 	fake.validateCreateMutex.Unlock()
 	if stub != nil {
-		return stub(arg1, arg2)
+		return stub(arg1, arg2, arg3)
 	}
 	if specificReturn {
 		return ret.result1
@@ -106,17 +168,17 @@ func (fake *FakeRouteValidator) ValidateCreateCallCount() int {
 	return len(fake.validateCreateArgsForCall)
 }
 
-func (fake *FakeRouteValidator) ValidateCreateCalls(stub func([]models.Route, int) *routing_api.Error) {
+func (fake *FakeRouteValidator) ValidateCreateCalls(stub func([]models.Route, models.RouterGroups, int) *routing_api.Error) {
 	fake.validateCreateMutex.Lock()
 	defer fake.validateCreateMutex.Unlock()
 	fake.ValidateCreateStub = stub
 }
 
-func (fake *FakeRouteValidator) ValidateCreateArgsForCall(i int) ([]models.Route, int) {
+func (fake *FakeRouteValidator) ValidateCreateArgsForCall(i int) ([]models.Route, models.RouterGroups, int) {
 	fake.validateCreateMutex.RLock()
 	defer fake.validateCreateMutex.RUnlock()
 	argsForCall := fake.validateCreateArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
 }
 
 func (fake *FakeRouteValidator) ValidateCreateReturns(result1 *routing_api.Error) {
@@ -279,6 +341,69 @@ func (fake *FakeRouteValidator) ValidateCreateTcpRouteMappingsReturnsOnCall(i in
 	}{result1}
 }
 
+func (fake *FakeRouteValidator) ValidateCreateUdpRouteMapping(arg1 models.UdpRouteMapping, arg2 models.RouterGroups, arg3 int) *routing_api.Error {
+	fake.validateCreateUdpRouteMappingMutex.Lock()
+	ret, specificReturn := fake.validateCreateUdpRouteMappingReturnsOnCall[len(fake.validateCreateUdpRouteMappingArgsForCall)]
+	fake.validateCreateUdpRouteMappingArgsForCall = append(fake.validateCreateUdpRouteMappingArgsForCall, struct {
+		arg1 models.UdpRouteMapping
+		arg2 models.RouterGroups
+		arg3 int
+	}{arg1, arg2, arg3})
+	stub := fake.ValidateCreateUdpRouteMappingStub
+	fakeReturns := fake.validateCreateUdpRouteMappingReturns
+	fake.recordInvocation("ValidateCreateUdpRouteMapping", []interface{}{arg1, arg2, arg3})
+	fake.validateCreateUdpRouteMappingMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeRouteValidator) ValidateCreateUdpRouteMappingCallCount() int {
+	fake.validateCreateUdpRouteMappingMutex.RLock()
+	defer fake.validateCreateUdpRouteMappingMutex.RUnlock()
+	return len(fake.validateCreateUdpRouteMappingArgsForCall)
+}
+
+func (fake *FakeRouteValidator) ValidateCreateUdpRouteMappingCalls(stub func(models.UdpRouteMapping, models.RouterGroups, int) *routing_api.Error) {
+	fake.validateCreateUdpRouteMappingMutex.Lock()
+	defer fake.validateCreateUdpRouteMappingMutex.Unlock()
+	fake.ValidateCreateUdpRouteMappingStub = stub
+}
+
+func (fake *FakeRouteValidator) ValidateCreateUdpRouteMappingArgsForCall(i int) (models.UdpRouteMapping, models.RouterGroups, int) {
+	fake.validateCreateUdpRouteMappingMutex.RLock()
+	defer fake.validateCreateUdpRouteMappingMutex.RUnlock()
+	argsForCall := fake.validateCreateUdpRouteMappingArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeRouteValidator) ValidateCreateUdpRouteMappingReturns(result1 *routing_api.Error) {
+	fake.validateCreateUdpRouteMappingMutex.Lock()
+	defer fake.validateCreateUdpRouteMappingMutex.Unlock()
+	fake.ValidateCreateUdpRouteMappingStub = nil
+	fake.validateCreateUdpRouteMappingReturns = struct {
+		result1 *routing_api.Error
+	}{result1}
+}
+
+func (fake *FakeRouteValidator) ValidateCreateUdpRouteMappingReturnsOnCall(i int, result1 *routing_api.Error) {
+	fake.validateCreateUdpRouteMappingMutex.Lock()
+	defer fake.validateCreateUdpRouteMappingMutex.Unlock()
+	fake.ValidateCreateUdpRouteMappingStub = nil
+	if fake.validateCreateUdpRouteMappingReturnsOnCall == nil {
+		fake.validateCreateUdpRouteMappingReturnsOnCall = make(map[int]struct {
+			result1 *routing_api.Error
+		})
+	}
+	fake.validateCreateUdpRouteMappingReturnsOnCall[i] = struct {
+		result1 *routing_api.Error
+	}{result1}
+}
+
 func (fake *FakeRouteValidator) ValidateDelete(arg1 []models.Route) *routing_api.Error {
 	var arg1Copy []models.Route
 	if arg1 != nil {
@@ -411,6 +536,263 @@ func (fake *FakeRouteValidator) ValidateDeleteTcpRouteMappingReturnsOnCall(i int
 	}{result1}
 }
 
+func (fake *FakeRouteValidator) ValidateDeleteUdpRouteMapping(arg1 []models.UdpRouteMapping) *routing_api.Error {
+	var arg1Copy []models.UdpRouteMapping
+	if arg1 != nil {
+		arg1Copy = make([]models.UdpRouteMapping, len(arg1))
+		copy(arg1Copy, arg1)
+	}
+	fake.validateDeleteUdpRouteMappingMutex.Lock()
+	ret, specificReturn := fake.validateDeleteUdpRouteMappingReturnsOnCall[len(fake.validateDeleteUdpRouteMappingArgsForCall)]
+	fake.validateDeleteUdpRouteMappingArgsForCall = append(fake.validateDeleteUdpRouteMappingArgsForCall, struct {
+		arg1 []models.UdpRouteMapping
+	}{arg1Copy})
+	stub := fake.ValidateDeleteUdpRouteMappingStub
+	fakeReturns := fake.validateDeleteUdpRouteMappingReturns
+	fake.recordInvocation("ValidateDeleteUdpRouteMapping", []interface{}{arg1Copy})
+	fake.validateDeleteUdpRouteMappingMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeRouteValidator) ValidateDeleteUdpRouteMappingCallCount() int {
+	fake.validateDeleteUdpRouteMappingMutex.RLock()
+	defer fake.validateDeleteUdpRouteMappingMutex.RUnlock()
+	return len(fake.validateDeleteUdpRouteMappingArgsForCall)
+}
+
+func (fake *FakeRouteValidator) ValidateDeleteUdpRouteMappingCalls(stub func([]models.UdpRouteMapping) *routing_api.Error) {
+	fake.validateDeleteUdpRouteMappingMutex.Lock()
+	defer fake.validateDeleteUdpRouteMappingMutex.Unlock()
+	fake.ValidateDeleteUdpRouteMappingStub = stub
+}
+
+func (fake *FakeRouteValidator) ValidateDeleteUdpRouteMappingArgsForCall(i int) []models.UdpRouteMapping {
+	fake.validateDeleteUdpRouteMappingMutex.RLock()
+	defer fake.validateDeleteUdpRouteMappingMutex.RUnlock()
+	argsForCall := fake.validateDeleteUdpRouteMappingArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeRouteValidator) ValidateDeleteUdpRouteMappingReturns(result1 *routing_api.Error) {
+	fake.validateDeleteUdpRouteMappingMutex.Lock()
+	defer fake.validateDeleteUdpRouteMappingMutex.Unlock()
+	fake.ValidateDeleteUdpRouteMappingStub = nil
+	fake.validateDeleteUdpRouteMappingReturns = struct {
+		result1 *routing_api.Error
+	}{result1}
+}
+
+func (fake *FakeRouteValidator) ValidateDeleteUdpRouteMappingReturnsOnCall(i int, result1 *routing_api.Error) {
+	fake.validateDeleteUdpRouteMappingMutex.Lock()
+	defer fake.validateDeleteUdpRouteMappingMutex.Unlock()
+	fake.ValidateDeleteUdpRouteMappingStub = nil
+	if fake.validateDeleteUdpRouteMappingReturnsOnCall == nil {
+		fake.validateDeleteUdpRouteMappingReturnsOnCall = make(map[int]struct {
+			result1 *routing_api.Error
+		})
+	}
+	fake.validateDeleteUdpRouteMappingReturnsOnCall[i] = struct {
+		result1 *routing_api.Error
+	}{result1}
+}
+
+func (fake *FakeRouteValidator) ValidateTcpRouteMappingBackendTlsProfile(arg1 models.TcpRouteMapping, arg2 models.BackendTlsProfile) *routing_api.Error {
+	fake.validateTcpRouteMappingBackendTlsProfileMutex.Lock()
+	ret, specificReturn := fake.validateTcpRouteMappingBackendTlsProfileReturnsOnCall[len(fake.validateTcpRouteMappingBackendTlsProfileArgsForCall)]
+	fake.validateTcpRouteMappingBackendTlsProfileArgsForCall = append(fake.validateTcpRouteMappingBackendTlsProfileArgsForCall, struct {
+		arg1 models.TcpRouteMapping
+		arg2 models.BackendTlsProfile
+	}{arg1, arg2})
+	stub := fake.ValidateTcpRouteMappingBackendTlsProfileStub
+	fakeReturns := fake.validateTcpRouteMappingBackendTlsProfileReturns
+	fake.recordInvocation("ValidateTcpRouteMappingBackendTlsProfile", []interface{}{arg1, arg2})
+	fake.validateTcpRouteMappingBackendTlsProfileMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeRouteValidator) ValidateTcpRouteMappingBackendTlsProfileCallCount() int {
+	fake.validateTcpRouteMappingBackendTlsProfileMutex.RLock()
+	defer fake.validateTcpRouteMappingBackendTlsProfileMutex.RUnlock()
+	return len(fake.validateTcpRouteMappingBackendTlsProfileArgsForCall)
+}
+
+func (fake *FakeRouteValidator) ValidateTcpRouteMappingBackendTlsProfileCalls(stub func(models.TcpRouteMapping, models.BackendTlsProfile) *routing_api.Error) {
+	fake.validateTcpRouteMappingBackendTlsProfileMutex.Lock()
+	defer fake.validateTcpRouteMappingBackendTlsProfileMutex.Unlock()
+	fake.ValidateTcpRouteMappingBackendTlsProfileStub = stub
+}
+
+func (fake *FakeRouteValidator) ValidateTcpRouteMappingBackendTlsProfileArgsForCall(i int) (models.TcpRouteMapping, models.BackendTlsProfile) {
+	fake.validateTcpRouteMappingBackendTlsProfileMutex.RLock()
+	defer fake.validateTcpRouteMappingBackendTlsProfileMutex.RUnlock()
+	argsForCall := fake.validateTcpRouteMappingBackendTlsProfileArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRouteValidator) ValidateTcpRouteMappingBackendTlsProfileReturns(result1 *routing_api.Error) {
+	fake.validateTcpRouteMappingBackendTlsProfileMutex.Lock()
+	defer fake.validateTcpRouteMappingBackendTlsProfileMutex.Unlock()
+	fake.ValidateTcpRouteMappingBackendTlsProfileStub = nil
+	fake.validateTcpRouteMappingBackendTlsProfileReturns = struct {
+		result1 *routing_api.Error
+	}{result1}
+}
+
+func (fake *FakeRouteValidator) ValidateTcpRouteMappingBackendTlsProfileReturnsOnCall(i int, result1 *routing_api.Error) {
+	fake.validateTcpRouteMappingBackendTlsProfileMutex.Lock()
+	defer fake.validateTcpRouteMappingBackendTlsProfileMutex.Unlock()
+	fake.ValidateTcpRouteMappingBackendTlsProfileStub = nil
+	if fake.validateTcpRouteMappingBackendTlsProfileReturnsOnCall == nil {
+		fake.validateTcpRouteMappingBackendTlsProfileReturnsOnCall = make(map[int]struct {
+			result1 *routing_api.Error
+		})
+	}
+	fake.validateTcpRouteMappingBackendTlsProfileReturnsOnCall[i] = struct {
+		result1 *routing_api.Error
+	}{result1}
+}
+
+func (fake *FakeRouteValidator) ValidateTcpRouteMappingOverlaps(arg1 models.TcpRouteMapping, arg2 []models.TcpRouteMapping) *routing_api.Error {
+	var arg2Copy []models.TcpRouteMapping
+	if arg2 != nil {
+		arg2Copy = make([]models.TcpRouteMapping, len(arg2))
+		copy(arg2Copy, arg2)
+	}
+	fake.validateTcpRouteMappingOverlapsMutex.Lock()
+	ret, specificReturn := fake.validateTcpRouteMappingOverlapsReturnsOnCall[len(fake.validateTcpRouteMappingOverlapsArgsForCall)]
+	fake.validateTcpRouteMappingOverlapsArgsForCall = append(fake.validateTcpRouteMappingOverlapsArgsForCall, struct {
+		arg1 models.TcpRouteMapping
+		arg2 []models.TcpRouteMapping
+	}{arg1, arg2Copy})
+	stub := fake.ValidateTcpRouteMappingOverlapsStub
+	fakeReturns := fake.validateTcpRouteMappingOverlapsReturns
+	fake.recordInvocation("ValidateTcpRouteMappingOverlaps", []interface{}{arg1, arg2Copy})
+	fake.validateTcpRouteMappingOverlapsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeRouteValidator) ValidateTcpRouteMappingOverlapsCallCount() int {
+	fake.validateTcpRouteMappingOverlapsMutex.RLock()
+	defer fake.validateTcpRouteMappingOverlapsMutex.RUnlock()
+	return len(fake.validateTcpRouteMappingOverlapsArgsForCall)
+}
+
+func (fake *FakeRouteValidator) ValidateTcpRouteMappingOverlapsCalls(stub func(models.TcpRouteMapping, []models.TcpRouteMapping) *routing_api.Error) {
+	fake.validateTcpRouteMappingOverlapsMutex.Lock()
+	defer fake.validateTcpRouteMappingOverlapsMutex.Unlock()
+	fake.ValidateTcpRouteMappingOverlapsStub = stub
+}
+
+func (fake *FakeRouteValidator) ValidateTcpRouteMappingOverlapsArgsForCall(i int) (models.TcpRouteMapping, []models.TcpRouteMapping) {
+	fake.validateTcpRouteMappingOverlapsMutex.RLock()
+	defer fake.validateTcpRouteMappingOverlapsMutex.RUnlock()
+	argsForCall := fake.validateTcpRouteMappingOverlapsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRouteValidator) ValidateTcpRouteMappingOverlapsReturns(result1 *routing_api.Error) {
+	fake.validateTcpRouteMappingOverlapsMutex.Lock()
+	defer fake.validateTcpRouteMappingOverlapsMutex.Unlock()
+	fake.ValidateTcpRouteMappingOverlapsStub = nil
+	fake.validateTcpRouteMappingOverlapsReturns = struct {
+		result1 *routing_api.Error
+	}{result1}
+}
+
+func (fake *FakeRouteValidator) ValidateTcpRouteMappingOverlapsReturnsOnCall(i int, result1 *routing_api.Error) {
+	fake.validateTcpRouteMappingOverlapsMutex.Lock()
+	defer fake.validateTcpRouteMappingOverlapsMutex.Unlock()
+	fake.ValidateTcpRouteMappingOverlapsStub = nil
+	if fake.validateTcpRouteMappingOverlapsReturnsOnCall == nil {
+		fake.validateTcpRouteMappingOverlapsReturnsOnCall = make(map[int]struct {
+			result1 *routing_api.Error
+		})
+	}
+	fake.validateTcpRouteMappingOverlapsReturnsOnCall[i] = struct {
+		result1 *routing_api.Error
+	}{result1}
+}
+
+func (fake *FakeRouteValidator) ValidateTcpRouteMappingTlsCertificate(arg1 models.TcpRouteMapping, arg2 models.TlsCertificate) *routing_api.Error {
+	fake.validateTcpRouteMappingTlsCertificateMutex.Lock()
+	ret, specificReturn := fake.validateTcpRouteMappingTlsCertificateReturnsOnCall[len(fake.validateTcpRouteMappingTlsCertificateArgsForCall)]
+	fake.validateTcpRouteMappingTlsCertificateArgsForCall = append(fake.validateTcpRouteMappingTlsCertificateArgsForCall, struct {
+		arg1 models.TcpRouteMapping
+		arg2 models.TlsCertificate
+	}{arg1, arg2})
+	stub := fake.ValidateTcpRouteMappingTlsCertificateStub
+	fakeReturns := fake.validateTcpRouteMappingTlsCertificateReturns
+	fake.recordInvocation("ValidateTcpRouteMappingTlsCertificate", []interface{}{arg1, arg2})
+	fake.validateTcpRouteMappingTlsCertificateMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeRouteValidator) ValidateTcpRouteMappingTlsCertificateCallCount() int {
+	fake.validateTcpRouteMappingTlsCertificateMutex.RLock()
+	defer fake.validateTcpRouteMappingTlsCertificateMutex.RUnlock()
+	return len(fake.validateTcpRouteMappingTlsCertificateArgsForCall)
+}
+
+func (fake *FakeRouteValidator) ValidateTcpRouteMappingTlsCertificateCalls(stub func(models.TcpRouteMapping, models.TlsCertificate) *routing_api.Error) {
+	fake.validateTcpRouteMappingTlsCertificateMutex.Lock()
+	defer fake.validateTcpRouteMappingTlsCertificateMutex.Unlock()
+	fake.ValidateTcpRouteMappingTlsCertificateStub = stub
+}
+
+func (fake *FakeRouteValidator) ValidateTcpRouteMappingTlsCertificateArgsForCall(i int) (models.TcpRouteMapping, models.TlsCertificate) {
+	fake.validateTcpRouteMappingTlsCertificateMutex.RLock()
+	defer fake.validateTcpRouteMappingTlsCertificateMutex.RUnlock()
+	argsForCall := fake.validateTcpRouteMappingTlsCertificateArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRouteValidator) ValidateTcpRouteMappingTlsCertificateReturns(result1 *routing_api.Error) {
+	fake.validateTcpRouteMappingTlsCertificateMutex.Lock()
+	defer fake.validateTcpRouteMappingTlsCertificateMutex.Unlock()
+	fake.ValidateTcpRouteMappingTlsCertificateStub = nil
+	fake.validateTcpRouteMappingTlsCertificateReturns = struct {
+		result1 *routing_api.Error
+	}{result1}
+}
+
+func (fake *FakeRouteValidator) ValidateTcpRouteMappingTlsCertificateReturnsOnCall(i int, result1 *routing_api.Error) {
+	fake.validateTcpRouteMappingTlsCertificateMutex.Lock()
+	defer fake.validateTcpRouteMappingTlsCertificateMutex.Unlock()
+	fake.ValidateTcpRouteMappingTlsCertificateStub = nil
+	if fake.validateTcpRouteMappingTlsCertificateReturnsOnCall == nil {
+		fake.validateTcpRouteMappingTlsCertificateReturnsOnCall = make(map[int]struct {
+			result1 *routing_api.Error
+		})
+	}
+	fake.validateTcpRouteMappingTlsCertificateReturnsOnCall[i] = struct {
+		result1 *routing_api.Error
+	}{result1}
+}
+
 func (fake *FakeRouteValidator) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -420,10 +802,20 @@ func (fake *FakeRouteValidator) Invocations() map[string][][]interface{} {
 	defer fake.validateCreateTcpRouteMappingMutex.RUnlock()
 	fake.validateCreateTcpRouteMappingsMutex.RLock()
 	defer fake.validateCreateTcpRouteMappingsMutex.RUnlock()
+	fake.validateCreateUdpRouteMappingMutex.RLock()
+	defer fake.validateCreateUdpRouteMappingMutex.RUnlock()
 	fake.validateDeleteMutex.RLock()
 	defer fake.validateDeleteMutex.RUnlock()
 	fake.validateDeleteTcpRouteMappingMutex.RLock()
 	defer fake.validateDeleteTcpRouteMappingMutex.RUnlock()
+	fake.validateDeleteUdpRouteMappingMutex.RLock()
+	defer fake.validateDeleteUdpRouteMappingMutex.RUnlock()
+	fake.validateTcpRouteMappingBackendTlsProfileMutex.RLock()
+	defer fake.validateTcpRouteMappingBackendTlsProfileMutex.RUnlock()
+	fake.validateTcpRouteMappingOverlapsMutex.RLock()
+	defer fake.validateTcpRouteMappingOverlapsMutex.RUnlock()
+	fake.validateTcpRouteMappingTlsCertificateMutex.RLock()
+	defer fake.validateTcpRouteMappingTlsCertificateMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
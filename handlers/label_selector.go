@@ -0,0 +1,31 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"code.cloudfoundry.org/routing-api/models"
+)
+
+const labelSelectorParam = "label_selector"
+
+func labelSelectorFromRequest(req *http.Request) (models.LabelSelector, error) {
+	return models.ParseLabelSelector(req.URL.Query().Get(labelSelectorParam))
+}
+
+// eventMatchesLabelSelector decodes only the labels of an event payload, so it
+// works for both http route and tcp route mapping events.
+func eventMatchesLabelSelector(selector models.LabelSelector, value string) bool {
+	if len(selector) == 0 {
+		return true
+	}
+
+	var labeled struct {
+		Labels models.LabelSet `json:"labels"`
+	}
+	err := json.Unmarshal([]byte(value), &labeled)
+	if err != nil {
+		return false
+	}
+	return selector.Matches(labeled.Labels)
+}
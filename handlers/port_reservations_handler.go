@@ -0,0 +1,189 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"code.cloudfoundry.org/lager/v3"
+	"code.cloudfoundry.org/routing-api/db"
+	"code.cloudfoundry.org/routing-api/models"
+	"code.cloudfoundry.org/routing-api/uaaclient"
+	"github.com/tedsuo/rata"
+)
+
+type PortReservationsHandler struct {
+	uaaClient  uaaclient.TokenValidator
+	logger     lager.Logger
+	db         db.DB
+	portPolicy models.PortPolicy
+}
+
+func NewPortReservationsHandler(uaaClient uaaclient.TokenValidator, logger lager.Logger, database db.DB, portPolicy models.PortPolicy) *PortReservationsHandler {
+	return &PortReservationsHandler{
+		uaaClient:  uaaClient,
+		logger:     logger,
+		db:         database,
+		portPolicy: portPolicy,
+	}
+}
+
+func (h *PortReservationsHandler) List(w http.ResponseWriter, req *http.Request) {
+	log := h.logger.Session("list-port-reservations")
+
+	err := h.uaaClient.ValidateToken(req.Header.Get("Authorization"), RouterGroupsReadScope)
+	if err != nil {
+		handleUnauthorizedError(w, err, log)
+		return
+	}
+
+	reservations, err := h.db.ReadPortReservations()
+	if err != nil {
+		handleDBCommunicationError(w, err, log)
+		return
+	}
+
+	query := req.URL.Query()
+	routerGroupGuid := query.Get("router_group_guid")
+	owner := query.Get("owner")
+
+	filtered := []models.PortReservation{}
+	for _, reservation := range reservations {
+		if routerGroupGuid != "" && reservation.RouterGroupGuid != routerGroupGuid {
+			continue
+		}
+		if owner != "" && reservation.Owner != owner {
+			continue
+		}
+		filtered = append(filtered, reservation)
+	}
+
+	writeJSONResponse(w, http.StatusOK, filtered, log)
+}
+
+func (h *PortReservationsHandler) Get(w http.ResponseWriter, req *http.Request) {
+	log := h.logger.Session("get-port-reservation")
+
+	err := h.uaaClient.ValidateToken(req.Header.Get("Authorization"), RouterGroupsReadScope)
+	if err != nil {
+		handleUnauthorizedError(w, err, log)
+		return
+	}
+
+	guid := rata.Param(req, "guid")
+	reservation, err := h.db.ReadPortReservation(guid)
+	if err != nil {
+		handleDBCommunicationError(w, err, log)
+		return
+	}
+	if reservation.Guid == "" {
+		handleNotFoundError(w, fmt.Errorf("port reservation '%s' not found", guid), log)
+		return
+	}
+
+	writeJSONResponse(w, http.StatusOK, reservation, log)
+}
+
+func (h *PortReservationsHandler) Create(w http.ResponseWriter, req *http.Request) {
+	log := h.logger.Session("create-port-reservation")
+
+	err := h.uaaClient.ValidateToken(req.Header.Get("Authorization"), RouterGroupsWriteScope)
+	if err != nil {
+		handleUnauthorizedError(w, err, log)
+		return
+	}
+
+	var reservation models.PortReservation
+	err = json.NewDecoder(req.Body).Decode(&reservation)
+	if err != nil {
+		handleProcessRequestError(w, err, log)
+		return
+	}
+
+	log.Info("request", lager.Data{"port_reservation": reservation})
+
+	routerGroup, err := h.db.ReadRouterGroup(reservation.RouterGroupGuid)
+	if err != nil {
+		handleDBCommunicationError(w, err, log)
+		return
+	}
+
+	err = validatePortReservation(reservation, routerGroup, h.portPolicy)
+	if err != nil {
+		handleProcessRequestError(w, err, log)
+		return
+	}
+
+	reservation, err = h.db.SavePortReservation(reservation)
+	if err != nil {
+		if dberr, ok := err.(db.DBError); ok {
+			switch dberr.Type {
+			case db.PortsExhausted:
+				handlePortRangeExhaustedError(w, err, log)
+				return
+			case db.UniqueField:
+				handleDBConflictError(w, err, log)
+				return
+			case db.KeyNotFound:
+				handleProcessRequestError(w, err, log)
+				return
+			}
+		}
+		handleDBCommunicationError(w, err, log)
+		return
+	}
+
+	writeJSONResponse(w, http.StatusCreated, reservation, log)
+}
+
+func (h *PortReservationsHandler) Delete(w http.ResponseWriter, req *http.Request) {
+	log := h.logger.Session("delete-port-reservation")
+
+	err := h.uaaClient.ValidateToken(req.Header.Get("Authorization"), RouterGroupsWriteScope)
+	if err != nil {
+		handleUnauthorizedError(w, err, log)
+		return
+	}
+
+	guid := rata.Param(req, "guid")
+	err = h.db.DeletePortReservation(guid)
+	if err != nil {
+		if dberr, ok := err.(db.DBError); ok && dberr.Type == db.KeyNotFound {
+			handleNotFoundError(w, fmt.Errorf("port reservation '%s' not found", guid), log)
+			return
+		}
+		handleDBCommunicationError(w, err, log)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func validatePortReservation(reservation models.PortReservation, routerGroup models.RouterGroup, portPolicy models.PortPolicy) error {
+	if reservation.RouterGroupGuid == "" {
+		return errors.New("each port reservation requires a non empty router group guid")
+	}
+
+	if reservation.Owner == "" {
+		return errors.New("each port reservation requires a non empty owner")
+	}
+
+	if reservation.TTL != nil && *reservation.TTL <= 0 {
+		return errors.New("each port reservation with a ttl requires a ttl greater than 0")
+	}
+
+	if routerGroup.Guid == "" {
+		return fmt.Errorf("router_group_guid: %s not found", reservation.RouterGroupGuid)
+	}
+
+	if routerGroup.Type != models.RouterGroup_TCP {
+		return fmt.Errorf("ports can only be reserved in router groups of type %s", models.RouterGroup_TCP)
+	}
+
+	if reservation.Port != 0 {
+		return routerGroup.ValidateExternalPort(reservation.Port, portPolicy)
+	}
+
+	return nil
+}
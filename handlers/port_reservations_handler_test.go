@@ -0,0 +1,344 @@
+package handlers_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+
+	"code.cloudfoundry.org/lager/v3/lagertest"
+	routing_api "code.cloudfoundry.org/routing-api"
+	"code.cloudfoundry.org/routing-api/db"
+	fake_db "code.cloudfoundry.org/routing-api/db/fakes"
+	"code.cloudfoundry.org/routing-api/handlers"
+	"code.cloudfoundry.org/routing-api/models"
+	fake_client "code.cloudfoundry.org/routing-api/uaaclient/fakes"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/tedsuo/rata"
+)
+
+var _ = Describe("PortReservationsHandler", func() {
+	var (
+		portReservationsHandler *handlers.PortReservationsHandler
+		handler                 http.Handler
+		request                 *http.Request
+		responseRecorder        *httptest.ResponseRecorder
+		fakeClient              *fake_client.FakeTokenValidator
+		fakeDb                  *fake_db.FakeDB
+		logger                  *lagertest.TestLogger
+		routerGroup             models.RouterGroup
+		portPolicy              models.PortPolicy
+	)
+
+	BeforeEach(func() {
+		logger = lagertest.NewTestLogger("test-port-reservations")
+		fakeClient = &fake_client.FakeTokenValidator{}
+		fakeDb = &fake_db.FakeDB{}
+		portPolicy = models.PortPolicy{SystemComponentPorts: []uint16{2222}}
+		portReservationsHandler = handlers.NewPortReservationsHandler(fakeClient, logger, fakeDb, portPolicy)
+		responseRecorder = httptest.NewRecorder()
+
+		routerGroup = models.RouterGroup{
+			Guid:            DefaultRouterGroupGuid,
+			Name:            DefaultRouterGroupName,
+			Type:            models.RouterGroup_TCP,
+			ReservablePorts: "2000-3000",
+		}
+		fakeDb.ReadRouterGroupReturns(routerGroup, nil)
+
+		var err error
+		handler, err = rata.NewRouter(
+			rata.Routes{
+				routing_api.RoutesMap[routing_api.CreatePortReservation],
+				routing_api.RoutesMap[routing_api.ListPortReservations],
+				routing_api.RoutesMap[routing_api.GetPortReservation],
+				routing_api.RoutesMap[routing_api.DeletePortReservation],
+			},
+			rata.Handlers{
+				routing_api.CreatePortReservation: http.HandlerFunc(portReservationsHandler.Create),
+				routing_api.ListPortReservations:  http.HandlerFunc(portReservationsHandler.List),
+				routing_api.GetPortReservation:    http.HandlerFunc(portReservationsHandler.Get),
+				routing_api.DeletePortReservation: http.HandlerFunc(portReservationsHandler.Delete),
+			},
+		)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	Describe("Create", func() {
+		var reservation models.PortReservation
+
+		BeforeEach(func() {
+			reservation = models.NewPortReservation(DefaultRouterGroupGuid, 0, "some-owner", nil)
+			fakeDb.SavePortReservationStub = func(r models.PortReservation) (models.PortReservation, error) {
+				r.Guid = "reservation-guid"
+				if r.Port == 0 {
+					r.Port = 2000
+				}
+				return r, nil
+			}
+		})
+
+		JustBeforeEach(func() {
+			body, err := json.Marshal(reservation)
+			Expect(err).NotTo(HaveOccurred())
+			request, err = http.NewRequest("POST", "/routing/v1/port_reservations", bytes.NewReader(body))
+			Expect(err).NotTo(HaveOccurred())
+			handler.ServeHTTP(responseRecorder, request)
+		})
+
+		It("checks for routing.router_groups.write scope", func() {
+			_, permission := fakeClient.ValidateTokenArgsForCall(0)
+			Expect(permission).To(ConsistOf(handlers.RouterGroupsWriteScope))
+		})
+
+		It("saves the reservation and returns it with the allocated port", func() {
+			Expect(fakeDb.SavePortReservationCallCount()).To(Equal(1))
+			Expect(fakeDb.SavePortReservationArgsForCall(0)).To(Equal(reservation))
+
+			Expect(responseRecorder.Code).To(Equal(http.StatusCreated))
+			var created models.PortReservation
+			Expect(json.Unmarshal(responseRecorder.Body.Bytes(), &created)).To(Succeed())
+			Expect(created.Guid).To(Equal("reservation-guid"))
+			Expect(created.Port).To(Equal(uint16(2000)))
+			Expect(created.Owner).To(Equal("some-owner"))
+		})
+
+		Context("when the owner is empty", func() {
+			BeforeEach(func() {
+				reservation.Owner = ""
+			})
+
+			It("returns a 400 Bad Request", func() {
+				Expect(fakeDb.SavePortReservationCallCount()).To(Equal(0))
+				Expect(responseRecorder.Code).To(Equal(http.StatusBadRequest))
+				Expect(responseRecorder.Body.String()).To(ContainSubstring("non empty owner"))
+			})
+		})
+
+		Context("when the ttl is not positive", func() {
+			BeforeEach(func() {
+				ttl := 0
+				reservation.TTL = &ttl
+			})
+
+			It("returns a 400 Bad Request", func() {
+				Expect(fakeDb.SavePortReservationCallCount()).To(Equal(0))
+				Expect(responseRecorder.Code).To(Equal(http.StatusBadRequest))
+			})
+		})
+
+		Context("when the router group does not exist", func() {
+			BeforeEach(func() {
+				fakeDb.ReadRouterGroupReturns(models.RouterGroup{}, nil)
+			})
+
+			It("returns a 400 Bad Request", func() {
+				Expect(fakeDb.SavePortReservationCallCount()).To(Equal(0))
+				Expect(responseRecorder.Code).To(Equal(http.StatusBadRequest))
+				Expect(responseRecorder.Body.String()).To(ContainSubstring("not found"))
+			})
+		})
+
+		Context("when the router group is not of type tcp", func() {
+			BeforeEach(func() {
+				routerGroup.Type = models.RouterGroup_HTTP
+				routerGroup.ReservablePorts = ""
+				fakeDb.ReadRouterGroupReturns(routerGroup, nil)
+			})
+
+			It("returns a 400 Bad Request", func() {
+				Expect(fakeDb.SavePortReservationCallCount()).To(Equal(0))
+				Expect(responseRecorder.Code).To(Equal(http.StatusBadRequest))
+			})
+		})
+
+		Context("when a port outside the reservable ports is requested", func() {
+			BeforeEach(func() {
+				reservation.Port = 5000
+			})
+
+			It("returns a 400 Bad Request", func() {
+				Expect(fakeDb.SavePortReservationCallCount()).To(Equal(0))
+				Expect(responseRecorder.Code).To(Equal(http.StatusBadRequest))
+				Expect(responseRecorder.Body.String()).To(ContainSubstring("not within the reservable ports"))
+			})
+		})
+
+		Context("when a reserved system component port is requested", func() {
+			BeforeEach(func() {
+				reservation.Port = 2222
+			})
+
+			It("returns a 400 Bad Request", func() {
+				Expect(fakeDb.SavePortReservationCallCount()).To(Equal(0))
+				Expect(responseRecorder.Code).To(Equal(http.StatusBadRequest))
+				Expect(responseRecorder.Body.String()).To(ContainSubstring("reserved system component port"))
+			})
+		})
+
+		Context("when a port excluded by the router group is requested", func() {
+			BeforeEach(func() {
+				routerGroup.ExcludedPorts = "2500-2510"
+				fakeDb.ReadRouterGroupReturns(routerGroup, nil)
+				reservation.Port = 2505
+			})
+
+			It("returns a 400 Bad Request", func() {
+				Expect(fakeDb.SavePortReservationCallCount()).To(Equal(0))
+				Expect(responseRecorder.Code).To(Equal(http.StatusBadRequest))
+				Expect(responseRecorder.Body.String()).To(ContainSubstring("excluded by router group"))
+			})
+		})
+
+		Context("when the port is reserved by another owner", func() {
+			BeforeEach(func() {
+				reservation.Port = 2500
+				fakeDb.SavePortReservationReturns(models.PortReservation{}, db.DBError{Type: db.UniqueField, Message: "reserved by another owner"})
+			})
+
+			It("returns a 409 Conflict", func() {
+				Expect(responseRecorder.Code).To(Equal(http.StatusConflict))
+				Expect(responseRecorder.Body.String()).To(ContainSubstring("DBConflictError"))
+			})
+		})
+
+		Context("when there are no free ports", func() {
+			BeforeEach(func() {
+				fakeDb.SavePortReservationReturns(models.PortReservation{}, db.DBError{Type: db.PortsExhausted, Message: "no free ports"})
+			})
+
+			It("returns a 409 Conflict", func() {
+				Expect(responseRecorder.Code).To(Equal(http.StatusConflict))
+				Expect(responseRecorder.Body.String()).To(ContainSubstring("PortRangeExhaustedError"))
+			})
+		})
+
+		Context("when the db fails to save the reservation", func() {
+			BeforeEach(func() {
+				fakeDb.SavePortReservationReturns(models.PortReservation{}, errors.New("db communication failed"))
+			})
+
+			It("returns a DB communication error", func() {
+				Expect(responseRecorder.Code).To(Equal(http.StatusServiceUnavailable))
+			})
+		})
+
+		Context("when authorization token is invalid", func() {
+			BeforeEach(func() {
+				fakeClient.ValidateTokenReturns(errors.New("kaboom"))
+			})
+
+			It("returns Unauthorized error", func() {
+				Expect(fakeDb.SavePortReservationCallCount()).To(Equal(0))
+				Expect(responseRecorder.Code).To(Equal(http.StatusUnauthorized))
+			})
+		})
+	})
+
+	Describe("List", func() {
+		BeforeEach(func() {
+			fakeDb.ReadPortReservationsReturns([]models.PortReservation{
+				models.NewPortReservation(DefaultRouterGroupGuid, 2000, "owner-a", nil),
+				models.NewPortReservation(DefaultRouterGroupGuid, 2001, "owner-b", nil),
+				models.NewPortReservation(DefaultOtherRouterGroupGuid, 2000, "owner-a", nil),
+			}, nil)
+		})
+
+		It("checks for routing.router_groups.read scope", func() {
+			var err error
+			request, err = http.NewRequest("GET", "/routing/v1/port_reservations", nil)
+			Expect(err).NotTo(HaveOccurred())
+			handler.ServeHTTP(responseRecorder, request)
+
+			_, permission := fakeClient.ValidateTokenArgsForCall(0)
+			Expect(permission).To(ConsistOf(handlers.RouterGroupsReadScope))
+		})
+
+		It("returns all reservations", func() {
+			var err error
+			request, err = http.NewRequest("GET", "/routing/v1/port_reservations", nil)
+			Expect(err).NotTo(HaveOccurred())
+			handler.ServeHTTP(responseRecorder, request)
+
+			Expect(responseRecorder.Code).To(Equal(http.StatusOK))
+			var reservations []models.PortReservation
+			Expect(json.Unmarshal(responseRecorder.Body.Bytes(), &reservations)).To(Succeed())
+			Expect(reservations).To(HaveLen(3))
+		})
+
+		It("filters by router group and owner", func() {
+			var err error
+			request, err = http.NewRequest("GET", "/routing/v1/port_reservations?router_group_guid="+DefaultRouterGroupGuid+"&owner=owner-a", nil)
+			Expect(err).NotTo(HaveOccurred())
+			handler.ServeHTTP(responseRecorder, request)
+
+			Expect(responseRecorder.Code).To(Equal(http.StatusOK))
+			var reservations []models.PortReservation
+			Expect(json.Unmarshal(responseRecorder.Body.Bytes(), &reservations)).To(Succeed())
+			Expect(reservations).To(HaveLen(1))
+			Expect(reservations[0].Port).To(Equal(uint16(2000)))
+			Expect(reservations[0].RouterGroupGuid).To(Equal(DefaultRouterGroupGuid))
+		})
+	})
+
+	Describe("Get", func() {
+		It("returns the reservation", func() {
+			reservation := models.NewPortReservation(DefaultRouterGroupGuid, 2000, "owner-a", nil)
+			reservation.Guid = "reservation-guid"
+			fakeDb.ReadPortReservationReturns(reservation, nil)
+
+			var err error
+			request, err = http.NewRequest("GET", "/routing/v1/port_reservations/reservation-guid", nil)
+			Expect(err).NotTo(HaveOccurred())
+			handler.ServeHTTP(responseRecorder, request)
+
+			Expect(fakeDb.ReadPortReservationArgsForCall(0)).To(Equal("reservation-guid"))
+			Expect(responseRecorder.Code).To(Equal(http.StatusOK))
+			Expect(responseRecorder.Body.String()).To(ContainSubstring(`"owner":"owner-a"`))
+		})
+
+		Context("when the reservation does not exist", func() {
+			It("returns a 404 Not Found", func() {
+				var err error
+				request, err = http.NewRequest("GET", "/routing/v1/port_reservations/not-exist", nil)
+				Expect(err).NotTo(HaveOccurred())
+				handler.ServeHTTP(responseRecorder, request)
+
+				Expect(responseRecorder.Code).To(Equal(http.StatusNotFound))
+				Expect(responseRecorder.Body.String()).To(ContainSubstring("ResourceNotFoundError"))
+			})
+		})
+	})
+
+	Describe("Delete", func() {
+		It("releases the reservation", func() {
+			var err error
+			request, err = http.NewRequest("DELETE", "/routing/v1/port_reservations/reservation-guid", nil)
+			Expect(err).NotTo(HaveOccurred())
+			handler.ServeHTTP(responseRecorder, request)
+
+			_, permission := fakeClient.ValidateTokenArgsForCall(0)
+			Expect(permission).To(ConsistOf(handlers.RouterGroupsWriteScope))
+			Expect(fakeDb.DeletePortReservationCallCount()).To(Equal(1))
+			Expect(fakeDb.DeletePortReservationArgsForCall(0)).To(Equal("reservation-guid"))
+			Expect(responseRecorder.Code).To(Equal(http.StatusNoContent))
+		})
+
+		Context("when the reservation does not exist", func() {
+			BeforeEach(func() {
+				fakeDb.DeletePortReservationReturns(db.DeletePortReservationError)
+			})
+
+			It("returns a 404 Not Found", func() {
+				var err error
+				request, err = http.NewRequest("DELETE", "/routing/v1/port_reservations/not-exist", nil)
+				Expect(err).NotTo(HaveOccurred())
+				handler.ServeHTTP(responseRecorder, request)
+
+				Expect(responseRecorder.Code).To(Equal(http.StatusNotFound))
+			})
+		})
+	})
+})
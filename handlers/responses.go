@@ -0,0 +1,22 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"code.cloudfoundry.org/lager/v3"
+)
+
+func writeJSONResponse(w http.ResponseWriter, status int, body interface{}, log lager.Logger) {
+	jsonBytes, err := json.Marshal(body)
+	if err != nil {
+		log.Error("failed-to-marshal", err)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_, err = w.Write(jsonBytes)
+	if err != nil {
+		log.Error("failed-to-write-to-response", err)
+	}
+}
@@ -2,10 +2,13 @@ package handlers
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"net/http"
 	"net/url"
 	"strconv"
+	"strings"
 
 	"code.cloudfoundry.org/lager/v3"
 	"code.cloudfoundry.org/routing-api/db"
@@ -20,16 +23,18 @@ const portWarning = "Warning: if routes are registered for ports that are not "
 	"result in backends for those routes becoming inaccessible."
 
 type RouterGroupsHandler struct {
-	uaaClient uaaclient.TokenValidator
-	logger    lager.Logger
-	db        db.DB
+	uaaClient  uaaclient.TokenValidator
+	logger     lager.Logger
+	db         db.DB
+	portPolicy models.PortPolicy
 }
 
-func NewRouteGroupsHandler(uaaClient uaaclient.TokenValidator, logger lager.Logger, db db.DB) *RouterGroupsHandler {
+func NewRouteGroupsHandler(uaaClient uaaclient.TokenValidator, logger lager.Logger, db db.DB, portPolicy models.PortPolicy) *RouterGroupsHandler {
 	return &RouterGroupsHandler{
-		uaaClient: uaaClient,
-		logger:    logger,
-		db:        db,
+		uaaClient:  uaaClient,
+		logger:     logger,
+		db:         db,
+		portPolicy: portPolicy,
 	}
 }
 
@@ -44,6 +49,12 @@ func (h *RouterGroupsHandler) ListRouterGroups(w http.ResponseWriter, req *http.
 		return
 	}
 
+	selector, err := labelSelectorFromRequest(req)
+	if err != nil {
+		handleProcessRequestError(w, err, log)
+		return
+	}
+
 	var routerGroups []models.RouterGroup
 
 	routerGroupName := req.URL.Query().Get("name")
@@ -64,6 +75,22 @@ func (h *RouterGroupsHandler) ListRouterGroups(w http.ResponseWriter, req *http.
 		return
 	}
 
+	if len(selector) > 0 {
+		filtered := []models.RouterGroup{}
+		for _, rg := range routerGroups {
+			if selector.Matches(rg.Labels) {
+				filtered = append(filtered, rg)
+			}
+		}
+		routerGroups = filtered
+	}
+
+	err = h.setQuotaUsage(routerGroups)
+	if err != nil {
+		handleDBCommunicationError(w, err, log)
+		return
+	}
+
 	jsonBytes, err := json.Marshal(routerGroups)
 	if err != nil {
 		log.Error("failed-to-marshal", err)
@@ -77,6 +104,66 @@ func (h *RouterGroupsHandler) ListRouterGroups(w http.ResponseWriter, req *http.
 	w.Header().Set("Content-Length", strconv.Itoa(len(jsonBytes)))
 }
 
+func (h *RouterGroupsHandler) GetRouterGroup(w http.ResponseWriter, req *http.Request) {
+	log := h.logger.Session("get-router-group")
+	log.Debug("started")
+	defer log.Debug("completed")
+
+	err := h.uaaClient.ValidateToken(req.Header.Get("Authorization"), RouterGroupsReadScope)
+	if err != nil {
+		handleUnauthorizedError(w, err, log)
+		return
+	}
+
+	guid := rata.Param(req, "guid")
+	rg, err := h.db.ReadRouterGroup(guid)
+	if err != nil {
+		handleDBCommunicationError(w, err, log)
+		return
+	}
+
+	if rg == (models.RouterGroup{}) {
+		handleNotFoundError(w, fmt.Errorf("router group '%s' does not exist", guid), log)
+		return
+	}
+
+	routerGroups := []models.RouterGroup{rg}
+	err = h.setQuotaUsage(routerGroups)
+	if err != nil {
+		handleDBCommunicationError(w, err, log)
+		return
+	}
+
+	writeRouterGroupResponse(w, http.StatusOK, routerGroups[0], log)
+}
+
+// setQuotaUsage sets the quota usage of the router groups that have quotas.
+// Live mappings are only read when one of them does.
+func (h *RouterGroupsHandler) setQuotaUsage(routerGroups []models.RouterGroup) error {
+	var (
+		mappings []models.TcpRouteMapping
+		loaded   bool
+	)
+	for i := range routerGroups {
+		if !routerGroups[i].HasTcpRouteQuotas() {
+			continue
+		}
+
+		if !loaded {
+			var err error
+			mappings, err = h.db.ReadTcpRouteMappings()
+			if err != nil {
+				return err
+			}
+			loaded = true
+		}
+
+		usage := models.NewRouterGroupQuotaUsage(routerGroups[i], mappings)
+		routerGroups[i].QuotaUsage = &usage
+	}
+	return nil
+}
+
 func (h *RouterGroupsHandler) UpdateRouterGroup(w http.ResponseWriter, req *http.Request) {
 	log := h.logger.Session("update-router-group")
 	log.Debug("started")
@@ -94,12 +181,34 @@ func (h *RouterGroupsHandler) UpdateRouterGroup(w http.ResponseWriter, req *http
 		return
 	}
 
-	bodyDecoder := json.NewDecoder(req.Body)
-	var updatedGroup models.RouterGroup
-	err = bodyDecoder.Decode(&updatedGroup)
+	body, err := io.ReadAll(req.Body)
+	if err != nil {
+		handleProcessRequestError(w, err, log)
+		return
+	}
 
+	var updatedGroup models.RouterGroup
+	err = json.Unmarshal(body, &updatedGroup)
 	if err != nil {
+		handleProcessRequestError(w, err, log)
+		return
+	}
 
+	// An empty description or a ttl or quota of 0 clears it, so the current
+	// value is only kept when the field is left out of the request.
+	var present struct {
+		Description                     *string `json:"description"`
+		MinTTL                          *int    `json:"min_ttl"`
+		MaxTTL                          *int    `json:"max_ttl"`
+		DefaultTTL                      *int    `json:"default_ttl"`
+		MaxTcpRoutes                    *int    `json:"max_tcp_routes"`
+		MaxTcpRoutesPerIsolationSegment *int    `json:"max_tcp_routes_per_isolation_segment"`
+		ExcludedPorts                   *string `json:"excluded_ports"`
+		AllowedBackendCIDRs             *string `json:"allowed_backend_cidrs"`
+		DeniedBackendCIDRs              *string `json:"denied_backend_cidrs"`
+	}
+	err = json.Unmarshal(body, &present)
+	if err != nil {
 		handleProcessRequestError(w, err, log)
 		return
 	}
@@ -116,22 +225,117 @@ func (h *RouterGroupsHandler) UpdateRouterGroup(w http.ResponseWriter, req *http
 		return
 	}
 
-	if rg.ReservablePorts != updatedGroup.ReservablePorts {
-		rg.ReservablePorts = updatedGroup.ReservablePorts
+	current := rg
+	if updatedGroup.Name != "" {
+		rg.Name = updatedGroup.Name
+	}
+	if updatedGroup.Type != "" {
+		rg.Type = updatedGroup.Type
+	}
+	rg.ReservablePorts = updatedGroup.ReservablePorts
+	if updatedGroup.Labels != "" {
+		rg.Labels = updatedGroup.Labels
+	}
+	if present.Description != nil {
+		rg.Description = *present.Description
+	}
+	if present.MinTTL != nil {
+		rg.MinTTL = *present.MinTTL
+	}
+	if present.MaxTTL != nil {
+		rg.MaxTTL = *present.MaxTTL
+	}
+	if present.DefaultTTL != nil {
+		rg.DefaultTTL = *present.DefaultTTL
+	}
+	if present.MaxTcpRoutes != nil {
+		rg.MaxTcpRoutes = *present.MaxTcpRoutes
+	}
+	if present.MaxTcpRoutesPerIsolationSegment != nil {
+		rg.MaxTcpRoutesPerIsolationSegment = *present.MaxTcpRoutesPerIsolationSegment
+	}
+	if present.ExcludedPorts != nil {
+		rg.ExcludedPorts = models.ReservablePorts(*present.ExcludedPorts)
+	}
+	if present.AllowedBackendCIDRs != nil {
+		rg.AllowedBackendCIDRs = models.CIDRs(*present.AllowedBackendCIDRs)
+	}
+	if present.DeniedBackendCIDRs != nil {
+		rg.DeniedBackendCIDRs = models.CIDRs(*present.DeniedBackendCIDRs)
+	}
 
-		err = rg.Validate()
+	if rg != current {
+		err = rg.Validate(h.portPolicy)
 
 		if err != nil {
 			handleProcessRequestError(w, err, log)
 			return
 		}
 
-		err = h.db.SaveRouterGroup(rg)
+		if rg.Name != current.Name {
+			var existing models.RouterGroup
+			existing, err = h.db.ReadRouterGroupByName(rg.Name)
+			if err != nil {
+				handleDBCommunicationError(w, err, log)
+				return
+			}
+			if existing.Guid != "" && existing.Guid != rg.Guid {
+				handleDBConflictError(w, fmt.Errorf("router group name '%s' is already taken", rg.Name), log)
+				return
+			}
+		}
 
-		if err != nil {
-			handleDBCommunicationError(w, err, log)
+		if rg.Type != current.Type {
+			dependents, err := h.routerGroupDependents(rg.Guid)
+			if err != nil {
+				handleDBCommunicationError(w, err, log)
+				return
+			}
+			if !dependents.Empty() {
+				message := fmt.Sprintf("router group %s has %s; its type can only be changed from %s to %s when it has none",
+					current.Name, dependents, current.Type, rg.Type)
+				handleRouterGroupInUseError(w, message, dependents, log)
+				return
+			}
+		}
+
+		var stranded []models.TcpRouteMapping
+		if rg.ReservablePorts != current.ReservablePorts {
+			stranded, err = h.strandedTcpRouteMappings(rg)
+			if err != nil {
+				handleDBCommunicationError(w, err, log)
+				return
+			}
+		}
+
+		query := req.URL.Query()
+		force := query.Get("force") == "true"
+		deleteStranded := query.Get("delete_stranded") == "true"
+		if deleteStranded && !force {
+			handleProcessRequestError(w, errors.New("delete_stranded requires force"), log)
+			return
+		}
+
+		if len(stranded) > 0 && !force {
+			handleRouterGroupPortsInUseError(w, strandedMessage(rg, stranded), stranded, log)
 			return
 		}
+
+		if len(stranded) > 0 && deleteStranded {
+			deleted, err := h.db.SaveRouterGroupAndDeleteStrandedTcpRouteMappings(rg)
+			if err != nil {
+				handleDBCommunicationError(w, err, log)
+				return
+			}
+			log.Info("deleted-stranded-tcp-route-mappings", lager.Data{"tcp_route_mappings": deleted})
+		} else {
+			err = h.db.SaveRouterGroup(rg)
+
+			if err != nil {
+				handleDBCommunicationError(w, err, log)
+				return
+			}
+		}
 	}
 
 	jsonBytes, err := json.Marshal(rg)
@@ -149,6 +353,23 @@ func (h *RouterGroupsHandler) UpdateRouterGroup(w http.ResponseWriter, req *http
 	w.Header().Set("Content-Length", strconv.Itoa(len(jsonBytes)))
 }
 
+func (h *RouterGroupsHandler) strandedTcpRouteMappings(rg models.RouterGroup) ([]models.TcpRouteMapping, error) {
+	mappings, err := h.db.ReadTcpRouteMappings()
+	if err != nil {
+		return nil, err
+	}
+	return rg.StrandedTcpRouteMappings(mappings)
+}
+
+func strandedMessage(rg models.RouterGroup, stranded []models.TcpRouteMapping) string {
+	routes := make([]string, len(stranded))
+	for i, mapping := range stranded {
+		routes[i] = mapping.String()
+	}
+	return fmt.Sprintf("reservable_ports %s would exclude the external ports of %d tcp routes: [%s]. Update with force=true to save anyway, or with force=true&delete_stranded=true to also delete them",
+		rg.ReservablePorts, len(stranded), strings.Join(routes, ", "))
+}
+
 func (h *RouterGroupsHandler) DeleteRouterGroup(w http.ResponseWriter, req *http.Request) {
 	log := h.logger.Session("delete-router-group")
 	log.Debug("started")
@@ -161,9 +382,22 @@ func (h *RouterGroupsHandler) DeleteRouterGroup(w http.ResponseWriter, req *http
 	}
 
 	guid := rata.Param(req, "guid")
-	err = h.db.DeleteRouterGroup(guid)
+	if req.URL.Query().Get("cascade") == "true" {
+		var dependents models.RouterGroupDependents
+		dependents, err = h.db.DeleteRouterGroupCascade(guid)
+		if err == nil {
+			log.Info("deleted-dependents", lager.Data{"dependents": dependents})
+		}
+	} else {
+		err = h.db.DeleteRouterGroup(guid)
+	}
 	if err != nil {
-		if dberr, ok := err.(db.DBError); !ok || dberr.Type != db.KeyNotFound {
+		dberr, ok := err.(db.DBError)
+		if ok && dberr.Type == db.InUse {
+			h.handleRouterGroupInUse(w, guid, dberr, log)
+			return
+		}
+		if !ok || dberr.Type != db.KeyNotFound {
 			handleDBCommunicationError(w, err, log)
 			return
 		}
@@ -174,6 +408,65 @@ func (h *RouterGroupsHandler) DeleteRouterGroup(w http.ResponseWriter, req *http
 	w.Header().Set("Content-Length", "0")
 }
 
+func (h *RouterGroupsHandler) handleRouterGroupInUse(w http.ResponseWriter, guid string, inUseErr error, log lager.Logger) {
+	dependents, err := h.routerGroupDependents(guid)
+	if err != nil {
+		handleDBCommunicationError(w, err, log)
+		return
+	}
+
+	handleRouterGroupInUseError(w, inUseErr.Error()+". Delete with cascade=true to delete them as well", dependents, log)
+}
+
+// routerGroupDependents returns the live http routes, tcp and udp route
+// mappings and port reservations of the router group.
+func (h *RouterGroupsHandler) routerGroupDependents(guid string) (models.RouterGroupDependents, error) {
+	allRoutes, err := h.db.ReadRoutes()
+	if err != nil {
+		return models.RouterGroupDependents{}, err
+	}
+	allTcpMappings, err := h.db.ReadTcpRouteMappings()
+	if err != nil {
+		return models.RouterGroupDependents{}, err
+	}
+	allUdpMappings, err := h.db.ReadUdpRouteMappings()
+	if err != nil {
+		return models.RouterGroupDependents{}, err
+	}
+	allReservations, err := h.db.ReadPortReservations()
+	if err != nil {
+		return models.RouterGroupDependents{}, err
+	}
+
+	dependents := models.RouterGroupDependents{
+		Routes:           []models.Route{},
+		TcpRouteMappings: []models.TcpRouteMapping{},
+		UdpRouteMappings: []models.UdpRouteMapping{},
+		PortReservations: []models.PortReservation{},
+	}
+	for _, route := range allRoutes {
+		if route.RouterGroupGuid == guid {
+			dependents.Routes = append(dependents.Routes, route)
+		}
+	}
+	for _, mapping := range allTcpMappings {
+		if mapping.RouterGroupGuid == guid {
+			dependents.TcpRouteMappings = append(dependents.TcpRouteMappings, mapping)
+		}
+	}
+	for _, mapping := range allUdpMappings {
+		if mapping.RouterGroupGuid == guid {
+			dependents.UdpRouteMappings = append(dependents.UdpRouteMappings, mapping)
+		}
+	}
+	for _, reservation := range allReservations {
+		if reservation.RouterGroupGuid == guid {
+			dependents.PortReservations = append(dependents.PortReservations, reservation)
+		}
+	}
+	return dependents, nil
+}
+
 func (h *RouterGroupsHandler) CreateRouterGroup(w http.ResponseWriter, req *http.Request) {
 	log := h.logger.Session("create-router-group")
 	log.Debug("started")
@@ -206,8 +499,7 @@ func (h *RouterGroupsHandler) CreateRouterGroup(w http.ResponseWriter, req *http
 		return
 	}
 	if existingRg := routerGroupExist(routerGroups, rg); existingRg != nil {
-		w.WriteHeader(http.StatusOK)
-		writeRouterGroupResponse(w, *existingRg, log)
+		writeRouterGroupResponse(w, http.StatusOK, *existingRg, log)
 		return
 	}
 	guid, err := uuid.NewV4()
@@ -217,7 +509,7 @@ func (h *RouterGroupsHandler) CreateRouterGroup(w http.ResponseWriter, req *http
 	}
 	rg.Guid = guid.String()
 	routerGroups = append(routerGroups, rg)
-	err = routerGroups.Validate()
+	err = routerGroups.Validate(h.portPolicy)
 	if err != nil {
 		handleProcessRequestError(w, err, log)
 		return
@@ -228,12 +520,62 @@ func (h *RouterGroupsHandler) CreateRouterGroup(w http.ResponseWriter, req *http
 		handleDBCommunicationError(w, err, log)
 		return
 	}
-	w.WriteHeader(http.StatusCreated)
-	writeRouterGroupResponse(w, rg, log)
+	writeRouterGroupResponse(w, http.StatusCreated, rg, log)
 }
 
-func writeRouterGroupResponse(w http.ResponseWriter, rg models.RouterGroup, log lager.Logger) {
-	jsonBytes, err := json.Marshal(rg)
+func (h *RouterGroupsHandler) RouterGroupPorts(w http.ResponseWriter, req *http.Request) {
+	log := h.logger.Session("router-group-ports")
+	log.Debug("started")
+	defer log.Debug("completed")
+
+	err := h.uaaClient.ValidateToken(req.Header.Get("Authorization"), RouterGroupsReadScope)
+	if err != nil {
+		handleUnauthorizedError(w, err, log)
+		return
+	}
+
+	guid := rata.Param(req, "guid")
+	rg, err := h.db.ReadRouterGroup(guid)
+	if err != nil {
+		handleDBCommunicationError(w, err, log)
+		return
+	}
+
+	if rg == (models.RouterGroup{}) {
+		handleNotFoundError(w, fmt.Errorf("router group '%s' does not exist", guid), log)
+		return
+	}
+
+	mappings, err := h.db.ReadTcpRouteMappings()
+	if err != nil {
+		handleDBCommunicationError(w, err, log)
+		return
+	}
+
+	reservations, err := h.db.ReadPortReservations()
+	if err != nil {
+		handleDBCommunicationError(w, err, log)
+		return
+	}
+
+	occupancy, err := models.NewPortOccupancy(rg, mappings, reservations, h.portPolicy)
+	if err != nil {
+		handleProcessRequestError(w, err, log)
+		return
+	}
+
+	// the summary always covers all ports, only the listed ports are filtered
+	if state := req.URL.Query().Get("state"); state != "" {
+		ports := []models.PortUsage{}
+		for _, port := range occupancy.Ports {
+			if port.State == models.PortState(state) {
+				ports = append(ports, port)
+			}
+		}
+		occupancy.Ports = ports
+	}
+
+	jsonBytes, err := json.Marshal(occupancy)
 	if err != nil {
 		log.Error("failed-to-marshal", err)
 	}
@@ -243,7 +585,23 @@ func writeRouterGroupResponse(w http.ResponseWriter, rg models.RouterGroup, log
 	if err != nil {
 		log.Error("failed-to-write-to-response", err)
 	}
+}
+
+// writeRouterGroupResponse sets the headers before writing the status code,
+// as headers set after it are not sent.
+func writeRouterGroupResponse(w http.ResponseWriter, statusCode int, rg models.RouterGroup, log lager.Logger) {
+	jsonBytes, err := json.Marshal(rg)
+	if err != nil {
+		log.Error("failed-to-marshal", err)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
 	w.Header().Set("Content-Length", strconv.Itoa(len(jsonBytes)))
+	w.WriteHeader(statusCode)
+	_, err = w.Write(jsonBytes)
+	if err != nil {
+		log.Error("failed-to-write-to-response", err)
+	}
 }
 
 func routerGroupExist(rgs models.RouterGroups, rg models.RouterGroup) *models.RouterGroup {
@@ -46,7 +46,7 @@ var _ = Describe("RouterGroupsHandler", func() {
 		logger = lagertest.NewTestLogger("test-router-group")
 		fakeClient = &fake_client.FakeTokenValidator{}
 		fakeDb = &fake_db.FakeDB{}
-		routerGroupHandler = handlers.NewRouteGroupsHandler(fakeClient, logger, fakeDb)
+		routerGroupHandler = handlers.NewRouteGroupsHandler(fakeClient, logger, fakeDb, models.PortPolicy{})
 		responseRecorder = httptest.NewRecorder()
 
 		fakeRouterGroups := []models.RouterGroup{
@@ -132,6 +132,36 @@ var _ = Describe("RouterGroupsHandler", func() {
 			})
 		})
 
+		Context("when a label selector is given", func() {
+			BeforeEach(func() {
+				fakeDb.ReadRouterGroupsReturns([]models.RouterGroup{
+					{Guid: "prod-guid", Name: "prod-tcp", Type: "tcp", ReservablePorts: "1024-2048", Labels: models.LabelSet(`{"env":"prod"}`)},
+					{Guid: "staging-guid", Name: "staging-tcp", Type: "tcp", ReservablePorts: "2049-4096", Labels: models.LabelSet(`{"env":"staging"}`)},
+				}, nil)
+			})
+
+			It("returns only the matching router groups", func() {
+				request = handlers.NewTestRequest("")
+				request.URL.RawQuery = "label_selector=env%3Dprod"
+
+				routerGroupHandler.ListRouterGroups(responseRecorder, request)
+				Expect(responseRecorder.Code).To(Equal(http.StatusOK))
+				var routerGroups []models.RouterGroup
+				Expect(json.Unmarshal(responseRecorder.Body.Bytes(), &routerGroups)).To(Succeed())
+				Expect(routerGroups).To(HaveLen(1))
+				Expect(routerGroups[0].Name).To(Equal("prod-tcp"))
+			})
+
+			It("returns a 400 when the selector is invalid", func() {
+				request = handlers.NewTestRequest("")
+				request.URL.RawQuery = "label_selector=env+within+(prod)"
+
+				routerGroupHandler.ListRouterGroups(responseRecorder, request)
+				Expect(responseRecorder.Code).To(Equal(http.StatusBadRequest))
+				Expect(responseRecorder.Body.String()).To(ContainSubstring("ProcessRequestError"))
+			})
+		})
+
 		It("checks for routing.router_groups.read scope", func() {
 			var err error
 			request, err = http.NewRequest("GET", routing_api.ListRouterGroups, nil)
@@ -182,6 +212,151 @@ var _ = Describe("RouterGroupsHandler", func() {
 
 	})
 
+	Describe("GetRouterGroup", func() {
+		var handler http.Handler
+
+		BeforeEach(func() {
+			var err error
+			handler, err = rata.NewRouter(rata.Routes{
+				routing_api.RoutesMap[routing_api.GetRouterGroup],
+				routing_api.RoutesMap[routing_api.RouterGroupPorts],
+			}, rata.Handlers{
+				routing_api.GetRouterGroup:   http.HandlerFunc(routerGroupHandler.GetRouterGroup),
+				routing_api.RouterGroupPorts: http.HandlerFunc(routerGroupHandler.RouterGroupPorts),
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			fakeDb.ReadRouterGroupReturns(models.RouterGroup{
+				Guid:            DefaultRouterGroupGuid,
+				Name:            DefaultRouterGroupName,
+				Type:            DefaultRouterGroupType,
+				ReservablePorts: "1024-65535",
+				Description:     "routers in zone a",
+			}, nil)
+		})
+
+		get := func(path string) {
+			var err error
+			request, err = http.NewRequest("GET", path, nil)
+			Expect(err).NotTo(HaveOccurred())
+			handler.ServeHTTP(responseRecorder, request)
+		}
+
+		It("responds with the router group", func() {
+			get(fmt.Sprintf("/routing/v1/router_groups/%s", DefaultRouterGroupGuid))
+
+			Expect(fakeDb.ReadRouterGroupCallCount()).To(Equal(1))
+			Expect(fakeDb.ReadRouterGroupArgsForCall(0)).To(Equal(DefaultRouterGroupGuid))
+			Expect(responseRecorder.Code).To(Equal(http.StatusOK))
+			Expect(responseRecorder.Result().Header.Get("Content-Type")).To(Equal("application/json"))
+			Expect(responseRecorder.Body.String()).To(MatchJSON(`{
+				"guid": "bad25cff-9332-48a6-8603-b619858e7992",
+				"name": "default-tcp",
+				"type": "tcp",
+				"reservable_ports": "1024-65535",
+				"description": "routers in zone a"
+			}`))
+		})
+
+		It("does not match the ports of the router group", func() {
+			fakeDb.ReadTcpRouteMappingsReturns(nil, errors.New("db communication failed"))
+			get(fmt.Sprintf("/routing/v1/router_groups/%s/ports", DefaultRouterGroupGuid))
+
+			Expect(fakeDb.ReadTcpRouteMappingsCallCount()).To(Equal(1))
+		})
+
+		It("checks for routing.router_groups.read scope", func() {
+			get(fmt.Sprintf("/routing/v1/router_groups/%s", DefaultRouterGroupGuid))
+
+			_, permission := fakeClient.ValidateTokenArgsForCall(0)
+			Expect(permission).To(ConsistOf(handlers.RouterGroupsReadScope))
+		})
+
+		Context("when the router group has quotas", func() {
+			BeforeEach(func() {
+				fakeDb.ReadRouterGroupReturns(models.RouterGroup{
+					Guid:            DefaultRouterGroupGuid,
+					Name:            DefaultRouterGroupName,
+					Type:            DefaultRouterGroupType,
+					ReservablePorts: "1024-65535",
+					MaxTcpRoutes:    10,
+				}, nil)
+				mapping := models.NewTcpRouteMapping(DefaultRouterGroupGuid, 1024, "10.0.0.1", 8080, 0, "", nil, nil, 60, models.ModificationTag{}, false, "")
+				mapping.IsolationSegment = "is1"
+				fakeDb.ReadTcpRouteMappingsReturns([]models.TcpRouteMapping{
+					mapping,
+					models.NewTcpRouteMapping(DefaultRouterGroupGuid, 1025, "10.0.0.2", 8080, 0, "", nil, nil, 60, models.ModificationTag{}, false, ""),
+					models.NewTcpRouteMapping("other-guid", 1025, "10.0.0.3", 8080, 0, "", nil, nil, 60, models.ModificationTag{}, false, ""),
+				}, nil)
+			})
+
+			It("responds with the quota usage", func() {
+				get(fmt.Sprintf("/routing/v1/router_groups/%s", DefaultRouterGroupGuid))
+
+				Expect(responseRecorder.Code).To(Equal(http.StatusOK))
+				Expect(responseRecorder.Body.String()).To(MatchJSON(`{
+					"guid": "bad25cff-9332-48a6-8603-b619858e7992",
+					"name": "default-tcp",
+					"type": "tcp",
+					"reservable_ports": "1024-65535",
+					"max_tcp_routes": 10,
+					"quota_usage": {
+						"tcp_routes": 2,
+						"tcp_routes_by_isolation_segment": {"": 1, "is1": 1}
+					}
+				}`))
+			})
+
+			It("returns a DB communication error when the mappings cannot be read", func() {
+				fakeDb.ReadTcpRouteMappingsReturns(nil, errors.New("db communication failed"))
+				get(fmt.Sprintf("/routing/v1/router_groups/%s", DefaultRouterGroupGuid))
+
+				Expect(responseRecorder.Code).To(Equal(http.StatusServiceUnavailable))
+			})
+		})
+
+		Context("when the router group does not exist", func() {
+			BeforeEach(func() {
+				fakeDb.ReadRouterGroupReturns(models.RouterGroup{}, nil)
+			})
+
+			It("returns a not found status", func() {
+				get("/routing/v1/router_groups/does-not-exist")
+
+				Expect(responseRecorder.Code).To(Equal(http.StatusNotFound))
+				Expect(responseRecorder.Body.String()).To(MatchJSON(`{
+					"name": "ResourceNotFoundError",
+					"message": "router group 'does-not-exist' does not exist"
+				}`))
+			})
+		})
+
+		Context("when the db fails to read the router group", func() {
+			BeforeEach(func() {
+				fakeDb.ReadRouterGroupReturns(models.RouterGroup{}, errors.New("db communication failed"))
+			})
+
+			It("returns a DB communication error", func() {
+				get(fmt.Sprintf("/routing/v1/router_groups/%s", DefaultRouterGroupGuid))
+
+				Expect(responseRecorder.Code).To(Equal(http.StatusServiceUnavailable))
+			})
+		})
+
+		Context("when authorization token is invalid", func() {
+			BeforeEach(func() {
+				fakeClient.ValidateTokenReturns(errors.New("kaboom"))
+			})
+
+			It("returns Unauthorized error", func() {
+				get(fmt.Sprintf("/routing/v1/router_groups/%s", DefaultRouterGroupGuid))
+
+				Expect(responseRecorder.Code).To(Equal(http.StatusUnauthorized))
+				Expect(fakeDb.ReadRouterGroupCallCount()).To(Equal(0))
+			})
+		})
+	})
+
 	Describe("UpdateRouterGroup", func() {
 		var (
 			existingTCPRouterGroup   models.RouterGroup
@@ -289,6 +464,85 @@ var _ = Describe("RouterGroupsHandler", func() {
 			Expect(url.QueryUnescape(warning)).To(ContainSubstring("routes becoming inaccessible"))
 		})
 
+		Context("when the new reservable ports exclude ports of live tcp routes", func() {
+			var stranded models.TcpRouteMapping
+
+			BeforeEach(func() {
+				stranded = models.NewTcpRouteMapping(DefaultRouterGroupGuid, 9000, "10.0.0.1", 8080, 0, "", nil, nil, 60, models.ModificationTag{}, false, "")
+				fakeDb.ReadTcpRouteMappingsReturns([]models.TcpRouteMapping{
+					stranded,
+					models.NewTcpRouteMapping(DefaultRouterGroupGuid, 8000, "10.0.0.2", 8080, 0, "", nil, nil, 60, models.ModificationTag{}, false, ""),
+					models.NewTcpRouteMapping(DefaultOtherRouterGroupGuid, 9000, "10.0.0.3", 8080, 0, "", nil, nil, 60, models.ModificationTag{}, false, ""),
+				}, nil)
+			})
+
+			updateWithQuery := func(query string) {
+				var err error
+				request, err = http.NewRequest(
+					"PUT",
+					fmt.Sprintf("/routing/v1/router_groups/%s%s", DefaultRouterGroupGuid, query),
+					body,
+				)
+				Expect(err).NotTo(HaveOccurred())
+				handler.ServeHTTP(responseRecorder, request)
+			}
+
+			It("does not save the router group and lists the stranded tcp routes", func() {
+				updateWithQuery("")
+
+				Expect(fakeDb.SaveRouterGroupCallCount()).To(Equal(0))
+				Expect(fakeDb.SaveRouterGroupAndDeleteStrandedTcpRouteMappingsCallCount()).To(Equal(0))
+				Expect(responseRecorder.Code).To(Equal(http.StatusConflict))
+
+				var payload struct {
+					Name             string                   `json:"name"`
+					Message          string                   `json:"message"`
+					TcpRouteMappings []models.TcpRouteMapping `json:"tcp_routes"`
+				}
+				Expect(json.Unmarshal(responseRecorder.Body.Bytes(), &payload)).To(Succeed())
+				Expect(payload.Name).To(Equal("RouterGroupPortsInUseError"))
+				Expect(payload.Message).To(ContainSubstring("would exclude the external ports of 1 tcp routes"))
+				Expect(payload.TcpRouteMappings).To(HaveLen(1))
+				Expect(payload.TcpRouteMappings[0].HostIP).To(Equal("10.0.0.1"))
+			})
+
+			Context("when force is given", func() {
+				It("saves the router group and keeps the tcp routes", func() {
+					updateWithQuery("?force=true")
+
+					Expect(responseRecorder.Code).To(Equal(http.StatusOK))
+					Expect(fakeDb.SaveRouterGroupCallCount()).To(Equal(1))
+					Expect(fakeDb.SaveRouterGroupAndDeleteStrandedTcpRouteMappingsCallCount()).To(Equal(0))
+				})
+
+				It("deletes the stranded tcp routes with the router group when delete_stranded is given", func() {
+					fakeDb.SaveRouterGroupAndDeleteStrandedTcpRouteMappingsReturns([]models.TcpRouteMapping{stranded}, nil)
+					updateWithQuery("?force=true&delete_stranded=true")
+
+					Expect(responseRecorder.Code).To(Equal(http.StatusOK))
+					Expect(fakeDb.SaveRouterGroupCallCount()).To(Equal(0))
+					Expect(fakeDb.SaveRouterGroupAndDeleteStrandedTcpRouteMappingsCallCount()).To(Equal(1))
+					Expect(fakeDb.SaveRouterGroupAndDeleteStrandedTcpRouteMappingsArgsForCall(0).ReservablePorts).To(Equal(models.ReservablePorts("8000")))
+				})
+
+				It("returns a DB communication error when deleting fails", func() {
+					fakeDb.SaveRouterGroupAndDeleteStrandedTcpRouteMappingsReturns(nil, errors.New("db communication failed"))
+					updateWithQuery("?force=true&delete_stranded=true")
+
+					Expect(responseRecorder.Code).To(Equal(http.StatusServiceUnavailable))
+				})
+			})
+
+			Context("when delete_stranded is given without force", func() {
+				It("returns a 400 Bad Request", func() {
+					updateWithQuery("?delete_stranded=true")
+
+					Expect(responseRecorder.Code).To(Equal(http.StatusBadRequest))
+					Expect(fakeDb.SaveRouterGroupCallCount()).To(Equal(0))
+				})
+			})
+		})
+
 		Context("when reservable port field is invalid", func() {
 			BeforeEach(func() {
 				queryGroup := models.RouterGroup{
@@ -545,6 +799,376 @@ var _ = Describe("RouterGroupsHandler", func() {
 			})
 		})
 
+		Context("when updating the name and description", func() {
+			update := func(guid, requestBody string) {
+				var err error
+				request, err = http.NewRequest(
+					"PUT",
+					fmt.Sprintf("/routing/v1/router_groups/%s", guid),
+					bytes.NewReader([]byte(requestBody)),
+				)
+				Expect(err).NotTo(HaveOccurred())
+				handler.ServeHTTP(responseRecorder, request)
+			}
+
+			It("renames the router group", func() {
+				update(DefaultRouterGroupGuid, `{"name": "renamed-tcp", "reservable_ports": "1024-65535"}`)
+
+				Expect(responseRecorder.Code).To(Equal(http.StatusOK))
+				Expect(fakeDb.ReadRouterGroupByNameCallCount()).To(Equal(1))
+				Expect(fakeDb.ReadRouterGroupByNameArgsForCall(0)).To(Equal("renamed-tcp"))
+				Expect(fakeDb.SaveRouterGroupCallCount()).To(Equal(1))
+				savedGroup := fakeDb.SaveRouterGroupArgsForCall(0)
+				Expect(savedGroup.Name).To(Equal("renamed-tcp"))
+				Expect(savedGroup.ReservablePorts).To(Equal(models.ReservablePorts("1024-65535")))
+			})
+
+			It("returns a conflict when another router group has the name", func() {
+				fakeDb.ReadRouterGroupByNameReturns(existingHTTPRouterGroup, nil)
+				update(DefaultRouterGroupGuid, `{"name": "default-http", "reservable_ports": "1024-65535"}`)
+
+				Expect(fakeDb.SaveRouterGroupCallCount()).To(Equal(0))
+				Expect(responseRecorder.Code).To(Equal(http.StatusConflict))
+				Expect(responseRecorder.Body.String()).To(MatchJSON(`{
+					"name": "DBConflictError",
+					"message": "router group name 'default-http' is already taken"
+				}`))
+			})
+
+			It("returns a DB communication error when the name cannot be checked", func() {
+				fakeDb.ReadRouterGroupByNameReturns(models.RouterGroup{}, errors.New("db communication failed"))
+				update(DefaultRouterGroupGuid, `{"name": "renamed-tcp", "reservable_ports": "1024-65535"}`)
+
+				Expect(fakeDb.SaveRouterGroupCallCount()).To(Equal(0))
+				Expect(responseRecorder.Code).To(Equal(http.StatusServiceUnavailable))
+			})
+
+			It("sets the description", func() {
+				update(DefaultRouterGroupGuid, `{"description": "routers in zone a", "reservable_ports": "1024-65535"}`)
+
+				Expect(responseRecorder.Code).To(Equal(http.StatusOK))
+				Expect(fakeDb.ReadRouterGroupByNameCallCount()).To(Equal(0))
+				Expect(fakeDb.SaveRouterGroupCallCount()).To(Equal(1))
+				Expect(fakeDb.SaveRouterGroupArgsForCall(0).Description).To(Equal("routers in zone a"))
+				Expect(responseRecorder.Body.String()).To(ContainSubstring(`"description":"routers in zone a"`))
+			})
+
+			Context("when the router group has a description", func() {
+				BeforeEach(func() {
+					existingTCPRouterGroup.Description = "routers in zone a"
+				})
+
+				It("keeps the description when it is left out", func() {
+					update(DefaultRouterGroupGuid, `{"reservable_ports": "1024-65535"}`)
+
+					Expect(responseRecorder.Code).To(Equal(http.StatusOK))
+					Expect(fakeDb.SaveRouterGroupCallCount()).To(Equal(0))
+					Expect(responseRecorder.Body.String()).To(ContainSubstring(`"description":"routers in zone a"`))
+				})
+
+				It("clears the description when it is empty", func() {
+					update(DefaultRouterGroupGuid, `{"description": "", "reservable_ports": "1024-65535"}`)
+
+					Expect(responseRecorder.Code).To(Equal(http.StatusOK))
+					Expect(fakeDb.SaveRouterGroupCallCount()).To(Equal(1))
+					Expect(fakeDb.SaveRouterGroupArgsForCall(0).Description).To(BeEmpty())
+				})
+			})
+		})
+
+		Context("when updating the ttl policy", func() {
+			update := func(requestBody string) {
+				var err error
+				request, err = http.NewRequest(
+					"PUT",
+					fmt.Sprintf("/routing/v1/router_groups/%s", DefaultRouterGroupGuid),
+					bytes.NewReader([]byte(requestBody)),
+				)
+				Expect(err).NotTo(HaveOccurred())
+				handler.ServeHTTP(responseRecorder, request)
+			}
+
+			It("sets the ttls", func() {
+				update(`{"min_ttl": 10, "max_ttl": 300, "default_ttl": 60, "reservable_ports": "1024-65535"}`)
+
+				Expect(responseRecorder.Code).To(Equal(http.StatusOK))
+				Expect(fakeDb.SaveRouterGroupCallCount()).To(Equal(1))
+				savedGroup := fakeDb.SaveRouterGroupArgsForCall(0)
+				Expect(savedGroup.MinTTL).To(Equal(10))
+				Expect(savedGroup.MaxTTL).To(Equal(300))
+				Expect(savedGroup.DefaultTTL).To(Equal(60))
+				Expect(responseRecorder.Body.String()).To(ContainSubstring(`"default_ttl":60`))
+			})
+
+			It("returns a bad request for an invalid policy", func() {
+				update(`{"min_ttl": 300, "max_ttl": 10, "reservable_ports": "1024-65535"}`)
+
+				Expect(responseRecorder.Code).To(Equal(http.StatusBadRequest))
+				Expect(fakeDb.SaveRouterGroupCallCount()).To(Equal(0))
+				Expect(responseRecorder.Body.String()).To(ContainSubstring("min_ttl 300 is greater than max_ttl 10 in router group: default-tcp"))
+			})
+
+			Context("when the router group has a ttl policy", func() {
+				BeforeEach(func() {
+					existingTCPRouterGroup.MaxTTL = 300
+				})
+
+				It("keeps the ttls that are left out", func() {
+					update(`{"reservable_ports": "1024-65535"}`)
+
+					Expect(responseRecorder.Code).To(Equal(http.StatusOK))
+					Expect(fakeDb.SaveRouterGroupCallCount()).To(Equal(0))
+				})
+
+				It("clears a ttl set to 0", func() {
+					update(`{"max_ttl": 0, "reservable_ports": "1024-65535"}`)
+
+					Expect(responseRecorder.Code).To(Equal(http.StatusOK))
+					Expect(fakeDb.SaveRouterGroupCallCount()).To(Equal(1))
+					Expect(fakeDb.SaveRouterGroupArgsForCall(0).MaxTTL).To(BeZero())
+				})
+			})
+		})
+
+		Context("when updating the excluded ports", func() {
+			update := func(requestBody string) {
+				var err error
+				request, err = http.NewRequest(
+					"PUT",
+					fmt.Sprintf("/routing/v1/router_groups/%s", DefaultRouterGroupGuid),
+					bytes.NewReader([]byte(requestBody)),
+				)
+				Expect(err).NotTo(HaveOccurred())
+				handler.ServeHTTP(responseRecorder, request)
+			}
+
+			It("sets the excluded ports", func() {
+				update(`{"excluded_ports": "2000-2010", "reservable_ports": "1024-65535"}`)
+
+				Expect(responseRecorder.Code).To(Equal(http.StatusOK))
+				Expect(fakeDb.SaveRouterGroupCallCount()).To(Equal(1))
+				Expect(fakeDb.SaveRouterGroupArgsForCall(0).ExcludedPorts).To(Equal(models.ReservablePorts("2000-2010")))
+				Expect(responseRecorder.Body.String()).To(ContainSubstring(`"excluded_ports":"2000-2010"`))
+			})
+
+			It("returns a bad request for invalid excluded ports", func() {
+				update(`{"excluded_ports": "foo!", "reservable_ports": "1024-65535"}`)
+
+				Expect(responseRecorder.Code).To(Equal(http.StatusBadRequest))
+				Expect(fakeDb.SaveRouterGroupCallCount()).To(Equal(0))
+				Expect(responseRecorder.Body.String()).To(ContainSubstring("invalid excluded_ports in router group default-tcp"))
+			})
+
+			Context("when the router group has excluded ports", func() {
+				BeforeEach(func() {
+					existingTCPRouterGroup.ExcludedPorts = "2000-2010"
+				})
+
+				It("keeps them when they are left out", func() {
+					update(`{"reservable_ports": "1024-65535"}`)
+
+					Expect(responseRecorder.Code).To(Equal(http.StatusOK))
+					Expect(fakeDb.SaveRouterGroupCallCount()).To(Equal(0))
+				})
+
+				It("clears them when they are empty", func() {
+					update(`{"excluded_ports": "", "reservable_ports": "1024-65535"}`)
+
+					Expect(responseRecorder.Code).To(Equal(http.StatusOK))
+					Expect(fakeDb.SaveRouterGroupCallCount()).To(Equal(1))
+					Expect(fakeDb.SaveRouterGroupArgsForCall(0).ExcludedPorts).To(BeEmpty())
+				})
+			})
+		})
+
+		Context("when updating the backend networks", func() {
+			update := func(requestBody string) {
+				var err error
+				request, err = http.NewRequest(
+					"PUT",
+					fmt.Sprintf("/routing/v1/router_groups/%s", DefaultRouterGroupGuid),
+					bytes.NewReader([]byte(requestBody)),
+				)
+				Expect(err).NotTo(HaveOccurred())
+				handler.ServeHTTP(responseRecorder, request)
+			}
+
+			It("sets the allowed and denied backend networks", func() {
+				update(`{"allowed_backend_cidrs": "10.0.0.0/8", "denied_backend_cidrs": "10.255.0.0/16", "reservable_ports": "1024-65535"}`)
+
+				Expect(responseRecorder.Code).To(Equal(http.StatusOK))
+				Expect(fakeDb.SaveRouterGroupCallCount()).To(Equal(1))
+				Expect(fakeDb.SaveRouterGroupArgsForCall(0).AllowedBackendCIDRs).To(Equal(models.CIDRs("10.0.0.0/8")))
+				Expect(fakeDb.SaveRouterGroupArgsForCall(0).DeniedBackendCIDRs).To(Equal(models.CIDRs("10.255.0.0/16")))
+				Expect(responseRecorder.Body.String()).To(ContainSubstring(`"allowed_backend_cidrs":"10.0.0.0/8"`))
+			})
+
+			It("returns a bad request for invalid networks", func() {
+				update(`{"denied_backend_cidrs": "10.255.0.0", "reservable_ports": "1024-65535"}`)
+
+				Expect(responseRecorder.Code).To(Equal(http.StatusBadRequest))
+				Expect(fakeDb.SaveRouterGroupCallCount()).To(Equal(0))
+				Expect(responseRecorder.Body.String()).To(ContainSubstring("invalid denied_backend_cidrs in router group default-tcp"))
+			})
+
+			Context("when the router group has backend networks", func() {
+				BeforeEach(func() {
+					existingTCPRouterGroup.AllowedBackendCIDRs = "10.0.0.0/8"
+				})
+
+				It("keeps them when they are left out", func() {
+					update(`{"reservable_ports": "1024-65535"}`)
+
+					Expect(responseRecorder.Code).To(Equal(http.StatusOK))
+					Expect(fakeDb.SaveRouterGroupCallCount()).To(Equal(0))
+				})
+
+				It("clears them when they are empty", func() {
+					update(`{"allowed_backend_cidrs": "", "reservable_ports": "1024-65535"}`)
+
+					Expect(responseRecorder.Code).To(Equal(http.StatusOK))
+					Expect(fakeDb.SaveRouterGroupCallCount()).To(Equal(1))
+					Expect(fakeDb.SaveRouterGroupArgsForCall(0).AllowedBackendCIDRs).To(BeEmpty())
+				})
+			})
+		})
+
+		Context("when updating the type", func() {
+			update := func(requestBody string) {
+				var err error
+				request, err = http.NewRequest(
+					"PUT",
+					fmt.Sprintf("/routing/v1/router_groups/%s", DefaultRouterGroupGuid),
+					bytes.NewReader([]byte(requestBody)),
+				)
+				Expect(err).NotTo(HaveOccurred())
+				handler.ServeHTTP(responseRecorder, request)
+			}
+
+			It("changes a tcp router group without dependents to http", func() {
+				update(`{"type": "http", "reservable_ports": ""}`)
+
+				Expect(responseRecorder.Code).To(Equal(http.StatusOK))
+				Expect(fakeDb.SaveRouterGroupCallCount()).To(Equal(1))
+				savedGroup := fakeDb.SaveRouterGroupArgsForCall(0)
+				Expect(savedGroup.Type).To(Equal(models.RouterGroup_HTTP))
+				Expect(savedGroup.ReservablePorts).To(BeEmpty())
+			})
+
+			It("rejects an http router group with reservable ports", func() {
+				update(`{"type": "http", "reservable_ports": "1024-65535"}`)
+
+				Expect(fakeDb.SaveRouterGroupCallCount()).To(Equal(0))
+				Expect(responseRecorder.Code).To(Equal(http.StatusBadRequest))
+			})
+
+			Context("when the router group has tcp routes or port reservations", func() {
+				BeforeEach(func() {
+					fakeDb.ReadTcpRouteMappingsReturns([]models.TcpRouteMapping{
+						models.NewTcpRouteMapping(DefaultRouterGroupGuid, 2000, "10.0.0.1", 8080, 0, "", nil, nil, 60, models.ModificationTag{}, false, ""),
+						models.NewTcpRouteMapping(DefaultOtherRouterGroupGuid, 2000, "10.0.0.2", 8080, 0, "", nil, nil, 60, models.ModificationTag{}, false, ""),
+					}, nil)
+					fakeDb.ReadPortReservationsReturns([]models.PortReservation{
+						models.NewPortReservation(DefaultRouterGroupGuid, 2001, "some-owner", nil),
+					}, nil)
+				})
+
+				It("does not save the router group and lists the dependents", func() {
+					update(`{"type": "http", "reservable_ports": ""}`)
+
+					Expect(fakeDb.SaveRouterGroupCallCount()).To(Equal(0))
+					Expect(responseRecorder.Code).To(Equal(http.StatusConflict))
+
+					var payload struct {
+						Name             string                   `json:"name"`
+						Message          string                   `json:"message"`
+						TcpRouteMappings []models.TcpRouteMapping `json:"tcp_routes"`
+						PortReservations []models.PortReservation `json:"port_reservations"`
+					}
+					Expect(json.Unmarshal(responseRecorder.Body.Bytes(), &payload)).To(Succeed())
+					Expect(payload.Name).To(Equal("RouterGroupInUseError"))
+					Expect(payload.Message).To(ContainSubstring("can only be changed from tcp to http when it has none"))
+					Expect(payload.TcpRouteMappings).To(HaveLen(1))
+					Expect(payload.PortReservations).To(HaveLen(1))
+				})
+
+				It("allows other changes", func() {
+					update(`{"name": "renamed-tcp", "reservable_ports": "1024-65535"}`)
+
+					Expect(responseRecorder.Code).To(Equal(http.StatusOK))
+					Expect(fakeDb.SaveRouterGroupCallCount()).To(Equal(1))
+				})
+			})
+
+			Context("when a udp router group has udp routes", func() {
+				BeforeEach(func() {
+					existingTCPRouterGroup.Type = models.RouterGroup_UDP
+					fakeDb.ReadUdpRouteMappingsReturns([]models.UdpRouteMapping{
+						models.NewUdpRouteMapping(DefaultRouterGroupGuid, 5300, "10.0.0.1", 53, "", 60, models.ModificationTag{}),
+						models.NewUdpRouteMapping(DefaultOtherRouterGroupGuid, 5300, "10.0.0.2", 53, "", 60, models.ModificationTag{}),
+					}, nil)
+				})
+
+				It("does not save the router group and lists the udp routes", func() {
+					update(`{"type": "tcp", "reservable_ports": "1024-65535"}`)
+
+					Expect(fakeDb.SaveRouterGroupCallCount()).To(Equal(0))
+					Expect(responseRecorder.Code).To(Equal(http.StatusConflict))
+
+					var payload struct {
+						Name             string                   `json:"name"`
+						Message          string                   `json:"message"`
+						UdpRouteMappings []models.UdpRouteMapping `json:"udp_routes"`
+					}
+					Expect(json.Unmarshal(responseRecorder.Body.Bytes(), &payload)).To(Succeed())
+					Expect(payload.Name).To(Equal("RouterGroupInUseError"))
+					Expect(payload.Message).To(ContainSubstring("has 0 http routes, 0 tcp routes, 1 udp routes and 0 port reservations"))
+					Expect(payload.UdpRouteMappings).To(HaveLen(1))
+					Expect(payload.UdpRouteMappings[0].HostIP).To(Equal("10.0.0.1"))
+				})
+			})
+
+			Context("when an http router group has http routes", func() {
+				BeforeEach(func() {
+					existingTCPRouterGroup.Type = models.RouterGroup_HTTP
+					route := models.NewRoute("a.example.com", 8080, "10.0.0.1", "", "", 60)
+					route.RouterGroupGuid = DefaultRouterGroupGuid
+					otherRoute := models.NewRoute("b.example.com", 8080, "10.0.0.2", "", "", 60)
+					otherRoute.RouterGroupGuid = DefaultOtherRouterGroupGuid
+					fakeDb.ReadRoutesReturns([]models.Route{route, otherRoute}, nil)
+				})
+
+				It("does not save the router group and lists the http routes", func() {
+					update(`{"type": "tcp", "reservable_ports": "1024-65535"}`)
+
+					Expect(fakeDb.SaveRouterGroupCallCount()).To(Equal(0))
+					Expect(responseRecorder.Code).To(Equal(http.StatusConflict))
+
+					var payload struct {
+						Message string         `json:"message"`
+						Routes  []models.Route `json:"routes"`
+					}
+					Expect(json.Unmarshal(responseRecorder.Body.Bytes(), &payload)).To(Succeed())
+					Expect(payload.Message).To(ContainSubstring("has 1 http routes, 0 tcp routes, 0 udp routes and 0 port reservations"))
+					Expect(payload.Routes).To(HaveLen(1))
+					Expect(payload.Routes[0].Route).To(Equal("a.example.com"))
+				})
+			})
+
+			Context("when the dependents cannot be read", func() {
+				BeforeEach(func() {
+					fakeDb.ReadPortReservationsReturns(nil, errors.New("db communication failed"))
+				})
+
+				It("returns a DB communication error", func() {
+					update(`{"type": "http", "reservable_ports": ""}`)
+
+					Expect(fakeDb.SaveRouterGroupCallCount()).To(Equal(0))
+					Expect(responseRecorder.Code).To(Equal(http.StatusServiceUnavailable))
+				})
+			})
+		})
+
 		It("checks for routing.router_groups.write scope", func() {
 			var err error
 			updatedGroup := models.RouterGroup{
@@ -766,6 +1390,87 @@ var _ = Describe("RouterGroupsHandler", func() {
 			})
 		})
 
+		Context("when the router group has routes or port reservations", func() {
+			BeforeEach(func() {
+				fakeDb.DeleteRouterGroupReturns(db.DBError{Type: db.InUse, Message: "Delete Fails: Router Group has 1 http routes, 1 tcp routes, 1 udp routes and 1 port reservations"})
+				route := models.NewRoute("a.example.com", 8080, "10.0.0.4", "", "", 60)
+				route.RouterGroupGuid = DefaultRouterGroupGuid
+				fakeDb.ReadRoutesReturns([]models.Route{route, models.NewRoute("b.example.com", 8080, "10.0.0.5", "", "", 60)}, nil)
+				fakeDb.ReadTcpRouteMappingsReturns([]models.TcpRouteMapping{
+					models.NewTcpRouteMapping(DefaultRouterGroupGuid, 2000, "10.0.0.1", 8080, 0, "", nil, nil, 60, models.ModificationTag{}, false, ""),
+					models.NewTcpRouteMapping(DefaultOtherRouterGroupGuid, 2000, "10.0.0.2", 8080, 0, "", nil, nil, 60, models.ModificationTag{}, false, ""),
+				}, nil)
+				fakeDb.ReadUdpRouteMappingsReturns([]models.UdpRouteMapping{
+					models.NewUdpRouteMapping(DefaultRouterGroupGuid, 5300, "10.0.0.3", 53, "", 60, models.ModificationTag{}),
+				}, nil)
+				fakeDb.ReadPortReservationsReturns([]models.PortReservation{
+					models.NewPortReservation(DefaultRouterGroupGuid, 2001, "some-owner", nil),
+				}, nil)
+			})
+
+			It("returns a conflict listing the dependents", func() {
+				var err error
+				request, err = http.NewRequest(
+					"DELETE",
+					fmt.Sprintf("/routing/v1/router_groups/%s", DefaultRouterGroupGuid),
+					nil,
+				)
+				Expect(err).NotTo(HaveOccurred())
+				handler.ServeHTTP(responseRecorder, request)
+
+				Expect(fakeDb.DeleteRouterGroupCascadeCallCount()).To(Equal(0))
+				Expect(responseRecorder.Code).To(Equal(http.StatusConflict))
+
+				var payload struct {
+					Name             string                   `json:"name"`
+					Message          string                   `json:"message"`
+					Routes           []models.Route           `json:"routes"`
+					TcpRouteMappings []models.TcpRouteMapping `json:"tcp_routes"`
+					UdpRouteMappings []models.UdpRouteMapping `json:"udp_routes"`
+					PortReservations []models.PortReservation `json:"port_reservations"`
+				}
+				Expect(json.Unmarshal(responseRecorder.Body.Bytes(), &payload)).To(Succeed())
+				Expect(payload.Name).To(Equal("RouterGroupInUseError"))
+				Expect(payload.Message).To(ContainSubstring("cascade=true"))
+				Expect(payload.Routes).To(HaveLen(1))
+				Expect(payload.Routes[0].IP).To(Equal("10.0.0.4"))
+				Expect(payload.TcpRouteMappings).To(HaveLen(1))
+				Expect(payload.TcpRouteMappings[0].HostIP).To(Equal("10.0.0.1"))
+				Expect(payload.UdpRouteMappings).To(HaveLen(1))
+				Expect(payload.UdpRouteMappings[0].HostIP).To(Equal("10.0.0.3"))
+				Expect(payload.PortReservations).To(HaveLen(1))
+				Expect(payload.PortReservations[0].Owner).To(Equal("some-owner"))
+			})
+		})
+
+		Context("when cascade is given", func() {
+			It("deletes the router group with its dependents", func() {
+				var err error
+				request, err = http.NewRequest(
+					"DELETE",
+					fmt.Sprintf("/routing/v1/router_groups/%s?cascade=true", DefaultRouterGroupGuid),
+					nil,
+				)
+				Expect(err).NotTo(HaveOccurred())
+				handler.ServeHTTP(responseRecorder, request)
+
+				Expect(fakeDb.DeleteRouterGroupCallCount()).To(Equal(0))
+				Expect(fakeDb.DeleteRouterGroupCascadeCallCount()).To(Equal(1))
+				Expect(fakeDb.DeleteRouterGroupCascadeArgsForCall(0)).To(Equal(DefaultRouterGroupGuid))
+				Expect(responseRecorder.Code).To(Equal(http.StatusNoContent))
+			})
+
+			It("returns a not found status when the router group does not exist", func() {
+				fakeDb.DeleteRouterGroupCascadeReturns(models.RouterGroupDependents{}, db.DeleteRouterGroupError)
+				var err error
+				request, err = http.NewRequest("DELETE", "/routing/v1/router_groups/not-exist?cascade=true", nil)
+				Expect(err).NotTo(HaveOccurred())
+				handler.ServeHTTP(responseRecorder, request)
+
+				Expect(responseRecorder.Code).To(Equal(http.StatusNotFound))
+			})
+		})
+
 		Context("when the db fails to delete router group", func() {
 			BeforeEach(func() {
 				fakeDb.DeleteRouterGroupReturns(errors.New("db communication failed"))
@@ -817,6 +1522,99 @@ var _ = Describe("RouterGroupsHandler", func() {
 		})
 	})
 
+	Describe("RouterGroupPorts", func() {
+		var (
+			handler http.Handler
+		)
+
+		BeforeEach(func() {
+			var err error
+			handler, err = rata.NewRouter(rata.Routes{
+				routing_api.RoutesMap[routing_api.RouterGroupPorts],
+			}, rata.Handlers{
+				routing_api.RouterGroupPorts: http.HandlerFunc(routerGroupHandler.RouterGroupPorts),
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			fakeDb.ReadRouterGroupReturns(models.RouterGroup{
+				Guid:            DefaultRouterGroupGuid,
+				Name:            DefaultRouterGroupName,
+				Type:            DefaultRouterGroupType,
+				ReservablePorts: "1024-1026",
+			}, nil)
+			fakeDb.ReadTcpRouteMappingsReturns([]models.TcpRouteMapping{
+				models.NewTcpRouteMapping(DefaultRouterGroupGuid, 1024, "10.0.0.1", 8080, 0, "", nil, nil, 60, models.ModificationTag{}, false, ""),
+			}, nil)
+			fakeDb.ReadPortReservationsReturns([]models.PortReservation{
+				models.NewPortReservation(DefaultRouterGroupGuid, 1025, "some-owner", nil),
+			}, nil)
+		})
+
+		It("responds with the state of each reservable port and summary counts", func() {
+			var err error
+			request, err = http.NewRequest("GET", fmt.Sprintf("/routing/v1/router_groups/%s/ports", DefaultRouterGroupGuid), nil)
+			Expect(err).NotTo(HaveOccurred())
+			handler.ServeHTTP(responseRecorder, request)
+
+			_, permission := fakeClient.ValidateTokenArgsForCall(0)
+			Expect(permission).To(ConsistOf(handlers.RouterGroupsReadScope))
+			Expect(fakeDb.ReadRouterGroupArgsForCall(0)).To(Equal(DefaultRouterGroupGuid))
+
+			Expect(responseRecorder.Code).To(Equal(http.StatusOK))
+			Expect(responseRecorder.Body.String()).To(MatchJSON(`{
+				"router_group_guid": "bad25cff-9332-48a6-8603-b619858e7992",
+				"router_group_name": "default-tcp",
+				"summary": {"total": 3, "free": 1, "mapped": 1, "reserved": 1, "system_reserved": 0},
+				"ports": [
+					{"port": 1024, "state": "mapped", "backends": [{"backend_ip": "10.0.0.1", "backend_port": 8080}]},
+					{"port": 1025, "state": "reserved", "owner": "some-owner"},
+					{"port": 1026, "state": "free"}
+				]
+			}`))
+		})
+
+		It("only lists ports in the requested state", func() {
+			var err error
+			request, err = http.NewRequest("GET", fmt.Sprintf("/routing/v1/router_groups/%s/ports?state=free", DefaultRouterGroupGuid), nil)
+			Expect(err).NotTo(HaveOccurred())
+			handler.ServeHTTP(responseRecorder, request)
+
+			Expect(responseRecorder.Code).To(Equal(http.StatusOK))
+			var occupancy models.PortOccupancy
+			Expect(json.Unmarshal(responseRecorder.Body.Bytes(), &occupancy)).To(Succeed())
+			Expect(occupancy.Ports).To(Equal([]models.PortUsage{{Port: 1026, State: models.PortStateFree}}))
+			Expect(occupancy.Summary.Total).To(Equal(3))
+		})
+
+		Context("when the router group does not exist", func() {
+			BeforeEach(func() {
+				fakeDb.ReadRouterGroupReturns(models.RouterGroup{}, nil)
+			})
+
+			It("returns a not found status", func() {
+				var err error
+				request, err = http.NewRequest("GET", "/routing/v1/router_groups/not-exist/ports", nil)
+				Expect(err).NotTo(HaveOccurred())
+				handler.ServeHTTP(responseRecorder, request)
+				Expect(responseRecorder.Code).To(Equal(http.StatusNotFound))
+			})
+		})
+
+		Context("when the db fails to read tcp route mappings", func() {
+			BeforeEach(func() {
+				fakeDb.ReadTcpRouteMappingsReturns(nil, errors.New("db communication failed"))
+			})
+
+			It("returns a DB communication error", func() {
+				var err error
+				request, err = http.NewRequest("GET", fmt.Sprintf("/routing/v1/router_groups/%s/ports", DefaultRouterGroupGuid), nil)
+				Expect(err).NotTo(HaveOccurred())
+				handler.ServeHTTP(responseRecorder, request)
+				Expect(responseRecorder.Code).To(Equal(http.StatusServiceUnavailable))
+			})
+		})
+	})
+
 	Describe("CreateRouterGroup", func() {
 		Describe("HTTP Router groups", func() {
 			Context("when the request body is invalid", func() {
@@ -36,11 +36,25 @@ func (h *RoutesHandler) List(w http.ResponseWriter, req *http.Request) {
 		handleUnauthorizedError(w, err, log)
 		return
 	}
+	selector, err := labelSelectorFromRequest(req)
+	if err != nil {
+		handleProcessRequestError(w, err, log)
+		return
+	}
 	routes, err := h.db.ReadRoutes()
 	if err != nil {
 		handleDBCommunicationError(w, err, log)
 		return
 	}
+	if len(selector) > 0 {
+		var filtered []models.Route
+		for _, route := range routes {
+			if selector.Matches(route.Labels) {
+				filtered = append(filtered, route)
+			}
+		}
+		routes = filtered
+	}
 	encoder := json.NewEncoder(w)
 	err = encoder.Encode(routes)
 	if err != nil {
@@ -69,12 +83,29 @@ func (h *RoutesHandler) Upsert(w http.ResponseWriter, req *http.Request) {
 
 	log.Info("request", lager.Data{"route_creation": routes})
 
-	// set defaults
+	// router groups are only needed for the ttl policy of routes that
+	// belong to one
+	var routerGroups models.RouterGroups
+	for _, route := range routes {
+		if route.RouterGroupGuid != "" {
+			routerGroups, err = h.db.ReadRouterGroups()
+			if err != nil {
+				handleDBCommunicationError(w, err, log)
+				return
+			}
+			break
+		}
+	}
+
+	// set defaults; routes of unknown router groups are rejected by the
+	// validator
 	for i := 0; i < len(routes); i++ {
-		routes[i].SetDefaults(h.maxTTL)
+		policy, _ := routeTTLPolicy(routes[i], routerGroups, h.maxTTL)
+		routes[i].SetDefaults(policy.Default)
+		routes[i].Normalize()
 	}
 
-	apiErr := h.validator.ValidateCreate(routes, h.maxTTL)
+	apiErr := h.validator.ValidateCreate(routes, routerGroups, h.maxTTL)
 	if apiErr != nil {
 		handleApiError(w, apiErr, log)
 		return
@@ -111,14 +142,25 @@ func (h *RoutesHandler) Delete(w http.ResponseWriter, req *http.Request) {
 
 	log.Info("request", lager.Data{"route_deletion": routes})
 
+	// routes stored before hostnames were normalized are deleted by their
+	// requested hostname when the normalized one is not found
+	requested := make([]models.Route, len(routes))
+	copy(requested, routes)
+	for i := range routes {
+		routes[i].Normalize()
+	}
+
 	apiErr := h.validator.ValidateDelete(routes)
 	if apiErr != nil {
 		handleApiError(w, apiErr, log)
 		return
 	}
 
-	for _, route := range routes {
+	for i, route := range routes {
 		err = h.db.DeleteRoute(route)
+		if dberr, ok := err.(db.DBError); ok && dberr.Type == db.KeyNotFound && (route.Route != requested[i].Route || route.IP != requested[i].IP) {
+			err = h.db.DeleteRoute(requested[i])
+		}
 		if err != nil {
 			if dberr, ok := err.(db.DBError); !ok || dberr.Type != db.KeyNotFound {
 				handleDBCommunicationError(w, err, log)
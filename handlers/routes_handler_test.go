@@ -198,6 +198,41 @@ var _ = Describe("RoutesHandler", func() {
 			})
 		})
 
+		Context("when a label selector is given", func() {
+			BeforeEach(func() {
+				route1 := models.NewRoute("post_here", 7000, "1.2.3.4", "", "", 0)
+				route1.Labels = models.LabelSet(`{"env":"prod"}`)
+				route2 := models.NewRoute("post_there", 2000, "1.2.3.5", "", "", 0)
+				route2.Labels = models.LabelSet(`{"env":"staging"}`)
+
+				database.ReadRoutesReturns([]models.Route{route1, route2}, nil)
+			})
+
+			It("returns only the matching routes", func() {
+				request = handlers.NewTestRequest("")
+				request.URL.RawQuery = "label_selector=env%3Dprod"
+
+				routesHandler.List(responseRecorder, request)
+
+				Expect(responseRecorder.Code).To(Equal(http.StatusOK))
+				var routes []models.Route
+				err := json.Unmarshal(responseRecorder.Body.Bytes(), &routes)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(routes).To(HaveLen(1))
+				Expect(routes[0].Route).To(Equal("post_here"))
+			})
+
+			It("returns a 400 when the selector is invalid", func() {
+				request = handlers.NewTestRequest("")
+				request.URL.RawQuery = "label_selector=env+within+(prod)"
+
+				routesHandler.List(responseRecorder, request)
+
+				Expect(responseRecorder.Code).To(Equal(http.StatusBadRequest))
+				Expect(responseRecorder.Body.String()).To(ContainSubstring("ProcessRequestError"))
+			})
+		})
+
 		Context("when the database errors out", func() {
 			BeforeEach(func() {
 				database.ReadRoutesReturns(nil, errors.New("some bad thing happened"))
@@ -256,6 +291,26 @@ var _ = Describe("RoutesHandler", func() {
 				Expect(database.DeleteRouteArgsForCall(1)).To(Equal(routes[1]))
 			})
 
+			It("deletes routes by their normalized hostname", func() {
+				routes[0].Route = "Foo.Example.COM./Some/Path"
+
+				request = handlers.NewTestRequest(routes)
+				routesHandler.Delete(responseRecorder, request)
+
+				Expect(responseRecorder.Code).To(Equal(http.StatusNoContent))
+				Expect(database.DeleteRouteArgsForCall(0).Route).To(Equal("foo.example.com/Some/Path"))
+			})
+
+			It("deletes routes by their canonical backend ip", func() {
+				routes[0].IP = "::ffff:1.2.3.4"
+
+				request = handlers.NewTestRequest(routes)
+				routesHandler.Delete(responseRecorder, request)
+
+				Expect(responseRecorder.Code).To(Equal(http.StatusNoContent))
+				Expect(database.DeleteRouteArgsForCall(0).IP).To(Equal("1.2.3.4"))
+			})
+
 			It("logs the routes deletion", func() {
 				request = handlers.NewTestRequest(routes)
 				routesHandler.Delete(responseRecorder, request)
@@ -287,6 +342,29 @@ var _ = Describe("RoutesHandler", func() {
 					Expect(responseRecorder.Code).To(Equal(http.StatusNoContent))
 				})
 
+				It("deletes the route by its requested hostname when the normalized one is not found", func() {
+					database.DeleteRouteReturnsOnCall(0, db.DBError{Type: db.KeyNotFound, Message: "The specified route could not be found."})
+					routes[0].Route = "Foo.Example.COM./Some/Path"
+
+					request = handlers.NewTestRequest(routes)
+					routesHandler.Delete(responseRecorder, request)
+
+					Expect(responseRecorder.Code).To(Equal(http.StatusNoContent))
+					Expect(database.DeleteRouteCallCount()).To(Equal(2))
+					Expect(database.DeleteRouteArgsForCall(0).Route).To(Equal("foo.example.com/Some/Path"))
+					Expect(database.DeleteRouteArgsForCall(1).Route).To(Equal("Foo.Example.COM./Some/Path"))
+				})
+
+				It("does not retry when the route is already normalized", func() {
+					database.DeleteRouteReturns(db.DBError{Type: db.KeyNotFound, Message: "The specified route could not be found."})
+
+					request = handlers.NewTestRequest(routes)
+					routesHandler.Delete(responseRecorder, request)
+
+					Expect(responseRecorder.Code).To(Equal(http.StatusNoContent))
+					Expect(database.DeleteRouteCallCount()).To(Equal(1))
+				})
+
 				It("responds with a server error", func() {
 					database.DeleteRouteReturns(errors.New("stuff broke"))
 
@@ -350,6 +428,22 @@ var _ = Describe("RoutesHandler", func() {
 				Expect(permission).To(ConsistOf(handlers.RoutingRoutesWriteScope))
 			})
 
+			It("saves routes with their normalized hostname", func() {
+				route.Route = "Foo.Example.COM./Some/Path"
+				request = handlers.NewTestRequest([]models.Route{route})
+				routesHandler.Upsert(responseRecorder, request)
+				Expect(responseRecorder.Code).To(Equal(http.StatusCreated))
+				Expect(database.SaveRouteArgsForCall(0).Route).To(Equal("foo.example.com/Some/Path"))
+			})
+
+			It("saves routes with their canonical backend ip", func() {
+				route.IP = "2001:DB8:0::1"
+				request = handlers.NewTestRequest([]models.Route{route})
+				routesHandler.Upsert(responseRecorder, request)
+				Expect(responseRecorder.Code).To(Equal(http.StatusCreated))
+				Expect(database.SaveRouteArgsForCall(0).IP).To(Equal("2001:db8::1"))
+			})
+
 			Context("when TTL is not set", func() {
 				BeforeEach(func() {
 					route.TTL = nil
@@ -362,6 +456,33 @@ var _ = Describe("RoutesHandler", func() {
 					Expect(database.SaveRouteCallCount()).To(Equal(1))
 					Expect(*database.SaveRouteArgsForCall(0).TTL).To(Equal(defaultTTL))
 				})
+
+				Context("when the route belongs to a router group with a default ttl", func() {
+					BeforeEach(func() {
+						route.RouterGroupGuid = "http-guid"
+						database.ReadRouterGroupsReturns(models.RouterGroups{
+							{Guid: "http-guid", Name: "default-http", Type: models.RouterGroup_HTTP, DefaultTTL: 15},
+						}, nil)
+					})
+
+					It("sets the default TTL of the router group", func() {
+						request = handlers.NewTestRequest([]models.Route{route})
+						routesHandler.Upsert(responseRecorder, request)
+						Expect(responseRecorder.Code).To(Equal(http.StatusCreated))
+						Expect(*database.SaveRouteArgsForCall(0).TTL).To(Equal(15))
+
+						_, routerGroups, _ := validator.ValidateCreateArgsForCall(0)
+						Expect(routerGroups).To(HaveLen(1))
+					})
+
+					It("responds with a server error when the router groups cannot be read", func() {
+						database.ReadRouterGroupsReturns(nil, errors.New("db communication failed"))
+						request = handlers.NewTestRequest([]models.Route{route})
+						routesHandler.Upsert(responseRecorder, request)
+						Expect(responseRecorder.Code).To(Equal(http.StatusServiceUnavailable))
+						Expect(database.SaveRouteCallCount()).To(Equal(0))
+					})
+				})
 			})
 
 			Context("when all inputs are present and correct", func() {
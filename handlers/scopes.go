@@ -1,8 +1,12 @@
 package handlers
 
 const (
-	RouterGroupsReadScope   = "routing.router_groups.read"
-	RouterGroupsWriteScope  = "routing.router_groups.write"
-	RoutingRoutesReadScope  = "routing.routes.read"
-	RoutingRoutesWriteScope = "routing.routes.write"
+	RouterGroupsReadScope        = "routing.router_groups.read"
+	RouterGroupsWriteScope       = "routing.router_groups.write"
+	RoutingRoutesReadScope       = "routing.routes.read"
+	RoutingRoutesWriteScope      = "routing.routes.write"
+	TlsCertificatesReadScope     = "routing.tls_certificates.read"
+	TlsCertificatesWriteScope    = "routing.tls_certificates.write"
+	BackendTlsProfilesReadScope  = "routing.backend_tls_profiles.read"
+	BackendTlsProfilesWriteScope = "routing.backend_tls_profiles.write"
 )
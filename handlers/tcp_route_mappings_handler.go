@@ -5,6 +5,7 @@ import (
 	"net/http"
 
 	"code.cloudfoundry.org/lager/v3"
+	routing_api "code.cloudfoundry.org/routing-api"
 	"code.cloudfoundry.org/routing-api/db"
 	"code.cloudfoundry.org/routing-api/models"
 	"code.cloudfoundry.org/routing-api/uaaclient"
@@ -36,6 +37,11 @@ func (h *TcpRouteMappingsHandler) List(w http.ResponseWriter, req *http.Request)
 		handleUnauthorizedError(w, err, log)
 		return
 	}
+	selector, err := labelSelectorFromRequest(req)
+	if err != nil {
+		handleProcessRequestError(w, err, log)
+		return
+	}
 	query := req.URL.Query()
 	var routes []models.TcpRouteMapping
 	if len(query["isolation_segment"]) > 0 {
@@ -47,6 +53,15 @@ func (h *TcpRouteMappingsHandler) List(w http.ResponseWriter, req *http.Request)
 		handleDBCommunicationError(w, err, log)
 		return
 	}
+	if len(selector) > 0 {
+		var filtered []models.TcpRouteMapping
+		for _, route := range routes {
+			if selector.Matches(route.Labels) {
+				filtered = append(filtered, route)
+			}
+		}
+		routes = filtered
+	}
 	encoder := json.NewEncoder(w)
 	err = encoder.Encode(routes)
 	if err != nil {
@@ -71,13 +86,6 @@ func (h *TcpRouteMappingsHandler) Upsert(w http.ResponseWriter, req *http.Reques
 		return
 	}
 
-	// set defaults
-	for i := 0; i < len(tcpMappings); i++ {
-		tcpMappings[i].SetDefaults(h.maxTTL)
-	}
-
-	log.Info("request", lager.Data{"tcp_mapping_creation": tcpMappings})
-
 	// fetch current router groups
 	routerGroups, err := h.db.ReadRouterGroups()
 	if err != nil {
@@ -85,16 +93,32 @@ func (h *TcpRouteMappingsHandler) Upsert(w http.ResponseWriter, req *http.Reques
 		return
 	}
 
-	for _, tcpMapping := range tcpMappings {
+	// set defaults
+	for i := 0; i < len(tcpMappings); i++ {
+		policy := tcpRouteMappingTTLPolicy(tcpMappings[i], routerGroups, h.maxTTL)
+		tcpMappings[i].SetDefaults(policy.Default)
+		tcpMappings[i].Normalize()
+	}
+
+	log.Info("request", lager.Data{"tcp_mapping_creation": tcpMappings})
+
+	for i, tcpMapping := range tcpMappings {
 		var sniHostName string
 		if _sniHostname := tcpMapping.SniHostname; _sniHostname != nil {
 			sniHostName = *_sniHostname
 		}
-		externalPort := tcpMapping.ExternalPort
-		similarTcpMappings, err := h.db.FindSimilarTcpRouteMappings(sniHostName, externalPort)
-		if err != nil {
-			handleDBCommunicationError(w, err, log)
-			return
+		var similarTcpMappings, overlappingTcpMappings []models.TcpRouteMapping
+		if externalPort := tcpMapping.ExternalPort; externalPort != 0 {
+			similarTcpMappings, err = h.db.FindSimilarTcpRouteMappings(sniHostName, externalPort)
+			if err != nil {
+				handleDBCommunicationError(w, err, log)
+				return
+			}
+			overlappingTcpMappings, err = h.db.FindOverlappingTcpRouteMappings(tcpMapping)
+			if err != nil {
+				handleDBCommunicationError(w, err, log)
+				return
+			}
 		}
 
 		apiErr := h.validator.ValidateCreateTcpRouteMapping(tcpMapping, similarTcpMappings, routerGroups, h.maxTTL)
@@ -102,17 +126,129 @@ func (h *TcpRouteMappingsHandler) Upsert(w http.ResponseWriter, req *http.Reques
 			handleProcessRequestError(w, apiErr, log)
 			return
 		}
+
+		// the mappings before it in the request are saved first
+		apiErr = h.validator.ValidateTcpRouteMappingOverlaps(tcpMapping, append(overlappingTcpMappings, tcpMappings[:i]...))
+		if apiErr != nil {
+			handleProcessRequestError(w, apiErr, log)
+			return
+		}
+
+		if tlsCertificateGuid := tcpMapping.TlsCertificateGuid; tlsCertificateGuid != "" {
+			tlsCertificate, err := h.db.ReadTlsCertificate(tlsCertificateGuid)
+			if err != nil {
+				handleDBCommunicationError(w, err, log)
+				return
+			}
+			apiErr = h.validator.ValidateTcpRouteMappingTlsCertificate(tcpMapping, tlsCertificate)
+			if apiErr != nil {
+				handleProcessRequestError(w, apiErr, log)
+				return
+			}
+		}
+
+		if backendTlsProfileName := tcpMapping.BackendTlsProfile; backendTlsProfileName != "" {
+			backendTlsProfile, err := h.db.ReadBackendTlsProfileByName(backendTlsProfileName)
+			if err != nil {
+				handleDBCommunicationError(w, err, log)
+				return
+			}
+			apiErr = h.validator.ValidateTcpRouteMappingBackendTlsProfile(tcpMapping, backendTlsProfile)
+			if apiErr != nil {
+				handleProcessRequestError(w, apiErr, log)
+				return
+			}
+		}
 	}
 
-	for _, tcpMapping := range tcpMappings {
-		err = h.db.SaveTcpRouteMapping(tcpMapping)
+	err = h.checkQuotas(tcpMappings, routerGroups)
+	if quotaErr, ok := err.(routing_api.Error); ok {
+		handleRouterGroupQuotaExceededError(w, quotaErr, log)
+		return
+	}
+	if err != nil {
+		handleDBCommunicationError(w, err, log)
+		return
+	}
+
+	for i, tcpMapping := range tcpMappings {
+		if tcpMapping.ExternalPort == 0 {
+			tcpMappings[i], err = h.db.AllocateTcpRouteMapping(tcpMapping)
+		} else {
+			err = h.db.SaveTcpRouteMapping(tcpMapping)
+		}
 		if err != nil {
+			if dberr, ok := err.(db.DBError); ok && dberr.Type == db.PortsExhausted {
+				handlePortRangeExhaustedError(w, err, log)
+				return
+			}
+			if dberr, ok := err.(db.DBError); ok && dberr.Type == db.QuotaExceeded {
+				handleRouterGroupQuotaExceededError(w, err, log)
+				return
+			}
 			handleDBCommunicationError(w, err, log)
 			return
 		}
 	}
 
 	w.WriteHeader(http.StatusCreated)
+	// the response carries the external ports that were allocated
+	err = json.NewEncoder(w).Encode(tcpMappings)
+	if err != nil {
+		log.Error("error writing to request", err)
+	}
+}
+
+// checkQuotas returns a RouterGroupQuotaExceededError when saving the
+// mappings would exceed the quotas of their router groups, so that none of
+// them is saved. Mappings that refresh a live mapping are not counted. Live
+// mappings are only read when a router group of the mappings has quotas. The
+// db enforces the quotas again for each mapping while it holds the lock of
+// the router group, since concurrent requests may pass this check together.
+func (h *TcpRouteMappingsHandler) checkQuotas(tcpMappings []models.TcpRouteMapping, routerGroups models.RouterGroups) error {
+	var (
+		live   []models.TcpRouteMapping
+		loaded bool
+		usage  = map[string]*models.RouterGroupQuotaUsage{}
+	)
+	for _, tcpMapping := range tcpMappings {
+		routerGroup, ok := routerGroups.FindByGuid(tcpMapping.RouterGroupGuid)
+		if !ok || !routerGroup.HasTcpRouteQuotas() {
+			continue
+		}
+
+		if !loaded {
+			var err error
+			live, err = h.db.ReadTcpRouteMappings()
+			if err != nil {
+				return err
+			}
+			loaded = true
+		}
+
+		refresh := false
+		for _, liveMapping := range live {
+			if tcpMapping.ExternalPort != 0 && liveMapping.SameRoute(tcpMapping) {
+				refresh = true
+				break
+			}
+		}
+		if refresh {
+			continue
+		}
+
+		groupUsage, ok := usage[routerGroup.Guid]
+		if !ok {
+			u := models.NewRouterGroupQuotaUsage(routerGroup, live)
+			groupUsage = &u
+			usage[routerGroup.Guid] = groupUsage
+		}
+		if err := groupUsage.Add(routerGroup, tcpMapping); err != nil {
+			return routing_api.NewError(routing_api.RouterGroupQuotaExceededError, err.Error())
+		}
+		live = append(live, tcpMapping)
+	}
+	return nil
 }
 
 func (h *TcpRouteMappingsHandler) Delete(w http.ResponseWriter, req *http.Request) {
@@ -134,14 +270,25 @@ func (h *TcpRouteMappingsHandler) Delete(w http.ResponseWriter, req *http.Reques
 
 	log.Info("request", lager.Data{"tcp_mapping_deletion": tcpMappings})
 
+	// mappings stored before hostnames were normalized are deleted by their
+	// requested hostnames when the normalized ones are not found
+	requested := make([]models.TcpRouteMapping, len(tcpMappings))
+	copy(requested, tcpMappings)
+	for i := range tcpMappings {
+		tcpMappings[i].Normalize()
+	}
+
 	apiErr := h.validator.ValidateDeleteTcpRouteMapping(tcpMappings)
 	if apiErr != nil {
 		handleProcessRequestError(w, apiErr, log)
 		return
 	}
 
-	for _, tcpMapping := range tcpMappings {
+	for i, tcpMapping := range tcpMappings {
 		err = h.db.DeleteTcpRouteMapping(tcpMapping)
+		if dberr, ok := err.(db.DBError); ok && dberr.Type == db.KeyNotFound && !tcpMapping.SameRoute(requested[i]) {
+			err = h.db.DeleteTcpRouteMapping(requested[i])
+		}
 		if err != nil {
 			if dberr, ok := err.(db.DBError); !ok || dberr.Type != db.KeyNotFound {
 				handleDBCommunicationError(w, err, log)
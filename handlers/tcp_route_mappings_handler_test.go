@@ -1,6 +1,7 @@
 package handlers_test
 
 import (
+	"encoding/json"
 	"errors"
 	"net/http"
 	"net/http/httptest"
@@ -175,6 +176,25 @@ var _ = Describe("TcpRouteMappingsHandler", func() {
 				})
 			})
 
+			Context("when ttl is not present and the router group has a default ttl", func() {
+				BeforeEach(func() {
+					database.ReadRouterGroupsReturns(models.RouterGroups{
+						{Guid: "router-group-guid-001", Name: "default-tcp", Type: models.RouterGroup_TCP, ReservablePorts: "1024-65535", DefaultTTL: 30},
+					}, nil)
+				})
+
+				It("sets the default ttl of the router group", func() {
+					tcpMapping := models.NewTcpRouteMapping("router-group-guid-001", 52000, "1.2.3.4", 60000, 0, "", nil, nil, 0, models.ModificationTag{}, false, "")
+					tcpMapping.TTL = nil
+					request = handlers.NewTestRequest([]models.TcpRouteMapping{tcpMapping})
+
+					tcpRouteMappingsHandler.Upsert(responseRecorder, request)
+					Expect(responseRecorder.Code).To(Equal(http.StatusCreated))
+					Expect(database.SaveTcpRouteMappingCallCount()).To(Equal(1))
+					Expect(*database.SaveTcpRouteMappingArgsForCall(0).TTL).To(Equal(30))
+				})
+			})
+
 			Context("when ttl is present", func() {
 				var tcpMappings []models.TcpRouteMapping
 
@@ -273,6 +293,120 @@ var _ = Describe("TcpRouteMappingsHandler", func() {
 				})
 			})
 
+			Context("when the SNI hostname is not normalized", func() {
+				It("saves and looks up similar mappings with the normalized hostname", func() {
+					sniHostname := "Foo.Example.COM."
+					tcpMapping := models.NewTcpRouteMapping("router-group-guid-001", 52000, "1.2.3.4", 60000, 0, "instanceId", &sniHostname, nil, 60, models.ModificationTag{}, false, "")
+					request = handlers.NewTestRequest([]models.TcpRouteMapping{tcpMapping})
+
+					tcpRouteMappingsHandler.Upsert(responseRecorder, request)
+					Expect(responseRecorder.Code).To(Equal(http.StatusCreated))
+
+					sniHostname, _ = database.FindSimilarTcpRouteMappingsArgsForCall(0)
+					Expect(sniHostname).To(Equal("foo.example.com"))
+					Expect(*database.SaveTcpRouteMappingArgsForCall(0).SniHostname).To(Equal("foo.example.com"))
+				})
+			})
+
+			Context("when the backend ip is not canonical", func() {
+				It("saves the mapping with the canonical backend ip", func() {
+					tcpMapping := models.NewTcpRouteMapping("router-group-guid-001", 52000, "2001:0DB8::0001", 60000, 0, "instanceId", nil, nil, 60, models.ModificationTag{}, false, "")
+					request = handlers.NewTestRequest([]models.TcpRouteMapping{tcpMapping})
+
+					tcpRouteMappingsHandler.Upsert(responseRecorder, request)
+					Expect(responseRecorder.Code).To(Equal(http.StatusCreated))
+
+					Expect(database.SaveTcpRouteMappingArgsForCall(0).HostIP).To(Equal("2001:db8::1"))
+				})
+			})
+
+			Context("when alpns are given as an array", func() {
+				It("saves them as a normalized csv value", func() {
+					request = handlers.NewTestRequest(`[{
+						"router_group_guid": "router-group-guid-001",
+						"port": 52000,
+						"backend_ip": "1.2.3.4",
+						"backend_port": 60000,
+						"ttl": 60,
+						"terminate_frontend_tls": true,
+						"alpns": ["h2", " http/1.1"]
+					}]`)
+
+					tcpRouteMappingsHandler.Upsert(responseRecorder, request)
+					Expect(responseRecorder.Code).To(Equal(http.StatusCreated))
+
+					Expect(database.SaveTcpRouteMappingCallCount()).To(Equal(1))
+					Expect(database.SaveTcpRouteMappingArgsForCall(0).ALPNs).To(Equal(models.ALPNs("h2,http/1.1")))
+				})
+			})
+
+			Context("when the external port is 0", func() {
+				var tcpMappings []models.TcpRouteMapping
+
+				BeforeEach(func() {
+					tcpMapping := models.NewTcpRouteMapping("router-group-guid-001", 0, "1.2.3.4", 60000, 0, "instanceId", nil, nil, 60, models.ModificationTag{}, false, "")
+					tcpMappings = []models.TcpRouteMapping{tcpMapping}
+
+					database.AllocateTcpRouteMappingStub = func(mapping models.TcpRouteMapping) (models.TcpRouteMapping, error) {
+						mapping.ExternalPort = 1024
+						return mapping, nil
+					}
+				})
+
+				It("allocates an external port instead of saving the mapping", func() {
+					request = handlers.NewTestRequest(tcpMappings)
+					tcpRouteMappingsHandler.Upsert(responseRecorder, request)
+
+					Expect(responseRecorder.Code).To(Equal(http.StatusCreated))
+					Expect(database.SaveTcpRouteMappingCallCount()).To(Equal(0))
+					Expect(database.FindSimilarTcpRouteMappingsCallCount()).To(Equal(0))
+					Expect(database.AllocateTcpRouteMappingCallCount()).To(Equal(1))
+					Expect(database.AllocateTcpRouteMappingArgsForCall(0)).To(Equal(tcpMappings[0]))
+				})
+
+				It("returns the allocated port", func() {
+					request = handlers.NewTestRequest(tcpMappings)
+					tcpRouteMappingsHandler.Upsert(responseRecorder, request)
+
+					var createdMappings []models.TcpRouteMapping
+					err := json.Unmarshal(responseRecorder.Body.Bytes(), &createdMappings)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(createdMappings).To(HaveLen(1))
+					Expect(createdMappings[0].ExternalPort).To(Equal(uint16(1024)))
+					Expect(createdMappings[0].HostIP).To(Equal("1.2.3.4"))
+				})
+
+				Context("when there are no free ports in the router group", func() {
+					BeforeEach(func() {
+						database.AllocateTcpRouteMappingStub = nil
+						database.AllocateTcpRouteMappingReturns(models.TcpRouteMapping{}, db.DBError{Type: db.PortsExhausted, Message: "There are no free ports in router group default-tcp"})
+					})
+
+					It("responds with a conflict", func() {
+						request = handlers.NewTestRequest(tcpMappings)
+						tcpRouteMappingsHandler.Upsert(responseRecorder, request)
+
+						Expect(responseRecorder.Code).To(Equal(http.StatusConflict))
+						Expect(responseRecorder.Body.String()).To(ContainSubstring(string(routing_api.PortRangeExhaustedError)))
+						Expect(responseRecorder.Body.String()).To(ContainSubstring("There are no free ports in router group default-tcp"))
+					})
+				})
+
+				Context("when the database fails to allocate", func() {
+					BeforeEach(func() {
+						database.AllocateTcpRouteMappingStub = nil
+						database.AllocateTcpRouteMappingReturns(models.TcpRouteMapping{}, errors.New("stuff broke"))
+					})
+
+					It("responds with a server error", func() {
+						request = handlers.NewTestRequest(tcpMappings)
+						tcpRouteMappingsHandler.Upsert(responseRecorder, request)
+
+						Expect(responseRecorder.Code).To(Equal(http.StatusServiceUnavailable))
+					})
+				})
+			})
+
 			Context("when there are errors with the input ports", func() {
 				It("blows up when a external port is negative", func() {
 					request = handlers.NewTestRequest(`[{"router_group_guid": "tcp-default", "port": -1, "backend_ip": "10.1.1.12", "backend_port": 60000}]`)
@@ -316,6 +450,288 @@ var _ = Describe("TcpRouteMappingsHandler", func() {
 				})
 			})
 
+			Context("when the router group has quotas", func() {
+				var tcpMapping models.TcpRouteMapping
+
+				BeforeEach(func() {
+					database.ReadRouterGroupsReturns(models.RouterGroups{
+						{Guid: "router-group-guid-001", Name: "default-tcp", Type: models.RouterGroup_TCP, ReservablePorts: "1024-65535", MaxTcpRoutes: 1},
+					}, nil)
+					database.ReadTcpRouteMappingsReturns([]models.TcpRouteMapping{
+						models.NewTcpRouteMapping("router-group-guid-001", 52000, "1.2.3.4", 60000, 0, "", nil, nil, 60, models.ModificationTag{}, false, ""),
+					}, nil)
+					tcpMapping = models.NewTcpRouteMapping("router-group-guid-001", 52000, "1.2.3.5", 60000, 0, "", nil, nil, 60, models.ModificationTag{}, false, "")
+				})
+
+				It("responds with a conflict when a quota would be exceeded", func() {
+					request = handlers.NewTestRequest([]models.TcpRouteMapping{tcpMapping})
+					tcpRouteMappingsHandler.Upsert(responseRecorder, request)
+
+					Expect(responseRecorder.Code).To(Equal(http.StatusConflict))
+					Expect(responseRecorder.Body.String()).To(MatchJSON(`{
+						"name": "RouterGroupQuotaExceededError",
+						"message": "router group default-tcp has reached its quota of 1 tcp routes"
+					}`))
+					Expect(database.SaveTcpRouteMappingCallCount()).To(Equal(0))
+				})
+
+				It("refreshes live mappings", func() {
+					tcpMapping.HostIP = "1.2.3.4"
+					request = handlers.NewTestRequest([]models.TcpRouteMapping{tcpMapping})
+					tcpRouteMappingsHandler.Upsert(responseRecorder, request)
+
+					Expect(responseRecorder.Code).To(Equal(http.StatusCreated))
+					Expect(database.SaveTcpRouteMappingCallCount()).To(Equal(1))
+				})
+
+				It("responds with a conflict when the db rejects a mapping that exceeds a quota", func() {
+					database.ReadTcpRouteMappingsReturns(nil, nil)
+					database.SaveTcpRouteMappingReturns(db.DBError{Type: db.QuotaExceeded, Message: "router group default-tcp has reached its quota of 1 tcp routes"})
+					request = handlers.NewTestRequest([]models.TcpRouteMapping{tcpMapping})
+					tcpRouteMappingsHandler.Upsert(responseRecorder, request)
+
+					Expect(responseRecorder.Code).To(Equal(http.StatusConflict))
+					Expect(responseRecorder.Body.String()).To(MatchJSON(`{
+						"name": "RouterGroupQuotaExceededError",
+						"message": "router group default-tcp has reached its quota of 1 tcp routes"
+					}`))
+				})
+
+				It("responds with a server error when the mappings cannot be read", func() {
+					database.ReadTcpRouteMappingsReturns(nil, errors.New("db communication failed"))
+					request = handlers.NewTestRequest([]models.TcpRouteMapping{tcpMapping})
+					tcpRouteMappingsHandler.Upsert(responseRecorder, request)
+
+					Expect(responseRecorder.Code).To(Equal(http.StatusServiceUnavailable))
+					Expect(database.SaveTcpRouteMappingCallCount()).To(Equal(0))
+				})
+			})
+
+			Context("when the proxy protocol of an existing mapping is changed", func() {
+				var (
+					existing   models.TcpRouteMapping
+					tcpMapping models.TcpRouteMapping
+				)
+
+				BeforeEach(func() {
+					realValidator := handlers.NewValidator(models.PortPolicy{}, models.ConnectionLimitPolicy{}, nil, models.BackendNetworkPolicy{})
+					tcpRouteMappingsHandler = handlers.NewTcpRouteMappingsHandler(fakeClient, realValidator, database, maxTTL, logger)
+					database.ReadRouterGroupsReturns(models.RouterGroups{
+						{Guid: "router-group-guid-001", Name: "default-tcp", Type: models.RouterGroup_TCP, ReservablePorts: "1024-65535"},
+					}, nil)
+
+					existing = models.NewTcpRouteMapping("router-group-guid-001", 52000, "1.2.3.4", 60000, 0, "", nil, nil, 60, models.ModificationTag{}, false, "")
+					existing.ProxyProtocol = models.ProxyProtocolV1
+					tcpMapping = existing
+					tcpMapping.ProxyProtocol = models.ProxyProtocolV2
+				})
+
+				It("saves the change when the mapping is the only backend of the route", func() {
+					database.FindSimilarTcpRouteMappingsReturns([]models.TcpRouteMapping{existing}, nil)
+
+					request = handlers.NewTestRequest([]models.TcpRouteMapping{tcpMapping})
+					tcpRouteMappingsHandler.Upsert(responseRecorder, request)
+
+					Expect(responseRecorder.Code).To(Equal(http.StatusCreated))
+					Expect(database.SaveTcpRouteMappingCallCount()).To(Equal(1))
+					Expect(database.SaveTcpRouteMappingArgsForCall(0).ProxyProtocol).To(Equal(models.ProxyProtocolV2))
+				})
+
+				It("rejects the change while other backends of the route use another proxy protocol", func() {
+					other := existing
+					other.HostIP = "1.2.3.5"
+					database.FindSimilarTcpRouteMappingsReturns([]models.TcpRouteMapping{existing, other}, nil)
+
+					request = handlers.NewTestRequest([]models.TcpRouteMapping{tcpMapping})
+					tcpRouteMappingsHandler.Upsert(responseRecorder, request)
+
+					Expect(responseRecorder.Code).To(Equal(http.StatusBadRequest))
+					Expect(responseRecorder.Body.String()).To(ContainSubstring("proxy_protocol: v2 not allowed"))
+					Expect(database.SaveTcpRouteMappingCallCount()).To(Equal(0))
+				})
+			})
+
+			Context("when the mapping forwards a port range", func() {
+				var tcpMappings []models.TcpRouteMapping
+
+				BeforeEach(func() {
+					tcpMapping := models.NewTcpRouteMapping("router-group-guid-001", 40000, "1.2.3.4", 50000, 0, "instanceId", nil, nil, 60, models.ModificationTag{}, false, "")
+					tcpMapping.ExternalPortEnd = 40099
+					single := models.NewTcpRouteMapping("router-group-guid-001", 40100, "1.2.3.4", 60000, 0, "instanceId", nil, nil, 60, models.ModificationTag{}, false, "")
+					tcpMappings = []models.TcpRouteMapping{tcpMapping, single}
+				})
+
+				It("saves the range as a single mapping", func() {
+					request = handlers.NewTestRequest(tcpMappings[:1])
+					tcpRouteMappingsHandler.Upsert(responseRecorder, request)
+
+					Expect(responseRecorder.Code).To(Equal(http.StatusCreated))
+					Expect(database.SaveTcpRouteMappingCallCount()).To(Equal(1))
+					Expect(database.SaveTcpRouteMappingArgsForCall(0).ExternalPortEnd).To(Equal(uint16(40099)))
+				})
+
+				It("validates overlaps with live mappings and the mappings before it in the request", func() {
+					live := models.NewTcpRouteMapping("router-group-guid-001", 40100, "1.2.3.5", 60001, 0, "", nil, nil, 60, models.ModificationTag{}, false, "")
+					database.FindOverlappingTcpRouteMappingsReturns([]models.TcpRouteMapping{live}, nil)
+
+					request = handlers.NewTestRequest(tcpMappings)
+					tcpRouteMappingsHandler.Upsert(responseRecorder, request)
+
+					Expect(responseRecorder.Code).To(Equal(http.StatusCreated))
+					Expect(database.FindOverlappingTcpRouteMappingsCallCount()).To(Equal(2))
+					Expect(database.FindOverlappingTcpRouteMappingsArgsForCall(0)).To(Equal(tcpMappings[0]))
+					Expect(validator.ValidateTcpRouteMappingOverlapsCallCount()).To(Equal(2))
+					mapping, overlapping := validator.ValidateTcpRouteMappingOverlapsArgsForCall(1)
+					Expect(mapping).To(Equal(tcpMappings[1]))
+					Expect(overlapping).To(Equal([]models.TcpRouteMapping{live, tcpMappings[0]}))
+				})
+
+				It("returns error without saving when the mapping overlaps", func() {
+					err := routing_api.NewError(routing_api.TcpRouteMappingInvalidError, "external ports [40000-40099] overlap with tcp mapping")
+					validator.ValidateTcpRouteMappingOverlapsReturns(&err)
+
+					request = handlers.NewTestRequest(tcpMappings)
+					tcpRouteMappingsHandler.Upsert(responseRecorder, request)
+
+					Expect(responseRecorder.Code).To(Equal(http.StatusBadRequest))
+					Expect(responseRecorder.Body.String()).To(ContainSubstring("overlap with tcp mapping"))
+					Expect(database.SaveTcpRouteMappingCallCount()).To(Equal(0))
+				})
+
+				It("responds with a server error when the overlapping mappings cannot be read", func() {
+					database.FindOverlappingTcpRouteMappingsReturns(nil, errors.New("stuff broke"))
+
+					request = handlers.NewTestRequest(tcpMappings)
+					tcpRouteMappingsHandler.Upsert(responseRecorder, request)
+
+					Expect(responseRecorder.Code).To(Equal(http.StatusServiceUnavailable))
+					Expect(database.SaveTcpRouteMappingCallCount()).To(Equal(0))
+				})
+			})
+
+			Context("when the mapping references a tls certificate", func() {
+				var (
+					tcpMapping     models.TcpRouteMapping
+					tlsCertificate models.TlsCertificate
+				)
+
+				BeforeEach(func() {
+					tcpMapping = models.NewTcpRouteMapping("router-group-guid-001", 52000, "1.2.3.4", 60000, 0, "instanceId", nil, nil, 60, models.ModificationTag{}, true, "")
+					tcpMapping.TlsCertificateGuid = "certificate-guid"
+
+					tlsCertificate = models.NewTlsCertificate("app.example.com", "", "")
+					tlsCertificate.Guid = "certificate-guid"
+					database.ReadTlsCertificateReturns(tlsCertificate, nil)
+				})
+
+				It("validates the mapping against the certificate", func() {
+					request = handlers.NewTestRequest([]models.TcpRouteMapping{tcpMapping})
+					tcpRouteMappingsHandler.Upsert(responseRecorder, request)
+
+					Expect(responseRecorder.Code).To(Equal(http.StatusCreated))
+					Expect(database.ReadTlsCertificateArgsForCall(0)).To(Equal("certificate-guid"))
+					Expect(validator.ValidateTcpRouteMappingTlsCertificateCallCount()).To(Equal(1))
+					mapping, certificate := validator.ValidateTcpRouteMappingTlsCertificateArgsForCall(0)
+					Expect(mapping.TlsCertificateGuid).To(Equal("certificate-guid"))
+					Expect(certificate).To(Equal(tlsCertificate))
+				})
+
+				It("returns error without saving when the certificate is not valid for the mapping", func() {
+					err := routing_api.NewError(routing_api.TcpRouteMappingInvalidError, "tls_certificate_guid: certificate-guid not found")
+					validator.ValidateTcpRouteMappingTlsCertificateReturns(&err)
+
+					request = handlers.NewTestRequest([]models.TcpRouteMapping{tcpMapping})
+					tcpRouteMappingsHandler.Upsert(responseRecorder, request)
+
+					Expect(responseRecorder.Code).To(Equal(http.StatusBadRequest))
+					Expect(responseRecorder.Body.String()).To(ContainSubstring("tls_certificate_guid: certificate-guid not found"))
+					Expect(database.SaveTcpRouteMappingCallCount()).To(Equal(0))
+				})
+
+				It("responds with a server error when the certificate cannot be read", func() {
+					database.ReadTlsCertificateReturns(models.TlsCertificate{}, errors.New("stuff broke"))
+
+					request = handlers.NewTestRequest([]models.TcpRouteMapping{tcpMapping})
+					tcpRouteMappingsHandler.Upsert(responseRecorder, request)
+
+					Expect(responseRecorder.Code).To(Equal(http.StatusServiceUnavailable))
+					Expect(database.SaveTcpRouteMappingCallCount()).To(Equal(0))
+				})
+
+				It("does not read certificates for mappings without one", func() {
+					tcpMapping.TlsCertificateGuid = ""
+
+					request = handlers.NewTestRequest([]models.TcpRouteMapping{tcpMapping})
+					tcpRouteMappingsHandler.Upsert(responseRecorder, request)
+
+					Expect(responseRecorder.Code).To(Equal(http.StatusCreated))
+					Expect(database.ReadTlsCertificateCallCount()).To(Equal(0))
+					Expect(validator.ValidateTcpRouteMappingTlsCertificateCallCount()).To(Equal(0))
+				})
+			})
+
+			Context("when the mapping references a backend tls profile", func() {
+				var (
+					tcpMapping        models.TcpRouteMapping
+					backendTlsProfile models.BackendTlsProfile
+				)
+
+				BeforeEach(func() {
+					tcpMapping = models.NewTcpRouteMapping("router-group-guid-001", 52000, "1.2.3.4", 60000, 0, "instanceId", nil, nil, 60, models.ModificationTag{}, false, "")
+					tcpMapping.EnableBackendMTLS = true
+					tcpMapping.BackendTlsProfile = "iso-seg-1"
+
+					backendTlsProfile = models.NewBackendTlsProfile("iso-seg-1", "", "", "", "")
+					backendTlsProfile.Guid = "profile-guid"
+					database.ReadBackendTlsProfileByNameReturns(backendTlsProfile, nil)
+				})
+
+				It("validates the mapping against the profile", func() {
+					request = handlers.NewTestRequest([]models.TcpRouteMapping{tcpMapping})
+					tcpRouteMappingsHandler.Upsert(responseRecorder, request)
+
+					Expect(responseRecorder.Code).To(Equal(http.StatusCreated))
+					Expect(database.ReadBackendTlsProfileByNameArgsForCall(0)).To(Equal("iso-seg-1"))
+					Expect(validator.ValidateTcpRouteMappingBackendTlsProfileCallCount()).To(Equal(1))
+					mapping, profile := validator.ValidateTcpRouteMappingBackendTlsProfileArgsForCall(0)
+					Expect(mapping.BackendTlsProfile).To(Equal("iso-seg-1"))
+					Expect(profile).To(Equal(backendTlsProfile))
+				})
+
+				It("returns error without saving when the profile is not valid for the mapping", func() {
+					err := routing_api.NewError(routing_api.TcpRouteMappingInvalidError, "backend_tls_profile: iso-seg-1 not found")
+					validator.ValidateTcpRouteMappingBackendTlsProfileReturns(&err)
+
+					request = handlers.NewTestRequest([]models.TcpRouteMapping{tcpMapping})
+					tcpRouteMappingsHandler.Upsert(responseRecorder, request)
+
+					Expect(responseRecorder.Code).To(Equal(http.StatusBadRequest))
+					Expect(responseRecorder.Body.String()).To(ContainSubstring("backend_tls_profile: iso-seg-1 not found"))
+					Expect(database.SaveTcpRouteMappingCallCount()).To(Equal(0))
+				})
+
+				It("responds with a server error when the profile cannot be read", func() {
+					database.ReadBackendTlsProfileByNameReturns(models.BackendTlsProfile{}, errors.New("stuff broke"))
+
+					request = handlers.NewTestRequest([]models.TcpRouteMapping{tcpMapping})
+					tcpRouteMappingsHandler.Upsert(responseRecorder, request)
+
+					Expect(responseRecorder.Code).To(Equal(http.StatusServiceUnavailable))
+					Expect(database.SaveTcpRouteMappingCallCount()).To(Equal(0))
+				})
+
+				It("does not read profiles for mappings without one", func() {
+					tcpMapping.BackendTlsProfile = ""
+
+					request = handlers.NewTestRequest([]models.TcpRouteMapping{tcpMapping})
+					tcpRouteMappingsHandler.Upsert(responseRecorder, request)
+
+					Expect(responseRecorder.Code).To(Equal(http.StatusCreated))
+					Expect(database.ReadBackendTlsProfileByNameCallCount()).To(Equal(0))
+					Expect(validator.ValidateTcpRouteMappingBackendTlsProfileCallCount()).To(Equal(0))
+				})
+			})
+
 			Context("when validator returns error", func() {
 				BeforeEach(func() {
 					err := routing_api.NewError(routing_api.TcpRouteMappingInvalidError, "Each tcp mapping requires a valid router group guid")
@@ -614,6 +1030,38 @@ var _ = Describe("TcpRouteMappingsHandler", func() {
 			})
 		})
 
+		Context("when a label selector is given", func() {
+			BeforeEach(func() {
+				mapping1 := models.NewTcpRouteMapping("router-group-guid-001", 52000, "1.2.3.4", 60000, 0, "instanceId", nil, nil, 55, models.ModificationTag{}, false, "")
+				mapping1.Labels = models.LabelSet(`{"env":"prod"}`)
+				mapping2 := models.NewTcpRouteMapping("router-group-guid-001", 52001, "1.2.3.5", 60001, 0, "instanceId", nil, nil, 55, models.ModificationTag{}, false, "")
+				mapping2.Labels = models.LabelSet(`{"env":"staging"}`)
+				database.ReadTcpRouteMappingsReturns([]models.TcpRouteMapping{mapping1, mapping2}, nil)
+			})
+
+			It("returns only the matching tcp route mappings", func() {
+				request = handlers.NewTestRequest("")
+				request.URL.RawQuery = "label_selector=env%3Dprod"
+				tcpRouteMappingsHandler.List(responseRecorder, request)
+
+				Expect(responseRecorder.Code).To(Equal(http.StatusOK))
+				var tcpRoutes []models.TcpRouteMapping
+				Expect(json.Unmarshal(responseRecorder.Body.Bytes(), &tcpRoutes)).To(Succeed())
+				Expect(tcpRoutes).To(HaveLen(1))
+				Expect(tcpRoutes[0].ExternalPort).To(Equal(uint16(52000)))
+			})
+
+			It("returns a 400 when the selector is invalid", func() {
+				request = handlers.NewTestRequest("")
+				request.URL.RawQuery = "label_selector=env+within+(prod)"
+				tcpRouteMappingsHandler.List(responseRecorder, request)
+
+				Expect(responseRecorder.Code).To(Equal(http.StatusBadRequest))
+				Expect(responseRecorder.Body.String()).To(ContainSubstring("ProcessRequestError"))
+				Expect(database.ReadTcpRouteMappingsCallCount()).To(Equal(0))
+			})
+		})
+
 		Context("when db returns empty tcp route mappings", func() {
 			BeforeEach(func() {
 				database.ReadTcpRouteMappingsReturns([]models.TcpRouteMapping{}, nil)
@@ -745,6 +1193,20 @@ var _ = Describe("TcpRouteMappingsHandler", func() {
 						tcpRouteMappingsHandler.Delete(responseRecorder, request)
 
 						Expect(responseRecorder.Code).To(Equal(http.StatusNoContent))
+						Expect(database.DeleteTcpRouteMappingCallCount()).To(Equal(1))
+					})
+
+					It("deletes the mapping by its requested sni hostname when the normalized one is not found", func() {
+						sniHostname := "Foo.Example.COM."
+						tcpMappings[0].SniHostname = &sniHostname
+
+						request = handlers.NewTestRequest(tcpMappings)
+						tcpRouteMappingsHandler.Delete(responseRecorder, request)
+
+						Expect(responseRecorder.Code).To(Equal(http.StatusNoContent))
+						Expect(database.DeleteTcpRouteMappingCallCount()).To(Equal(2))
+						Expect(*database.DeleteTcpRouteMappingArgsForCall(0).SniHostname).To(Equal("foo.example.com"))
+						Expect(*database.DeleteTcpRouteMappingArgsForCall(1).SniHostname).To(Equal("Foo.Example.COM."))
 					})
 				})
 			})
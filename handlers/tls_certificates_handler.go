@@ -0,0 +1,204 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"code.cloudfoundry.org/lager/v3"
+	"code.cloudfoundry.org/routing-api/db"
+	"code.cloudfoundry.org/routing-api/models"
+	"code.cloudfoundry.org/routing-api/uaaclient"
+	"github.com/tedsuo/rata"
+)
+
+type TlsCertificatesHandler struct {
+	uaaClient uaaclient.TokenValidator
+	logger    lager.Logger
+	db        db.DB
+}
+
+func NewTlsCertificatesHandler(uaaClient uaaclient.TokenValidator, logger lager.Logger, database db.DB) *TlsCertificatesHandler {
+	return &TlsCertificatesHandler{
+		uaaClient: uaaClient,
+		logger:    logger,
+		db:        database,
+	}
+}
+
+func (h *TlsCertificatesHandler) List(w http.ResponseWriter, req *http.Request) {
+	log := h.logger.Session("list-tls-certificates")
+
+	err := h.uaaClient.ValidateToken(req.Header.Get("Authorization"), TlsCertificatesReadScope)
+	if err != nil {
+		handleUnauthorizedError(w, err, log)
+		return
+	}
+
+	certificates, err := h.db.ReadTlsCertificates()
+	if err != nil {
+		handleDBCommunicationError(w, err, log)
+		return
+	}
+
+	sniHostname := req.URL.Query().Get("sni_hostname")
+	if sniHostname != "" {
+		sniHostname, err = models.NormalizeHostname(sniHostname)
+		if err != nil {
+			handleProcessRequestError(w, fmt.Errorf("sni_hostname: %s", err), log)
+			return
+		}
+	}
+
+	filtered := []models.TlsCertificate{}
+	for _, certificate := range certificates {
+		if sniHostname != "" && certificate.SniHostname != sniHostname {
+			continue
+		}
+		if certificate.PrivateKey == "" {
+			log.Info("private-key-not-decrypted", lager.Data{"guid": certificate.Guid})
+		}
+		filtered = append(filtered, certificate)
+	}
+
+	writeJSONResponse(w, http.StatusOK, filtered, log)
+}
+
+func (h *TlsCertificatesHandler) Get(w http.ResponseWriter, req *http.Request) {
+	log := h.logger.Session("get-tls-certificate")
+
+	err := h.uaaClient.ValidateToken(req.Header.Get("Authorization"), TlsCertificatesReadScope)
+	if err != nil {
+		handleUnauthorizedError(w, err, log)
+		return
+	}
+
+	guid := rata.Param(req, "guid")
+	certificate, err := h.db.ReadTlsCertificate(guid)
+	if err != nil {
+		handleDBCommunicationError(w, err, log)
+		return
+	}
+	if certificate.Guid == "" {
+		handleNotFoundError(w, fmt.Errorf("tls certificate '%s' not found", guid), log)
+		return
+	}
+
+	writeJSONResponse(w, http.StatusOK, certificate, log)
+}
+
+func (h *TlsCertificatesHandler) Create(w http.ResponseWriter, req *http.Request) {
+	log := h.logger.Session("create-tls-certificate")
+
+	err := h.uaaClient.ValidateToken(req.Header.Get("Authorization"), TlsCertificatesWriteScope)
+	if err != nil {
+		handleUnauthorizedError(w, err, log)
+		return
+	}
+
+	var certificate models.TlsCertificate
+	err = json.NewDecoder(req.Body).Decode(&certificate)
+	if err != nil {
+		handleProcessRequestError(w, err, log)
+		return
+	}
+	certificate.Guid = ""
+
+	log.Info("request", lager.Data{"tls_certificate": certificate.String()})
+
+	h.save(w, certificate, http.StatusCreated, log)
+}
+
+// Update rotates the certificate chain and private key of the tls certificate.
+// Its sni_hostname cannot be changed.
+func (h *TlsCertificatesHandler) Update(w http.ResponseWriter, req *http.Request) {
+	log := h.logger.Session("update-tls-certificate")
+
+	err := h.uaaClient.ValidateToken(req.Header.Get("Authorization"), TlsCertificatesWriteScope)
+	if err != nil {
+		handleUnauthorizedError(w, err, log)
+		return
+	}
+
+	var certificate models.TlsCertificate
+	err = json.NewDecoder(req.Body).Decode(&certificate)
+	if err != nil {
+		handleProcessRequestError(w, err, log)
+		return
+	}
+	certificate.Guid = rata.Param(req, "guid")
+
+	log.Info("request", lager.Data{"tls_certificate": certificate.String()})
+
+	h.save(w, certificate, http.StatusOK, log)
+}
+
+func (h *TlsCertificatesHandler) save(w http.ResponseWriter, certificate models.TlsCertificate, status int, log lager.Logger) {
+	certificate.Normalize()
+	err := certificate.Validate(time.Now())
+	if err != nil {
+		handleProcessRequestError(w, err, log)
+		return
+	}
+
+	leaf, err := certificate.Leaf()
+	if err != nil {
+		handleProcessRequestError(w, err, log)
+		return
+	}
+	certificate.NotAfter = leaf.NotAfter
+
+	certificate, err = h.db.SaveTlsCertificate(certificate)
+	if err != nil {
+		if dberr, ok := err.(db.DBError); ok {
+			switch dberr.Type {
+			case db.UniqueField:
+				handleDBConflictError(w, err, log)
+				return
+			case db.KeyNotFound:
+				handleNotFoundError(w, err, log)
+				return
+			case db.NonUpdatableField:
+				handleProcessRequestError(w, err, log)
+				return
+			}
+		}
+		handleDBCommunicationError(w, err, log)
+		return
+	}
+
+	// create and update do not echo the private key back, only get and list
+	// return it, to clients holding the read scope
+	certificate.PrivateKey = ""
+	writeJSONResponse(w, status, certificate, log)
+}
+
+func (h *TlsCertificatesHandler) Delete(w http.ResponseWriter, req *http.Request) {
+	log := h.logger.Session("delete-tls-certificate")
+
+	err := h.uaaClient.ValidateToken(req.Header.Get("Authorization"), TlsCertificatesWriteScope)
+	if err != nil {
+		handleUnauthorizedError(w, err, log)
+		return
+	}
+
+	guid := rata.Param(req, "guid")
+	err = h.db.DeleteTlsCertificate(guid)
+	if err != nil {
+		if dberr, ok := err.(db.DBError); ok {
+			switch dberr.Type {
+			case db.KeyNotFound:
+				handleNotFoundError(w, fmt.Errorf("tls certificate '%s' not found", guid), log)
+				return
+			case db.InUse:
+				handleDBConflictError(w, err, log)
+				return
+			}
+		}
+		handleDBCommunicationError(w, err, log)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,398 @@
+package handlers_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"code.cloudfoundry.org/lager/v3/lagertest"
+	routing_api "code.cloudfoundry.org/routing-api"
+	"code.cloudfoundry.org/routing-api/db"
+	fake_db "code.cloudfoundry.org/routing-api/db/fakes"
+	"code.cloudfoundry.org/routing-api/handlers"
+	"code.cloudfoundry.org/routing-api/models"
+	"code.cloudfoundry.org/routing-api/test_helpers"
+	fake_client "code.cloudfoundry.org/routing-api/uaaclient/fakes"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/tedsuo/rata"
+)
+
+var _ = Describe("TlsCertificatesHandler", func() {
+	var (
+		tlsCertificatesHandler *handlers.TlsCertificatesHandler
+		handler                http.Handler
+		request                *http.Request
+		responseRecorder       *httptest.ResponseRecorder
+		fakeClient             *fake_client.FakeTokenValidator
+		fakeDb                 *fake_db.FakeDB
+		logger                 *lagertest.TestLogger
+		certChain, privateKey  string
+		notAfter               time.Time
+	)
+
+	BeforeEach(func() {
+		logger = lagertest.NewTestLogger("test-tls-certificates")
+		fakeClient = &fake_client.FakeTokenValidator{}
+		fakeDb = &fake_db.FakeDB{}
+		tlsCertificatesHandler = handlers.NewTlsCertificatesHandler(fakeClient, logger, fakeDb)
+		responseRecorder = httptest.NewRecorder()
+
+		var err error
+		notAfter = time.Now().Add(24 * time.Hour).Truncate(time.Second)
+		certChain, privateKey, err = test_helpers.CreateHostnameCertificate([]string{"app.example.com"}, notAfter)
+		Expect(err).NotTo(HaveOccurred())
+
+		handler, err = rata.NewRouter(
+			rata.Routes{
+				routing_api.RoutesMap[routing_api.CreateTlsCertificate],
+				routing_api.RoutesMap[routing_api.ListTlsCertificates],
+				routing_api.RoutesMap[routing_api.GetTlsCertificate],
+				routing_api.RoutesMap[routing_api.UpdateTlsCertificate],
+				routing_api.RoutesMap[routing_api.DeleteTlsCertificate],
+			},
+			rata.Handlers{
+				routing_api.CreateTlsCertificate: http.HandlerFunc(tlsCertificatesHandler.Create),
+				routing_api.ListTlsCertificates:  http.HandlerFunc(tlsCertificatesHandler.List),
+				routing_api.GetTlsCertificate:    http.HandlerFunc(tlsCertificatesHandler.Get),
+				routing_api.UpdateTlsCertificate: http.HandlerFunc(tlsCertificatesHandler.Update),
+				routing_api.DeleteTlsCertificate: http.HandlerFunc(tlsCertificatesHandler.Delete),
+			},
+		)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	Describe("Create", func() {
+		var certificate models.TlsCertificate
+
+		BeforeEach(func() {
+			certificate = models.NewTlsCertificate("App.Example.com", certChain, privateKey)
+			fakeDb.SaveTlsCertificateStub = func(c models.TlsCertificate) (models.TlsCertificate, error) {
+				c.Guid = "certificate-guid"
+				return c, nil
+			}
+		})
+
+		JustBeforeEach(func() {
+			body, err := json.Marshal(certificate)
+			Expect(err).NotTo(HaveOccurred())
+			request, err = http.NewRequest("POST", "/routing/v1/tls_certificates", bytes.NewReader(body))
+			Expect(err).NotTo(HaveOccurred())
+			handler.ServeHTTP(responseRecorder, request)
+		})
+
+		It("checks for routing.tls_certificates.write scope", func() {
+			_, permission := fakeClient.ValidateTokenArgsForCall(0)
+			Expect(permission).To(ConsistOf(handlers.TlsCertificatesWriteScope))
+		})
+
+		It("saves the normalized certificate with its expiry", func() {
+			Expect(fakeDb.SaveTlsCertificateCallCount()).To(Equal(1))
+			saved := fakeDb.SaveTlsCertificateArgsForCall(0)
+			Expect(saved.Guid).To(BeEmpty())
+			Expect(saved.SniHostname).To(Equal("app.example.com"))
+			Expect(saved.PrivateKey).To(Equal(privateKey))
+			Expect(saved.NotAfter).To(BeTemporally("==", notAfter))
+		})
+
+		It("returns the certificate without its private key", func() {
+			Expect(responseRecorder.Code).To(Equal(http.StatusCreated))
+			var created models.TlsCertificate
+			Expect(json.Unmarshal(responseRecorder.Body.Bytes(), &created)).To(Succeed())
+			Expect(created.Guid).To(Equal("certificate-guid"))
+			Expect(created.SniHostname).To(Equal("app.example.com"))
+			Expect(responseRecorder.Body.String()).NotTo(ContainSubstring("private_key"))
+		})
+
+		Context("when the certificate is not valid for the sni hostname", func() {
+			BeforeEach(func() {
+				certificate.SniHostname = "other.example.com"
+			})
+
+			It("returns a 400 Bad Request", func() {
+				Expect(fakeDb.SaveTlsCertificateCallCount()).To(Equal(0))
+				Expect(responseRecorder.Code).To(Equal(http.StatusBadRequest))
+				Expect(responseRecorder.Body.String()).To(ContainSubstring("cert_chain is not valid for sni_hostname 'other.example.com'"))
+			})
+		})
+
+		Context("when the private key is missing", func() {
+			BeforeEach(func() {
+				certificate.PrivateKey = ""
+			})
+
+			It("returns a 400 Bad Request", func() {
+				Expect(fakeDb.SaveTlsCertificateCallCount()).To(Equal(0))
+				Expect(responseRecorder.Code).To(Equal(http.StatusBadRequest))
+			})
+		})
+
+		Context("when the certificate has expired", func() {
+			BeforeEach(func() {
+				var err error
+				certificate.CertChain, certificate.PrivateKey, err = test_helpers.CreateHostnameCertificate([]string{"app.example.com"}, time.Now().Add(-time.Hour))
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("returns a 400 Bad Request", func() {
+				Expect(fakeDb.SaveTlsCertificateCallCount()).To(Equal(0))
+				Expect(responseRecorder.Code).To(Equal(http.StatusBadRequest))
+				Expect(responseRecorder.Body.String()).To(ContainSubstring("cert_chain expired at"))
+			})
+		})
+
+		Context("when there already is a certificate for the sni hostname", func() {
+			BeforeEach(func() {
+				fakeDb.SaveTlsCertificateStub = nil
+				fakeDb.SaveTlsCertificateReturns(models.TlsCertificate{}, db.DBError{Type: db.UniqueField, Message: "already exists"})
+			})
+
+			It("returns a 409 Conflict", func() {
+				Expect(responseRecorder.Code).To(Equal(http.StatusConflict))
+				Expect(responseRecorder.Body.String()).To(ContainSubstring("DBConflictError"))
+			})
+		})
+
+		Context("when the db fails to save the certificate", func() {
+			BeforeEach(func() {
+				fakeDb.SaveTlsCertificateStub = nil
+				fakeDb.SaveTlsCertificateReturns(models.TlsCertificate{}, errors.New("db communication failed"))
+			})
+
+			It("returns a DB communication error", func() {
+				Expect(responseRecorder.Code).To(Equal(http.StatusServiceUnavailable))
+			})
+		})
+
+		Context("when authorization token is invalid", func() {
+			BeforeEach(func() {
+				fakeClient.ValidateTokenReturns(errors.New("kaboom"))
+			})
+
+			It("returns Unauthorized error", func() {
+				Expect(fakeDb.SaveTlsCertificateCallCount()).To(Equal(0))
+				Expect(responseRecorder.Code).To(Equal(http.StatusUnauthorized))
+			})
+		})
+	})
+
+	Describe("Update", func() {
+		var certificate models.TlsCertificate
+
+		BeforeEach(func() {
+			certificate = models.NewTlsCertificate("app.example.com", certChain, privateKey)
+			fakeDb.SaveTlsCertificateStub = func(c models.TlsCertificate) (models.TlsCertificate, error) {
+				return c, nil
+			}
+		})
+
+		JustBeforeEach(func() {
+			body, err := json.Marshal(certificate)
+			Expect(err).NotTo(HaveOccurred())
+			request, err = http.NewRequest("PUT", "/routing/v1/tls_certificates/certificate-guid", bytes.NewReader(body))
+			Expect(err).NotTo(HaveOccurred())
+			handler.ServeHTTP(responseRecorder, request)
+		})
+
+		It("rotates the certificate with the guid", func() {
+			_, permission := fakeClient.ValidateTokenArgsForCall(0)
+			Expect(permission).To(ConsistOf(handlers.TlsCertificatesWriteScope))
+
+			Expect(fakeDb.SaveTlsCertificateCallCount()).To(Equal(1))
+			saved := fakeDb.SaveTlsCertificateArgsForCall(0)
+			Expect(saved.Guid).To(Equal("certificate-guid"))
+			Expect(saved.CertChain).To(Equal(certChain))
+
+			Expect(responseRecorder.Code).To(Equal(http.StatusOK))
+			Expect(responseRecorder.Body.String()).NotTo(ContainSubstring("private_key"))
+		})
+
+		Context("when the certificate does not exist", func() {
+			BeforeEach(func() {
+				fakeDb.SaveTlsCertificateStub = nil
+				fakeDb.SaveTlsCertificateReturns(models.TlsCertificate{}, db.DBError{Type: db.KeyNotFound, Message: "does not exist"})
+			})
+
+			It("returns a 404 Not Found", func() {
+				Expect(responseRecorder.Code).To(Equal(http.StatusNotFound))
+			})
+		})
+
+		Context("when the sni hostname is changed", func() {
+			BeforeEach(func() {
+				fakeDb.SaveTlsCertificateStub = nil
+				fakeDb.SaveTlsCertificateReturns(models.TlsCertificate{}, db.DBError{Type: db.NonUpdatableField, Message: "cannot be changed"})
+			})
+
+			It("returns a 400 Bad Request", func() {
+				Expect(responseRecorder.Code).To(Equal(http.StatusBadRequest))
+			})
+		})
+	})
+
+	Describe("List", func() {
+		BeforeEach(func() {
+			fakeDb.ReadTlsCertificatesReturns([]models.TlsCertificate{
+				models.NewTlsCertificate("app.example.com", certChain, privateKey),
+				models.NewTlsCertificate("other.example.com", certChain, privateKey),
+			}, nil)
+		})
+
+		It("checks for routing.tls_certificates.read scope", func() {
+			var err error
+			request, err = http.NewRequest("GET", "/routing/v1/tls_certificates", nil)
+			Expect(err).NotTo(HaveOccurred())
+			handler.ServeHTTP(responseRecorder, request)
+
+			_, permission := fakeClient.ValidateTokenArgsForCall(0)
+			Expect(permission).To(ConsistOf(handlers.TlsCertificatesReadScope))
+		})
+
+		It("returns all certificates with their private keys", func() {
+			var err error
+			request, err = http.NewRequest("GET", "/routing/v1/tls_certificates", nil)
+			Expect(err).NotTo(HaveOccurred())
+			handler.ServeHTTP(responseRecorder, request)
+
+			Expect(responseRecorder.Code).To(Equal(http.StatusOK))
+			var certificates []models.TlsCertificate
+			Expect(json.Unmarshal(responseRecorder.Body.Bytes(), &certificates)).To(Succeed())
+			Expect(certificates).To(HaveLen(2))
+			Expect(certificates[0].PrivateKey).To(Equal(privateKey))
+		})
+
+		Context("when a private key could not be decrypted", func() {
+			BeforeEach(func() {
+				undecrypted := models.NewTlsCertificate("other.example.com", certChain, privateKey)
+				undecrypted.PrivateKey = ""
+				fakeDb.ReadTlsCertificatesReturns([]models.TlsCertificate{
+					models.NewTlsCertificate("app.example.com", certChain, privateKey),
+					undecrypted,
+				}, nil)
+			})
+
+			It("still returns every certificate and logs the one without its key", func() {
+				var err error
+				request, err = http.NewRequest("GET", "/routing/v1/tls_certificates", nil)
+				Expect(err).NotTo(HaveOccurred())
+				handler.ServeHTTP(responseRecorder, request)
+
+				Expect(responseRecorder.Code).To(Equal(http.StatusOK))
+				var certificates []models.TlsCertificate
+				Expect(json.Unmarshal(responseRecorder.Body.Bytes(), &certificates)).To(Succeed())
+				Expect(certificates).To(HaveLen(2))
+				Expect(certificates[0].PrivateKey).To(Equal(privateKey))
+				Expect(certificates[1].PrivateKey).To(BeEmpty())
+				Expect(logger.LogMessages()).To(ContainElement(HaveSuffix("private-key-not-decrypted")))
+			})
+		})
+
+		It("filters by the normalized sni hostname", func() {
+			var err error
+			request, err = http.NewRequest("GET", "/routing/v1/tls_certificates?sni_hostname=Other.Example.com", nil)
+			Expect(err).NotTo(HaveOccurred())
+			handler.ServeHTTP(responseRecorder, request)
+
+			Expect(responseRecorder.Code).To(Equal(http.StatusOK))
+			var certificates []models.TlsCertificate
+			Expect(json.Unmarshal(responseRecorder.Body.Bytes(), &certificates)).To(Succeed())
+			Expect(certificates).To(HaveLen(1))
+			Expect(certificates[0].SniHostname).To(Equal("other.example.com"))
+		})
+
+		Context("when the private keys cannot be decrypted", func() {
+			BeforeEach(func() {
+				fakeDb.ReadTlsCertificatesReturns(nil, db.ErrNoEncryptionKey)
+			})
+
+			It("returns a DB communication error", func() {
+				var err error
+				request, err = http.NewRequest("GET", "/routing/v1/tls_certificates", nil)
+				Expect(err).NotTo(HaveOccurred())
+				handler.ServeHTTP(responseRecorder, request)
+
+				Expect(responseRecorder.Code).To(Equal(http.StatusServiceUnavailable))
+			})
+		})
+	})
+
+	Describe("Get", func() {
+		It("returns the certificate", func() {
+			certificate := models.NewTlsCertificate("app.example.com", certChain, privateKey)
+			certificate.Guid = "certificate-guid"
+			fakeDb.ReadTlsCertificateReturns(certificate, nil)
+
+			var err error
+			request, err = http.NewRequest("GET", "/routing/v1/tls_certificates/certificate-guid", nil)
+			Expect(err).NotTo(HaveOccurred())
+			handler.ServeHTTP(responseRecorder, request)
+
+			_, permission := fakeClient.ValidateTokenArgsForCall(0)
+			Expect(permission).To(ConsistOf(handlers.TlsCertificatesReadScope))
+			Expect(fakeDb.ReadTlsCertificateArgsForCall(0)).To(Equal("certificate-guid"))
+			Expect(responseRecorder.Code).To(Equal(http.StatusOK))
+			Expect(responseRecorder.Body.String()).To(ContainSubstring(`"sni_hostname":"app.example.com"`))
+		})
+
+		Context("when the certificate does not exist", func() {
+			It("returns a 404 Not Found", func() {
+				var err error
+				request, err = http.NewRequest("GET", "/routing/v1/tls_certificates/not-exist", nil)
+				Expect(err).NotTo(HaveOccurred())
+				handler.ServeHTTP(responseRecorder, request)
+
+				Expect(responseRecorder.Code).To(Equal(http.StatusNotFound))
+				Expect(responseRecorder.Body.String()).To(ContainSubstring("ResourceNotFoundError"))
+			})
+		})
+	})
+
+	Describe("Delete", func() {
+		It("deletes the certificate", func() {
+			var err error
+			request, err = http.NewRequest("DELETE", "/routing/v1/tls_certificates/certificate-guid", nil)
+			Expect(err).NotTo(HaveOccurred())
+			handler.ServeHTTP(responseRecorder, request)
+
+			_, permission := fakeClient.ValidateTokenArgsForCall(0)
+			Expect(permission).To(ConsistOf(handlers.TlsCertificatesWriteScope))
+			Expect(fakeDb.DeleteTlsCertificateCallCount()).To(Equal(1))
+			Expect(fakeDb.DeleteTlsCertificateArgsForCall(0)).To(Equal("certificate-guid"))
+			Expect(responseRecorder.Code).To(Equal(http.StatusNoContent))
+		})
+
+		Context("when the certificate does not exist", func() {
+			BeforeEach(func() {
+				fakeDb.DeleteTlsCertificateReturns(db.DeleteTlsCertificateError)
+			})
+
+			It("returns a 404 Not Found", func() {
+				var err error
+				request, err = http.NewRequest("DELETE", "/routing/v1/tls_certificates/not-exist", nil)
+				Expect(err).NotTo(HaveOccurred())
+				handler.ServeHTTP(responseRecorder, request)
+
+				Expect(responseRecorder.Code).To(Equal(http.StatusNotFound))
+			})
+		})
+
+		Context("when tcp routes use the certificate", func() {
+			BeforeEach(func() {
+				fakeDb.DeleteTlsCertificateReturns(db.DBError{Type: db.InUse, Message: "Delete Fails: Tls Certificate is used by 1 tcp routes"})
+			})
+
+			It("returns a 409 Conflict", func() {
+				var err error
+				request, err = http.NewRequest("DELETE", "/routing/v1/tls_certificates/certificate-guid", nil)
+				Expect(err).NotTo(HaveOccurred())
+				handler.ServeHTTP(responseRecorder, request)
+
+				Expect(responseRecorder.Code).To(Equal(http.StatusConflict))
+				Expect(responseRecorder.Body.String()).To(ContainSubstring("is used by 1 tcp routes"))
+			})
+		})
+	})
+})
@@ -0,0 +1,156 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"code.cloudfoundry.org/lager/v3"
+	"code.cloudfoundry.org/routing-api/db"
+	"code.cloudfoundry.org/routing-api/models"
+	"code.cloudfoundry.org/routing-api/uaaclient"
+)
+
+type UdpRouteMappingsHandler struct {
+	uaaClient uaaclient.TokenValidator
+	validator RouteValidator
+	db        db.DB
+	logger    lager.Logger
+	maxTTL    int
+}
+
+func NewUdpRouteMappingsHandler(uaaClient uaaclient.TokenValidator, validator RouteValidator, database db.DB, ttl int, logger lager.Logger) *UdpRouteMappingsHandler {
+	return &UdpRouteMappingsHandler{
+		uaaClient: uaaClient,
+		validator: validator,
+		db:        database,
+		logger:    logger,
+		maxTTL:    ttl,
+	}
+}
+
+func (h *UdpRouteMappingsHandler) List(w http.ResponseWriter, req *http.Request) {
+	log := h.logger.Session("list-udp-route-mappings")
+
+	err := h.uaaClient.ValidateToken(req.Header.Get("Authorization"), RoutingRoutesReadScope)
+	if err != nil {
+		handleUnauthorizedError(w, err, log)
+		return
+	}
+	selector, err := labelSelectorFromRequest(req)
+	if err != nil {
+		handleProcessRequestError(w, err, log)
+		return
+	}
+	query := req.URL.Query()
+	var routes []models.UdpRouteMapping
+	if len(query["isolation_segment"]) > 0 {
+		routes, err = h.db.ReadFilteredUdpRouteMappings("isolation_segment", query["isolation_segment"])
+	} else {
+		routes, err = h.db.ReadUdpRouteMappings()
+	}
+	if err != nil {
+		handleDBCommunicationError(w, err, log)
+		return
+	}
+	if len(selector) > 0 {
+		var filtered []models.UdpRouteMapping
+		for _, route := range routes {
+			if selector.Matches(route.Labels) {
+				filtered = append(filtered, route)
+			}
+		}
+		routes = filtered
+	}
+	encoder := json.NewEncoder(w)
+	err = encoder.Encode(routes)
+	if err != nil {
+		handleProcessRequestError(w, err, log)
+	}
+}
+
+func (h *UdpRouteMappingsHandler) Upsert(w http.ResponseWriter, req *http.Request) {
+	log := h.logger.Session("create-udp-route-mappings")
+
+	err := h.uaaClient.ValidateToken(req.Header.Get("Authorization"), RoutingRoutesWriteScope)
+	if err != nil {
+		handleUnauthorizedError(w, err, log)
+		return
+	}
+
+	decoder := json.NewDecoder(req.Body)
+	var udpMappings []models.UdpRouteMapping
+	err = decoder.Decode(&udpMappings)
+	if err != nil {
+		handleProcessRequestError(w, err, log)
+		return
+	}
+
+	routerGroups, err := h.db.ReadRouterGroups()
+	if err != nil {
+		handleDBCommunicationError(w, err, log)
+		return
+	}
+
+	for i := 0; i < len(udpMappings); i++ {
+		policy := udpRouteMappingTTLPolicy(udpMappings[i], routerGroups, h.maxTTL)
+		udpMappings[i].SetDefaults(policy.Default)
+	}
+
+	log.Info("request", lager.Data{"udp_mapping_creation": udpMappings})
+
+	for _, udpMapping := range udpMappings {
+		apiErr := h.validator.ValidateCreateUdpRouteMapping(udpMapping, routerGroups, h.maxTTL)
+		if apiErr != nil {
+			handleProcessRequestError(w, apiErr, log)
+			return
+		}
+	}
+
+	for _, udpMapping := range udpMappings {
+		err = h.db.SaveUdpRouteMapping(udpMapping)
+		if err != nil {
+			handleDBCommunicationError(w, err, log)
+			return
+		}
+	}
+
+	w.WriteHeader(http.StatusCreated)
+}
+
+func (h *UdpRouteMappingsHandler) Delete(w http.ResponseWriter, req *http.Request) {
+	log := h.logger.Session("delete-udp-route-mappings")
+
+	err := h.uaaClient.ValidateToken(req.Header.Get("Authorization"), RoutingRoutesWriteScope)
+	if err != nil {
+		handleUnauthorizedError(w, err, log)
+		return
+	}
+
+	decoder := json.NewDecoder(req.Body)
+	var udpMappings []models.UdpRouteMapping
+	err = decoder.Decode(&udpMappings)
+	if err != nil {
+		handleProcessRequestError(w, err, log)
+		return
+	}
+
+	log.Info("request", lager.Data{"udp_mapping_deletion": udpMappings})
+
+	apiErr := h.validator.ValidateDeleteUdpRouteMapping(udpMappings)
+	if apiErr != nil {
+		handleProcessRequestError(w, apiErr, log)
+		return
+	}
+
+	for _, udpMapping := range udpMappings {
+		err = h.db.DeleteUdpRouteMapping(udpMapping)
+		if err != nil {
+			if dberr, ok := err.(db.DBError); !ok || dberr.Type != db.KeyNotFound {
+				handleDBCommunicationError(w, err, log)
+				return
+			}
+		}
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,389 @@
+package handlers_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+
+	"code.cloudfoundry.org/lager/v3/lagertest"
+	routing_api "code.cloudfoundry.org/routing-api"
+	"code.cloudfoundry.org/routing-api/db"
+	fake_db "code.cloudfoundry.org/routing-api/db/fakes"
+	fake_validator "code.cloudfoundry.org/routing-api/handlers/fakes"
+	"code.cloudfoundry.org/routing-api/metrics"
+	"code.cloudfoundry.org/routing-api/models"
+	fake_client "code.cloudfoundry.org/routing-api/uaaclient/fakes"
+
+	"code.cloudfoundry.org/routing-api/handlers"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("UdpRouteMappingsHandler", func() {
+	var (
+		udpRouteMappingsHandler *handlers.UdpRouteMappingsHandler
+		request                 *http.Request
+		responseRecorder        *httptest.ResponseRecorder
+		validator               *fake_validator.FakeRouteValidator
+		database                *fake_db.FakeDB
+		logger                  *lagertest.TestLogger
+		fakeClient              *fake_client.FakeTokenValidator
+		maxTTL                  int
+	)
+
+	BeforeEach(func() {
+		database = &fake_db.FakeDB{}
+		fakeClient = &fake_client.FakeTokenValidator{}
+		validator = &fake_validator.FakeRouteValidator{}
+		logger = lagertest.NewTestLogger("routing-api-test")
+		maxTTL = 120
+		udpRouteMappingsHandler = handlers.NewUdpRouteMappingsHandler(fakeClient, validator, database, maxTTL, logger)
+		responseRecorder = httptest.NewRecorder()
+	})
+
+	Describe("Upsert", func() {
+		var udpMappings []models.UdpRouteMapping
+
+		BeforeEach(func() {
+			udpMapping := models.NewUdpRouteMapping("router-group-guid-001", 5300, "1.2.3.4", 53, "instanceId", 60, models.ModificationTag{})
+			udpMappings = []models.UdpRouteMapping{udpMapping}
+		})
+
+		It("checks for routing.routes.write scope", func() {
+			request = handlers.NewTestRequest(udpMappings)
+
+			udpRouteMappingsHandler.Upsert(responseRecorder, request)
+			Expect(responseRecorder.Code).To(Equal(http.StatusCreated))
+
+			_, permission := fakeClient.ValidateTokenArgsForCall(0)
+			Expect(permission).To(ConsistOf(handlers.RoutingRoutesWriteScope))
+		})
+
+		It("validates and saves each mapping", func() {
+			udpMappings = append(udpMappings, udpMappings[0])
+			udpMappings[1].HostIP = "5.4.3.2"
+
+			request = handlers.NewTestRequest(udpMappings)
+			udpRouteMappingsHandler.Upsert(responseRecorder, request)
+
+			Expect(responseRecorder.Code).To(Equal(http.StatusCreated))
+			Expect(validator.ValidateCreateUdpRouteMappingCallCount()).To(Equal(2))
+			Expect(database.SaveUdpRouteMappingCallCount()).To(Equal(2))
+			Expect(database.SaveUdpRouteMappingArgsForCall(0)).To(Equal(udpMappings[0]))
+			Expect(database.SaveUdpRouteMappingArgsForCall(1)).To(Equal(udpMappings[1]))
+		})
+
+		It("logs the route declaration", func() {
+			request = handlers.NewTestRequest(udpMappings)
+			udpRouteMappingsHandler.Upsert(responseRecorder, request)
+
+			data := map[string]interface{}{
+				"port":              float64(5300),
+				"router_group_guid": "router-group-guid-001",
+				"backend_ip":        "1.2.3.4",
+				"backend_port":      float64(53),
+				"instance_id":       "instanceId",
+				"modification_tag":  map[string]interface{}{"guid": "", "index": float64(0)},
+				"ttl":               float64(60),
+				"isolation_segment": "",
+			}
+			Expect(logger.Logs()[0].Message).To(ContainSubstring("request"))
+			Expect(logger.Logs()[0].Data["udp_mapping_creation"]).To(Equal([]interface{}{data}))
+		})
+
+		Context("when ttl is not present", func() {
+			BeforeEach(func() {
+				udpMappings[0].TTL = nil
+			})
+
+			It("sets the max ttl as default", func() {
+				request = handlers.NewTestRequest(udpMappings)
+				udpRouteMappingsHandler.Upsert(responseRecorder, request)
+
+				Expect(responseRecorder.Code).To(Equal(http.StatusCreated))
+				Expect(*database.SaveUdpRouteMappingArgsForCall(0).TTL).To(Equal(maxTTL))
+			})
+
+			It("sets the default ttl of the router group", func() {
+				database.ReadRouterGroupsReturns(models.RouterGroups{
+					{Guid: "router-group-guid-001", Name: "default-udp", Type: models.RouterGroup_UDP, ReservablePorts: "1024-65535", DefaultTTL: 30},
+				}, nil)
+				request = handlers.NewTestRequest(udpMappings)
+				udpRouteMappingsHandler.Upsert(responseRecorder, request)
+
+				Expect(responseRecorder.Code).To(Equal(http.StatusCreated))
+				Expect(*database.SaveUdpRouteMappingArgsForCall(0).TTL).To(Equal(30))
+			})
+		})
+
+		Context("when the router groups cannot be read", func() {
+			BeforeEach(func() {
+				database.ReadRouterGroupsReturns(nil, errors.New("stuff broke"))
+			})
+
+			It("responds with a server error", func() {
+				request = handlers.NewTestRequest(udpMappings)
+				udpRouteMappingsHandler.Upsert(responseRecorder, request)
+
+				Expect(responseRecorder.Code).To(Equal(http.StatusServiceUnavailable))
+				Expect(database.SaveUdpRouteMappingCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when database fails to save", func() {
+			BeforeEach(func() {
+				database.SaveUdpRouteMappingReturns(errors.New("stuff broke"))
+			})
+
+			It("responds with a server error", func() {
+				request = handlers.NewTestRequest(udpMappings)
+				udpRouteMappingsHandler.Upsert(responseRecorder, request)
+
+				Expect(responseRecorder.Code).To(Equal(http.StatusServiceUnavailable))
+				Expect(responseRecorder.Body.String()).To(ContainSubstring("stuff broke"))
+			})
+		})
+
+		Context("when the body cannot be decoded", func() {
+			It("blows up when a host port does not fit into a uint16", func() {
+				request = handlers.NewTestRequest(`[{"router_group_guid": "udp-default", "port": 5300, "backend_ip": "10.1.1.12", "backend_port": 65537}]`)
+				udpRouteMappingsHandler.Upsert(responseRecorder, request)
+
+				Expect(responseRecorder.Code).To(Equal(http.StatusBadRequest))
+				Expect(responseRecorder.Body.String()).To(ContainSubstring("cannot unmarshal number 65537"))
+				Expect(database.SaveUdpRouteMappingCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when validator returns error", func() {
+			BeforeEach(func() {
+				err := routing_api.NewError(routing_api.UdpRouteMappingInvalidError, "router_group_guid: router-group-guid-001 is not a udp router group")
+				validator.ValidateCreateUdpRouteMappingReturns(&err)
+			})
+
+			It("returns error without saving any mapping", func() {
+				request = handlers.NewTestRequest(udpMappings)
+				udpRouteMappingsHandler.Upsert(responseRecorder, request)
+
+				Expect(responseRecorder.Code).To(Equal(http.StatusBadRequest))
+				Expect(responseRecorder.Body.String()).To(ContainSubstring("is not a udp router group"))
+				Expect(database.SaveUdpRouteMappingCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the UAA token is not valid", func() {
+			var currentCount int64
+			BeforeEach(func() {
+				currentCount = metrics.GetTokenErrors()
+				fakeClient.ValidateTokenReturns(errors.New("Not valid"))
+			})
+
+			It("returns an Unauthorized status code", func() {
+				request = handlers.NewTestRequest(udpMappings)
+				udpRouteMappingsHandler.Upsert(responseRecorder, request)
+
+				Expect(responseRecorder.Code).To(Equal(http.StatusUnauthorized))
+				Expect(metrics.GetTokenErrors()).To(Equal(currentCount + 1))
+			})
+		})
+	})
+
+	Describe("List", func() {
+		var udpRoutes []models.UdpRouteMapping
+
+		BeforeEach(func() {
+			mapping1 := models.NewUdpRouteMapping("router-group-guid-001", 5300, "1.2.3.4", 53, "instanceId", 55, models.ModificationTag{})
+			mapping2 := models.NewUdpRouteMapping("router-group-guid-001", 5514, "1.2.3.5", 514, "instanceId", 55, models.ModificationTag{})
+			mapping2.IsolationSegment = "is1"
+			labels, err := models.NewLabelSet(map[string]string{"app": "syslog"})
+			Expect(err).NotTo(HaveOccurred())
+			mapping2.Labels = labels
+			udpRoutes = []models.UdpRouteMapping{mapping1, mapping2}
+			database.ReadUdpRouteMappingsReturns(udpRoutes, nil)
+		})
+
+		It("checks for routing.routes.read scope", func() {
+			request = handlers.NewTestRequest("")
+
+			udpRouteMappingsHandler.List(responseRecorder, request)
+			Expect(responseRecorder.Code).To(Equal(http.StatusOK))
+
+			_, permission := fakeClient.ValidateTokenArgsForCall(0)
+			Expect(permission).To(ConsistOf(handlers.RoutingRoutesReadScope))
+		})
+
+		It("returns udp route mappings", func() {
+			request = handlers.NewTestRequest("")
+			udpRouteMappingsHandler.List(responseRecorder, request)
+
+			Expect(database.ReadUdpRouteMappingsCallCount()).To(Equal(1))
+			Expect(responseRecorder.Code).To(Equal(http.StatusOK))
+			Expect(responseRecorder.Body.String()).To(MatchJSON(`[
+				{
+					"router_group_guid": "router-group-guid-001",
+					"port": 5300,
+					"backend_ip": "1.2.3.4",
+					"backend_port": 53,
+					"instance_id": "instanceId",
+					"modification_tag": {"guid": "", "index": 0},
+					"ttl": 55,
+					"isolation_segment": ""
+				},
+				{
+					"router_group_guid": "router-group-guid-001",
+					"port": 5514,
+					"backend_ip": "1.2.3.5",
+					"backend_port": 514,
+					"instance_id": "instanceId",
+					"modification_tag": {"guid": "", "index": 0},
+					"ttl": 55,
+					"isolation_segment": "is1",
+					"labels": {"app": "syslog"}
+				}]`))
+		})
+
+		It("filters by isolation segments", func() {
+			database.ReadFilteredUdpRouteMappingsReturns(udpRoutes[1:], nil)
+			request = handlers.NewTestRequest("")
+			q := request.URL.Query()
+			q.Add("isolation_segment", "is1")
+			request.URL.RawQuery = q.Encode()
+			udpRouteMappingsHandler.List(responseRecorder, request)
+
+			Expect(responseRecorder.Code).To(Equal(http.StatusOK))
+			Expect(database.ReadUdpRouteMappingsCallCount()).To(Equal(0))
+			columnName, values := database.ReadFilteredUdpRouteMappingsArgsForCall(0)
+			Expect(columnName).To(Equal("isolation_segment"))
+			Expect(values).To(ConsistOf("is1"))
+		})
+
+		It("filters by label selector", func() {
+			request = handlers.NewTestRequest("")
+			q := request.URL.Query()
+			q.Add("label_selector", "app=syslog")
+			request.URL.RawQuery = q.Encode()
+			udpRouteMappingsHandler.List(responseRecorder, request)
+
+			Expect(responseRecorder.Code).To(Equal(http.StatusOK))
+			Expect(responseRecorder.Body.String()).To(ContainSubstring(`"port":5514`))
+			Expect(responseRecorder.Body.String()).NotTo(ContainSubstring(`"port":5300`))
+		})
+
+		Context("when db returns error", func() {
+			BeforeEach(func() {
+				database.ReadUdpRouteMappingsReturns(nil, errors.New("something bad"))
+			})
+
+			It("returns internal server error", func() {
+				request = handlers.NewTestRequest("")
+				udpRouteMappingsHandler.List(responseRecorder, request)
+
+				Expect(responseRecorder.Code).To(Equal(http.StatusServiceUnavailable))
+			})
+		})
+
+		Context("when the UAA token is not valid", func() {
+			BeforeEach(func() {
+				fakeClient.ValidateTokenReturns(errors.New("Not valid"))
+			})
+
+			It("returns an Unauthorized status code", func() {
+				request = handlers.NewTestRequest("")
+				udpRouteMappingsHandler.List(responseRecorder, request)
+
+				Expect(responseRecorder.Code).To(Equal(http.StatusUnauthorized))
+				Expect(database.ReadUdpRouteMappingsCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	Describe("Delete", func() {
+		var udpMappings []models.UdpRouteMapping
+
+		BeforeEach(func() {
+			udpMapping := models.NewUdpRouteMapping("router-group-guid-002", 5300, "1.2.3.4", 53, "instanceId", 60, models.ModificationTag{})
+			udpMappings = []models.UdpRouteMapping{udpMapping}
+		})
+
+		It("checks for routing.routes.write scope", func() {
+			request = handlers.NewTestRequest(udpMappings)
+
+			udpRouteMappingsHandler.Delete(responseRecorder, request)
+			Expect(responseRecorder.Code).To(Equal(http.StatusNoContent))
+
+			_, permission := fakeClient.ValidateTokenArgsForCall(0)
+			Expect(permission).To(ConsistOf(handlers.RoutingRoutesWriteScope))
+		})
+
+		It("deletes each mapping", func() {
+			udpMappings = append(udpMappings, udpMappings[0])
+			udpMappings[1].HostIP = "5.4.3.2"
+
+			request = handlers.NewTestRequest(udpMappings)
+			udpRouteMappingsHandler.Delete(responseRecorder, request)
+
+			Expect(responseRecorder.Code).To(Equal(http.StatusNoContent))
+			Expect(database.DeleteUdpRouteMappingCallCount()).To(Equal(2))
+			Expect(database.DeleteUdpRouteMappingArgsForCall(0)).To(Equal(udpMappings[0]))
+			Expect(database.DeleteUdpRouteMappingArgsForCall(1)).To(Equal(udpMappings[1]))
+		})
+
+		Context("when database fails to delete", func() {
+			BeforeEach(func() {
+				database.DeleteUdpRouteMappingReturns(errors.New("stuff broke"))
+			})
+
+			It("responds with a server error", func() {
+				request = handlers.NewTestRequest(udpMappings)
+				udpRouteMappingsHandler.Delete(responseRecorder, request)
+
+				Expect(responseRecorder.Code).To(Equal(http.StatusServiceUnavailable))
+				Expect(responseRecorder.Body.String()).To(ContainSubstring("stuff broke"))
+			})
+		})
+
+		Context("when route to be deleted is not present", func() {
+			BeforeEach(func() {
+				database.DeleteUdpRouteMappingReturns(db.DBError{Type: db.KeyNotFound, Message: "The specified key is not found"})
+			})
+
+			It("doesn't fail", func() {
+				request = handlers.NewTestRequest(udpMappings)
+				udpRouteMappingsHandler.Delete(responseRecorder, request)
+
+				Expect(responseRecorder.Code).To(Equal(http.StatusNoContent))
+			})
+		})
+
+		Context("when validator returns error", func() {
+			BeforeEach(func() {
+				err := routing_api.NewError(routing_api.UdpRouteMappingInvalidError, "Each udp mapping requires a non empty router group guid")
+				validator.ValidateDeleteUdpRouteMappingReturns(&err)
+			})
+
+			It("returns error", func() {
+				request = handlers.NewTestRequest(udpMappings)
+				udpRouteMappingsHandler.Delete(responseRecorder, request)
+
+				Expect(responseRecorder.Code).To(Equal(http.StatusBadRequest))
+				Expect(responseRecorder.Body.String()).To(ContainSubstring("Each udp mapping requires a non empty router group guid"))
+				Expect(database.DeleteUdpRouteMappingCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the UAA token is not valid", func() {
+			BeforeEach(func() {
+				fakeClient.ValidateTokenReturns(errors.New("Not valid"))
+			})
+
+			It("returns an Unauthorized status code", func() {
+				request = handlers.NewTestRequest(udpMappings)
+				udpRouteMappingsHandler.Delete(responseRecorder, request)
+
+				Expect(responseRecorder.Code).To(Equal(http.StatusUnauthorized))
+				Expect(database.DeleteUdpRouteMappingCallCount()).To(Equal(0))
+			})
+		})
+	})
+})
@@ -6,6 +6,7 @@ import (
 	"net/url"
 	"strconv"
 	"strings"
+	"time"
 
 	routing_api "code.cloudfoundry.org/routing-api"
 	"code.cloudfoundry.org/routing-api/models"
@@ -13,29 +14,58 @@ import (
 
 //go:generate counterfeiter -o fakes/fake_validator.go . RouteValidator
 type RouteValidator interface {
-	ValidateCreate(routes []models.Route, maxTTL int) *routing_api.Error
+	ValidateCreate(routes []models.Route, routerGroups models.RouterGroups, maxTTL int) *routing_api.Error
 	ValidateDelete(routes []models.Route) *routing_api.Error
 
 	ValidateCreateTcpRouteMappings(tcpRouteMappings []models.TcpRouteMapping, routerGroups models.RouterGroups, maxTTL int) *routing_api.Error
 	ValidateCreateTcpRouteMapping(tcpRouteMapping models.TcpRouteMapping, similarTcpRouteMappings []models.TcpRouteMapping, routerGroups models.RouterGroups, maxTTL int) *routing_api.Error
 	ValidateDeleteTcpRouteMapping(tcpRouteMappings []models.TcpRouteMapping) *routing_api.Error
+	ValidateTcpRouteMappingOverlaps(tcpRouteMapping models.TcpRouteMapping, overlappingTcpRouteMappings []models.TcpRouteMapping) *routing_api.Error
+	ValidateTcpRouteMappingTlsCertificate(tcpRouteMapping models.TcpRouteMapping, tlsCertificate models.TlsCertificate) *routing_api.Error
+	ValidateTcpRouteMappingBackendTlsProfile(tcpRouteMapping models.TcpRouteMapping, backendTlsProfile models.BackendTlsProfile) *routing_api.Error
+
+	ValidateCreateUdpRouteMapping(udpRouteMapping models.UdpRouteMapping, routerGroups models.RouterGroups, maxTTL int) *routing_api.Error
+	ValidateDeleteUdpRouteMapping(udpRouteMappings []models.UdpRouteMapping) *routing_api.Error
 }
 
-type Validator struct{}
+type Validator struct {
+	portPolicy            models.PortPolicy
+	connectionLimitPolicy models.ConnectionLimitPolicy
+	customALPNs           []string
+	backendNetworkPolicy  models.BackendNetworkPolicy
+}
 
-func NewValidator() Validator {
-	return Validator{}
+// NewValidator returns a validator that accepts the custom ALPN protocol ids
+// in addition to those registered with IANA, and backend ips allowed by the
+// backend network policy.
+func NewValidator(portPolicy models.PortPolicy, connectionLimitPolicy models.ConnectionLimitPolicy, customALPNs []string, backendNetworkPolicy models.BackendNetworkPolicy) Validator {
+	return Validator{portPolicy: portPolicy, connectionLimitPolicy: connectionLimitPolicy, customALPNs: customALPNs, backendNetworkPolicy: backendNetworkPolicy}
 }
 
-func (v Validator) ValidateCreate(routes []models.Route, maxTTL int) *routing_api.Error {
+func (v Validator) ValidateCreate(routes []models.Route, routerGroups models.RouterGroups, maxTTL int) *routing_api.Error {
 	for _, route := range routes {
 		err := requiredValidation(route)
 		if err != nil {
 			return err
 		}
 
-		if *route.TTL > maxTTL {
-			err := routing_api.NewError(routing_api.RouteInvalidError, fmt.Sprintf("Max ttl is %d", maxTTL))
+		err = validateRouteHostname(route.Route)
+		if err != nil {
+			return err
+		}
+
+		err = validateRouteIP(route.IP)
+		if err != nil {
+			return err
+		}
+
+		policy, err := routeTTLPolicy(route, routerGroups, maxTTL)
+		if err != nil {
+			return err
+		}
+
+		if *route.TTL > policy.Max {
+			err := routing_api.NewError(routing_api.RouteInvalidError, fmt.Sprintf("Max ttl is %d", policy.Max))
 			return &err
 		}
 
@@ -43,6 +73,52 @@ func (v Validator) ValidateCreate(routes []models.Route, maxTTL int) *routing_ap
 			err := routing_api.NewError(routing_api.RouteInvalidError, "Request requires a ttl greater than 0")
 			return &err
 		}
+
+		if *route.TTL < policy.Min {
+			err := routing_api.NewError(routing_api.RouteInvalidError, fmt.Sprintf("Min ttl is %d", policy.Min))
+			return &err
+		}
+
+		err = validateBackendTLS(route)
+		if err != nil {
+			return err
+		}
+
+		err = validateProtocol(route.Protocol)
+		if err != nil {
+			return err
+		}
+
+		if labelErr := route.Labels.Validate(); labelErr != nil {
+			err := routing_api.NewError(routing_api.RouteInvalidError, labelErr.Error())
+			return &err
+		}
+
+		err = v.validateRouteBackendNetwork(route, routerGroups)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validateRouteBackendNetwork returns an error when the backend ip of the
+// route is not allowed by the backend network policy of its router group, or
+// by the global policy when the route has no router group.
+func (v Validator) validateRouteBackendNetwork(route models.Route, routerGroups models.RouterGroups) *routing_api.Error {
+	policy := v.backendNetworkPolicy
+	if routerGroup, ok := routerGroups.FindByGuid(route.RouterGroupGuid); ok {
+		routerGroupPolicy, policyErr := policy.ForRouterGroup(routerGroup)
+		if policyErr != nil {
+			err := routing_api.NewError(routing_api.RouteInvalidError, policyErr.Error())
+			return &err
+		}
+		policy = routerGroupPolicy
+	}
+
+	if networkErr := policy.Validate(route.IP); networkErr != nil {
+		err := routing_api.NewError(routing_api.RouteInvalidError, networkErr.Error())
+		return &err
 	}
 	return nil
 }
@@ -57,6 +133,51 @@ func (v Validator) ValidateDelete(routes []models.Route) *routing_api.Error {
 	return nil
 }
 
+// routeTTLPolicy returns the TTL policy of the http router group of the route,
+// or the global policy when the route has no router group. The global policy
+// is also returned with the error for an unknown router group, so that
+// defaults can still be set before validation.
+func routeTTLPolicy(route models.Route, routerGroups models.RouterGroups, maxTTL int) (models.TTLPolicy, *routing_api.Error) {
+	if route.RouterGroupGuid == "" {
+		return models.DefaultTTLPolicy(maxTTL), nil
+	}
+
+	routerGroup, ok := routerGroups.FindByGuid(route.RouterGroupGuid)
+	if !ok {
+		err := routing_api.NewError(routing_api.RouteInvalidError,
+			"router_group_guid: "+route.RouterGroupGuid+" not found")
+		return models.DefaultTTLPolicy(maxTTL), &err
+	}
+
+	if routerGroup.Type != models.RouterGroup_HTTP {
+		err := routing_api.NewError(routing_api.RouteInvalidError,
+			"router_group_guid: "+route.RouterGroupGuid+" is not an http router group")
+		return models.DefaultTTLPolicy(maxTTL), &err
+	}
+
+	return routerGroup.TTLPolicy(maxTTL), nil
+}
+
+// tcpRouteMappingTTLPolicy returns the TTL policy of the router group of the
+// mapping, or the global policy when the router group is unknown.
+func tcpRouteMappingTTLPolicy(tcpRouteMapping models.TcpRouteMapping, routerGroups models.RouterGroups, maxTTL int) models.TTLPolicy {
+	routerGroup, ok := routerGroups.FindByGuid(tcpRouteMapping.RouterGroupGuid)
+	if !ok {
+		return models.DefaultTTLPolicy(maxTTL)
+	}
+	return routerGroup.TTLPolicy(maxTTL)
+}
+
+// udpRouteMappingTTLPolicy returns the TTL policy of the router group of the
+// mapping, or the global policy when the router group is unknown.
+func udpRouteMappingTTLPolicy(udpRouteMapping models.UdpRouteMapping, routerGroups models.RouterGroups, maxTTL int) models.TTLPolicy {
+	routerGroup, ok := routerGroups.FindByGuid(udpRouteMapping.RouterGroupGuid)
+	if !ok {
+		return models.DefaultTTLPolicy(maxTTL)
+	}
+	return routerGroup.TTLPolicy(maxTTL)
+}
+
 func requiredValidation(route models.Route) *routing_api.Error {
 	err := validateRouteUrl(route.Route)
 	if err != nil {
@@ -86,6 +207,47 @@ func requiredValidation(route models.Route) *routing_api.Error {
 	return nil
 }
 
+// validateRouteIP returns an error when the backend ip of the route is not an
+// IPv4 or IPv6 address. It is only checked on create, so that routes stored
+// before backend ips were validated can still be deleted.
+func validateRouteIP(ip string) *routing_api.Error {
+	if _, ipErr := models.CanonicalIP(ip); ipErr != nil {
+		err := routing_api.NewError(routing_api.RouteInvalidError, ipErr.Error())
+		return &err
+	}
+	return nil
+}
+
+func validateBackendTLS(route models.Route) *routing_api.Error {
+	if route.TLSPort < 0 || route.TLSPort > 65535 {
+		err := routing_api.NewError(routing_api.RouteInvalidError, "Each route request with a tls_port requires that port to be between 1 and 65535")
+		return &err
+	}
+
+	if route.TLSPort > 0 && route.ServerCertDomainSAN == "" {
+		err := routing_api.NewError(routing_api.RouteInvalidError, "Each route request with a tls_port requires a server_cert_domain_san")
+		return &err
+	}
+
+	if route.TLSPort == 0 && route.ServerCertDomainSAN != "" {
+		err := routing_api.NewError(routing_api.RouteInvalidError, "Each route request can define server_cert_domain_san only when tls_port is set")
+		return &err
+	}
+
+	return nil
+}
+
+func validateProtocol(protocol string) *routing_api.Error {
+	switch protocol {
+	case "", models.RouteProtocolHTTP1, models.RouteProtocolHTTP2:
+		return nil
+	}
+
+	err := routing_api.NewError(routing_api.RouteInvalidError,
+		fmt.Sprintf("protocol: %s not allowed, must be one of [%s, %s]", protocol, models.RouteProtocolHTTP1, models.RouteProtocolHTTP2))
+	return &err
+}
+
 func validateRouteUrl(route string) *routing_api.Error {
 	err := validateUrl(route)
 	if err != nil {
@@ -96,6 +258,18 @@ func validateRouteUrl(route string) *routing_api.Error {
 	return nil
 }
 
+// validateRouteHostname returns an error when the hostname of the route is not
+// a valid DNS name. It is only checked on create, so that routes stored before
+// hostnames were validated can still be deleted.
+func validateRouteHostname(route string) *routing_api.Error {
+	_, err := models.NormalizeRouteURL(route)
+	if err != nil {
+		err := routing_api.NewError(routing_api.RouteInvalidError, err.Error())
+		return &err
+	}
+	return nil
+}
+
 func validateRouteServiceUrl(routeService string) *routing_api.Error {
 	if routeService == "" {
 		return nil
@@ -147,25 +321,62 @@ func (v Validator) ValidateCreateTcpRouteMappings(tcpRouteMappings []models.TcpR
 }
 
 func (v Validator) ValidateCreateTcpRouteMapping(tcpRouteMapping models.TcpRouteMapping, similarTcpRouteMappings []models.TcpRouteMapping, routerGroups models.RouterGroups, maxTTL int) *routing_api.Error {
-	err := validateTcpRouteMapping(tcpRouteMapping, true, maxTTL)
+	policy := tcpRouteMappingTTLPolicy(tcpRouteMapping, routerGroups, maxTTL)
+	err := validateTcpRouteMapping(tcpRouteMapping, true, policy)
 	if err != nil {
 		return err
 	}
 
-	validGuid := false
-	for _, routerGroup := range routerGroups {
-		if tcpRouteMapping.RouterGroupGuid == routerGroup.Guid {
-			validGuid = true
-			break
-		}
+	if limitErr := v.connectionLimitPolicy.Validate(tcpRouteMapping); limitErr != nil {
+		err := routing_api.NewError(routing_api.TcpRouteMappingInvalidError,
+			limitErr.Error()+". RouteMapping=["+tcpRouteMapping.String()+"]")
+		return &err
 	}
 
-	if !validGuid {
+	if alpnErr := tcpRouteMapping.ALPNs.Validate(v.customALPNs); alpnErr != nil {
+		err := routing_api.NewError(routing_api.TcpRouteMappingInvalidError,
+			alpnErr.Error()+". RouteMapping=["+tcpRouteMapping.String()+"]")
+		return &err
+	}
+
+	routerGroup, ok := routerGroups.FindByGuid(tcpRouteMapping.RouterGroupGuid)
+	if !ok {
 		err := routing_api.NewError(routing_api.TcpRouteMappingInvalidError,
 			"router_group_guid: "+tcpRouteMapping.RouterGroupGuid+" not found")
 		return &err
 	}
 
+	if routerGroup.Type != models.RouterGroup_TCP {
+		err := routing_api.NewError(routing_api.TcpRouteMappingInvalidError,
+			"router_group_guid: "+tcpRouteMapping.RouterGroupGuid+" is not a tcp router group")
+		return &err
+	}
+
+	networkPolicy, networkErr := v.backendNetworkPolicy.ForRouterGroup(routerGroup)
+	if networkErr == nil {
+		networkErr = networkPolicy.Validate(tcpRouteMapping.HostIP)
+	}
+	if networkErr != nil {
+		err := routing_api.NewError(routing_api.TcpRouteMappingInvalidError,
+			networkErr.Error()+". RouteMapping=["+tcpRouteMapping.String()+"]")
+		return &err
+	}
+
+	// an external port of 0 asks for a port to be allocated from the router group
+	if tcpRouteMapping.ExternalPort == 0 && routerGroup.ReservablePorts == "" {
+		err := routing_api.NewError(routing_api.TcpRouteMappingInvalidError,
+			"router_group_guid: "+tcpRouteMapping.RouterGroupGuid+" has no reservable ports to allocate an external port from")
+		return &err
+	}
+
+	if tcpRouteMapping.ExternalPort != 0 && !externalPortInUse(tcpRouteMapping, similarTcpRouteMappings) {
+		if portErr := routerGroup.ValidateExternalPortRange(tcpRouteMapping.ExternalPortRange(), v.portPolicy); portErr != nil {
+			err := routing_api.NewError(routing_api.TcpRouteMappingInvalidError,
+				portErr.Error()+". RouteMapping=["+tcpRouteMapping.String()+"]")
+			return &err
+		}
+	}
+
 	// ensure all backends with the same snihostname and external port have the frontend_tls to be either enabled or disabled
 	isTerminateFrontendTLSEnabled := tcpRouteMapping.TerminateFrontendTLS
 	for _, similarTcpRouteMapping := range similarTcpRouteMappings {
@@ -176,35 +387,164 @@ func (v Validator) ValidateCreateTcpRouteMapping(tcpRouteMapping models.TcpRoute
 		}
 	}
 
+	// ensure all backends with the same snihostname and external port negotiate the same ALPNs.
+	// the mapping's own row is skipped, so that the only backend of a route can change them.
+	alpns := tcpRouteMapping.ALPNs.Normalize()
+	for _, similarTcpRouteMapping := range similarTcpRouteMappings {
+		if similarTcpRouteMapping.SameRoute(tcpRouteMapping) {
+			continue
+		}
+		if alpns != similarTcpRouteMapping.ALPNs.Normalize() {
+			err := routing_api.NewError(routing_api.TcpRouteMappingInvalidError,
+				fmt.Sprintf("alpns: %s not allowed", alpns))
+			return &err
+		}
+	}
+
+	// ensure all backends with the same snihostname and external port receive the same PROXY protocol header.
+	// the mapping's own row is skipped, so that the only backend of a route can change it.
+	proxyProtocolVersion := tcpRouteMapping.ProxyProtocolVersion()
+	for _, similarTcpRouteMapping := range similarTcpRouteMappings {
+		if similarTcpRouteMapping.SameRoute(tcpRouteMapping) {
+			continue
+		}
+		if proxyProtocolVersion != similarTcpRouteMapping.ProxyProtocolVersion() {
+			err := routing_api.NewError(routing_api.TcpRouteMappingInvalidError,
+				fmt.Sprintf("proxy_protocol: %s not allowed", proxyProtocolVersion))
+			return &err
+		}
+	}
+
+	// ensure all backends with the same snihostname and external port are served with the same certificate
+	for _, similarTcpRouteMapping := range similarTcpRouteMappings {
+		if similarTcpRouteMapping.SameRoute(tcpRouteMapping) {
+			continue
+		}
+		if tcpRouteMapping.TlsCertificateGuid != similarTcpRouteMapping.TlsCertificateGuid {
+			err := routing_api.NewError(routing_api.TcpRouteMappingInvalidError,
+				fmt.Sprintf("tls_certificate_guid: %s not allowed", tcpRouteMapping.TlsCertificateGuid))
+			return &err
+		}
+	}
+
+	return nil
+}
+
+// externalPortInUse reports whether live mappings already route the external
+// port of the router group. Mappings registered before external ports were
+// checked against the reservable ports keep being refreshed, so that their
+// routes do not expire.
+func externalPortInUse(tcpRouteMapping models.TcpRouteMapping, similarTcpRouteMappings []models.TcpRouteMapping) bool {
+	for _, similarTcpRouteMapping := range similarTcpRouteMappings {
+		if similarTcpRouteMapping.RouterGroupGuid == tcpRouteMapping.RouterGroupGuid &&
+			similarTcpRouteMapping.ExternalPort == tcpRouteMapping.ExternalPort &&
+			similarTcpRouteMapping.ExternalPortEnd == tcpRouteMapping.ExternalPortEnd {
+			return true
+		}
+	}
+	return false
+}
+
+// ValidateTcpRouteMappingOverlaps returns an error when the external ports of
+// the mapping partially overlap with those of another mapping, so that a port
+// would be forwarded by both. Mappings for the same external ports may route to
+// different backends.
+func (v Validator) ValidateTcpRouteMappingOverlaps(tcpRouteMapping models.TcpRouteMapping, overlappingTcpRouteMappings []models.TcpRouteMapping) *routing_api.Error {
+	for _, overlappingTcpRouteMapping := range overlappingTcpRouteMappings {
+		if tcpRouteMapping.ConflictsWith(overlappingTcpRouteMapping) {
+			err := routing_api.NewError(routing_api.TcpRouteMappingInvalidError,
+				"external ports "+tcpRouteMapping.ExternalPortRange().String()+" overlap with tcp mapping ["+overlappingTcpRouteMapping.String()+"]. RouteMapping=["+tcpRouteMapping.String()+"]")
+			return &err
+		}
+	}
+	return nil
+}
+
+// ValidateTcpRouteMappingTlsCertificate returns an error when routers cannot
+// present the tls certificate referenced by the mapping: it does not exist,
+// does not cover the SNI hostname of the mapping or has expired.
+func (v Validator) ValidateTcpRouteMappingTlsCertificate(tcpRouteMapping models.TcpRouteMapping, tlsCertificate models.TlsCertificate) *routing_api.Error {
+	if tlsCertificate.Guid == "" {
+		err := routing_api.NewError(routing_api.TcpRouteMappingInvalidError,
+			"tls_certificate_guid: "+tcpRouteMapping.TlsCertificateGuid+" not found")
+		return &err
+	}
+
+	if sniHostname := tcpRouteMapping.SniHostname; sniHostname != nil && *sniHostname != "" && !tlsCertificate.Covers(*sniHostname) {
+		err := routing_api.NewError(routing_api.TcpRouteMappingInvalidError,
+			"tls certificate "+tlsCertificate.Guid+" for sni_hostname '"+tlsCertificate.SniHostname+"' is not valid for backend_sni_hostname '"+*sniHostname+"'. RouteMapping=["+tcpRouteMapping.String()+"]")
+		return &err
+	}
+
+	if time.Now().After(tlsCertificate.NotAfter) {
+		err := routing_api.NewError(routing_api.TcpRouteMappingInvalidError,
+			"tls certificate "+tlsCertificate.Guid+" expired at "+tlsCertificate.NotAfter.UTC().Format(time.RFC3339)+". RouteMapping=["+tcpRouteMapping.String()+"]")
+		return &err
+	}
+	return nil
+}
+
+// ValidateTcpRouteMappingBackendTlsProfile returns an error when routers
+// cannot use the backend tls profile referenced by the mapping: it does not
+// exist, is reserved for another isolation segment or its client certificate
+// has expired.
+func (v Validator) ValidateTcpRouteMappingBackendTlsProfile(tcpRouteMapping models.TcpRouteMapping, backendTlsProfile models.BackendTlsProfile) *routing_api.Error {
+	if backendTlsProfile.Guid == "" {
+		err := routing_api.NewError(routing_api.TcpRouteMappingInvalidError,
+			"backend_tls_profile: "+tcpRouteMapping.BackendTlsProfile+" not found")
+		return &err
+	}
+
+	if !backendTlsProfile.AllowsIsolationSegment(tcpRouteMapping.IsolationSegment) {
+		err := routing_api.NewError(routing_api.TcpRouteMappingInvalidError,
+			"backend tls profile "+backendTlsProfile.Name+" is reserved for isolation segment '"+backendTlsProfile.IsolationSegment+"'. RouteMapping=["+tcpRouteMapping.String()+"]")
+		return &err
+	}
+
+	if time.Now().After(backendTlsProfile.NotAfter) {
+		err := routing_api.NewError(routing_api.TcpRouteMappingInvalidError,
+			"backend tls profile "+backendTlsProfile.Name+" has a client certificate that expired at "+backendTlsProfile.NotAfter.UTC().Format(time.RFC3339)+". RouteMapping=["+tcpRouteMapping.String()+"]")
+		return &err
+	}
 	return nil
 }
 
 func (v Validator) ValidateDeleteTcpRouteMapping(tcpRouteMappings []models.TcpRouteMapping) *routing_api.Error {
 	for _, tcpRouteMapping := range tcpRouteMappings {
-		err := validateTcpRouteMapping(tcpRouteMapping, false, 0)
+		err := validateTcpRouteMapping(tcpRouteMapping, false, models.TTLPolicy{})
 		if err != nil {
 			return err
 		}
+
+		if tcpRouteMapping.ExternalPort == 0 {
+			err := routing_api.NewError(routing_api.TcpRouteMappingInvalidError,
+				"Each tcp mapping requires a positive external port. RouteMapping=["+tcpRouteMapping.String()+"]")
+			return &err
+		}
 	}
 	return nil
 }
 
-func validateTcpRouteMapping(tcpRouteMapping models.TcpRouteMapping, checkTTL bool, maxTTL int) *routing_api.Error {
+// validateTcpRouteMapping validates the mapping for a create, or for a delete
+// when create is false. Deletes skip the TTL, backend ip and SNI hostname
+// checks, so that mappings stored before those were validated can still be
+// deleted.
+func validateTcpRouteMapping(tcpRouteMapping models.TcpRouteMapping, create bool, policy models.TTLPolicy) *routing_api.Error {
 	if tcpRouteMapping.RouterGroupGuid == "" {
 		err := routing_api.NewError(routing_api.TcpRouteMappingInvalidError,
 			"Each tcp mapping requires a non empty router group guid. RouteMapping=["+tcpRouteMapping.String()+"]")
 		return &err
 	}
 
-	if tcpRouteMapping.ExternalPort <= 0 {
+	if tcpRouteMapping.HostIP == "" {
 		err := routing_api.NewError(routing_api.TcpRouteMappingInvalidError,
-			"Each tcp mapping requires a positive external port. RouteMapping=["+tcpRouteMapping.String()+"]")
+			"Each tcp mapping requires a non empty backend ip. RouteMapping=["+tcpRouteMapping.String()+"]")
 		return &err
 	}
 
-	if tcpRouteMapping.HostIP == "" {
+	if _, ipErr := models.CanonicalIP(tcpRouteMapping.HostIP); create && ipErr != nil {
 		err := routing_api.NewError(routing_api.TcpRouteMappingInvalidError,
-			"Each tcp mapping requires a non empty backend ip. RouteMapping=["+tcpRouteMapping.String()+"]")
+			ipErr.Error()+". RouteMapping=["+tcpRouteMapping.String()+"]")
 		return &err
 	}
 
@@ -214,29 +554,204 @@ func validateTcpRouteMapping(tcpRouteMapping models.TcpRouteMapping, checkTTL bo
 		return &err
 	}
 
+	if sniHostname := tcpRouteMapping.SniHostname; create && sniHostname != nil && *sniHostname != "" {
+		if _, hostnameErr := models.NormalizeHostname(*sniHostname); hostnameErr != nil {
+			err := routing_api.NewError(routing_api.TcpRouteMappingInvalidError,
+				"backend_sni_hostname: "+hostnameErr.Error()+". RouteMapping=["+tcpRouteMapping.String()+"]")
+			return &err
+		}
+	}
+
+	if sniRewriteHostname := tcpRouteMapping.SniRewriteHostname; create && sniRewriteHostname != nil && *sniRewriteHostname != "" {
+		if strings.HasPrefix(*sniRewriteHostname, "*.") {
+			err := routing_api.NewError(routing_api.TcpRouteMappingInvalidError,
+				"sni_rewrite_hostname: hostname '"+*sniRewriteHostname+"' must not be a wildcard. RouteMapping=["+tcpRouteMapping.String()+"]")
+			return &err
+		}
+		if _, hostnameErr := models.NormalizeHostname(*sniRewriteHostname); hostnameErr != nil {
+			err := routing_api.NewError(routing_api.TcpRouteMappingInvalidError,
+				"sni_rewrite_hostname: "+hostnameErr.Error()+". RouteMapping=["+tcpRouteMapping.String()+"]")
+			return &err
+		}
+	}
+
+	if rangeErr := tcpRouteMapping.ValidatePortRange(); rangeErr != nil {
+		err := routing_api.NewError(routing_api.TcpRouteMappingInvalidError,
+			rangeErr.Error()+". RouteMapping=["+tcpRouteMapping.String()+"]")
+		return &err
+	}
+
 	if tcpRouteMapping.HostTLSPort > 65535 {
 		err := routing_api.NewError(routing_api.TcpRouteMappingInvalidError,
 			"Each tcp mapping with a backend TLS port requires that port to be less than or equal to 65535. RouteMapping=["+tcpRouteMapping.String()+"]")
 		return &err
 	}
 
-	if checkTTL && *tcpRouteMapping.TTL > maxTTL {
+	if create && *tcpRouteMapping.TTL > policy.Max {
 		err := routing_api.NewError(routing_api.TcpRouteMappingInvalidError,
-			"Each tcp mapping requires TTL to be less than or equal to "+strconv.Itoa(int(maxTTL))+". RouteMapping=["+tcpRouteMapping.String()+"]")
+			"Each tcp mapping requires TTL to be less than or equal to "+strconv.Itoa(policy.Max)+". RouteMapping=["+tcpRouteMapping.String()+"]")
 		return &err
 	}
 
-	if checkTTL && *tcpRouteMapping.TTL <= 0 {
+	if create && *tcpRouteMapping.TTL <= 0 {
 		err := routing_api.NewError(routing_api.TcpRouteMappingInvalidError,
 			"Each tcp route mapping requires a ttl greater than 0")
 		return &err
 	}
 
+	if create && *tcpRouteMapping.TTL < policy.Min {
+		err := routing_api.NewError(routing_api.TcpRouteMappingInvalidError,
+			"Each tcp mapping requires TTL to be greater than or equal to "+strconv.Itoa(policy.Min)+". RouteMapping=["+tcpRouteMapping.String()+"]")
+		return &err
+	}
+
 	if tcpRouteMapping.ALPNs != "" && !tcpRouteMapping.TerminateFrontendTLS {
 		err := routing_api.NewError(routing_api.TcpRouteMappingInvalidError,
 			"Each tcp mapping can define ALPNs only when TerminateFrontendTLS is enabled. RouteMapping=["+tcpRouteMapping.String()+"]")
 		return &err
 	}
 
+	if tcpRouteMapping.TlsCertificateGuid != "" && !tcpRouteMapping.TerminateFrontendTLS {
+		err := routing_api.NewError(routing_api.TcpRouteMappingInvalidError,
+			"Each tcp mapping can reference a tls certificate only when TerminateFrontendTLS is enabled. RouteMapping=["+tcpRouteMapping.String()+"]")
+		return &err
+	}
+
+	if tcpRouteMapping.BackendTlsProfile != "" && !tcpRouteMapping.EnableBackendMTLS {
+		err := routing_api.NewError(routing_api.TcpRouteMappingInvalidError,
+			"Each tcp mapping can reference a backend tls profile only when EnableBackendMTLS is enabled. RouteMapping=["+tcpRouteMapping.String()+"]")
+		return &err
+	}
+
+	switch tcpRouteMapping.ProxyProtocol {
+	case "", models.ProxyProtocolNone, models.ProxyProtocolV1, models.ProxyProtocolV2:
+	default:
+		err := routing_api.NewError(routing_api.TcpRouteMappingInvalidError,
+			"Each tcp mapping requires proxy_protocol to be one of none, v1 or v2. RouteMapping=["+tcpRouteMapping.String()+"]")
+		return &err
+	}
+
+	if labelErr := tcpRouteMapping.Labels.Validate(); labelErr != nil {
+		err := routing_api.NewError(routing_api.TcpRouteMappingInvalidError,
+			labelErr.Error()+". RouteMapping=["+tcpRouteMapping.String()+"]")
+		return &err
+	}
+
+	if healthCheckErr := tcpRouteMapping.ValidateHealthCheck(); healthCheckErr != nil {
+		err := routing_api.NewError(routing_api.TcpRouteMappingInvalidError,
+			healthCheckErr.Error()+". RouteMapping=["+tcpRouteMapping.String()+"]")
+		return &err
+	}
+
+	return nil
+}
+
+func (v Validator) ValidateCreateUdpRouteMapping(udpRouteMapping models.UdpRouteMapping, routerGroups models.RouterGroups, maxTTL int) *routing_api.Error {
+	policy := udpRouteMappingTTLPolicy(udpRouteMapping, routerGroups, maxTTL)
+	err := validateUdpRouteMapping(udpRouteMapping, true, policy)
+	if err != nil {
+		return err
+	}
+
+	routerGroup, ok := routerGroups.FindByGuid(udpRouteMapping.RouterGroupGuid)
+	if !ok {
+		err := routing_api.NewError(routing_api.UdpRouteMappingInvalidError,
+			"router_group_guid: "+udpRouteMapping.RouterGroupGuid+" not found")
+		return &err
+	}
+
+	if routerGroup.Type != models.RouterGroup_UDP {
+		err := routing_api.NewError(routing_api.UdpRouteMappingInvalidError,
+			"router_group_guid: "+udpRouteMapping.RouterGroupGuid+" is not a udp router group")
+		return &err
+	}
+
+	networkPolicy, networkErr := v.backendNetworkPolicy.ForRouterGroup(routerGroup)
+	if networkErr == nil {
+		networkErr = networkPolicy.Validate(udpRouteMapping.HostIP)
+	}
+	if networkErr != nil {
+		err := routing_api.NewError(routing_api.UdpRouteMappingInvalidError,
+			networkErr.Error()+". RouteMapping=["+udpRouteMapping.String()+"]")
+		return &err
+	}
+
+	if portErr := routerGroup.ValidateExternalPort(udpRouteMapping.ExternalPort, v.portPolicy); portErr != nil {
+		err := routing_api.NewError(routing_api.UdpRouteMappingInvalidError,
+			portErr.Error()+". RouteMapping=["+udpRouteMapping.String()+"]")
+		return &err
+	}
+
+	return nil
+}
+
+func (v Validator) ValidateDeleteUdpRouteMapping(udpRouteMappings []models.UdpRouteMapping) *routing_api.Error {
+	for _, udpRouteMapping := range udpRouteMappings {
+		err := validateUdpRouteMapping(udpRouteMapping, false, models.TTLPolicy{})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validateUdpRouteMapping validates the mapping for a create, or for a delete
+// when create is false. Deletes skip the TTL and backend ip checks, so that
+// mappings stored before those were validated can still be deleted.
+func validateUdpRouteMapping(udpRouteMapping models.UdpRouteMapping, create bool, policy models.TTLPolicy) *routing_api.Error {
+	if udpRouteMapping.RouterGroupGuid == "" {
+		err := routing_api.NewError(routing_api.UdpRouteMappingInvalidError,
+			"Each udp mapping requires a non empty router group guid. RouteMapping=["+udpRouteMapping.String()+"]")
+		return &err
+	}
+
+	if udpRouteMapping.ExternalPort == 0 {
+		err := routing_api.NewError(routing_api.UdpRouteMappingInvalidError,
+			"Each udp mapping requires a positive external port. RouteMapping=["+udpRouteMapping.String()+"]")
+		return &err
+	}
+
+	if udpRouteMapping.HostIP == "" {
+		err := routing_api.NewError(routing_api.UdpRouteMappingInvalidError,
+			"Each udp mapping requires a non empty backend ip. RouteMapping=["+udpRouteMapping.String()+"]")
+		return &err
+	}
+
+	if _, ipErr := models.CanonicalIP(udpRouteMapping.HostIP); create && ipErr != nil {
+		err := routing_api.NewError(routing_api.UdpRouteMappingInvalidError,
+			ipErr.Error()+". RouteMapping=["+udpRouteMapping.String()+"]")
+		return &err
+	}
+
+	if udpRouteMapping.HostPort == 0 {
+		err := routing_api.NewError(routing_api.UdpRouteMappingInvalidError,
+			"Each udp mapping requires a positive backend port. RouteMapping=["+udpRouteMapping.String()+"]")
+		return &err
+	}
+
+	if create && *udpRouteMapping.TTL > policy.Max {
+		err := routing_api.NewError(routing_api.UdpRouteMappingInvalidError,
+			"Each udp mapping requires TTL to be less than or equal to "+strconv.Itoa(policy.Max)+". RouteMapping=["+udpRouteMapping.String()+"]")
+		return &err
+	}
+
+	if create && *udpRouteMapping.TTL <= 0 {
+		err := routing_api.NewError(routing_api.UdpRouteMappingInvalidError,
+			"Each udp route mapping requires a ttl greater than 0")
+		return &err
+	}
+
+	if create && *udpRouteMapping.TTL < policy.Min {
+		err := routing_api.NewError(routing_api.UdpRouteMappingInvalidError,
+			"Each udp mapping requires TTL to be greater than or equal to "+strconv.Itoa(policy.Min)+". RouteMapping=["+udpRouteMapping.String()+"]")
+		return &err
+	}
+
+	if labelErr := udpRouteMapping.Labels.Validate(); labelErr != nil {
+		err := routing_api.NewError(routing_api.UdpRouteMappingInvalidError,
+			labelErr.Error()+". RouteMapping=["+udpRouteMapping.String()+"]")
+		return &err
+	}
+
 	return nil
 }
@@ -2,6 +2,8 @@ package handlers_test
 
 import (
 	"fmt"
+	"net/netip"
+	"time"
 
 	routing_api "code.cloudfoundry.org/routing-api"
 	"code.cloudfoundry.org/routing-api/handlers"
@@ -19,7 +21,7 @@ var _ = Describe("Validator", func() {
 	)
 
 	BeforeEach(func() {
-		validator = handlers.NewValidator()
+		validator = handlers.NewValidator(models.PortPolicy{}, models.ConnectionLimitPolicy{}, nil, models.BackendNetworkPolicy{})
 		maxTTL = 50
 
 		route := models.NewRoute("http://127.0.0.1/a/valid/route", 8080, "127.0.0.1", "log_guid", "https://my-rs.example.com", maxTTL)
@@ -29,7 +31,7 @@ var _ = Describe("Validator", func() {
 	Describe("Routes", func() {
 		Describe("ValidateCreate", func() {
 			It("does not return an error if all route inputs are valid", func() {
-				err := validator.ValidateCreate(routes, maxTTL)
+				err := validator.ValidateCreate(routes, nil, maxTTL)
 				Expect(err).To(BeNil())
 			})
 
@@ -41,7 +43,7 @@ var _ = Describe("Validator", func() {
 				It("returns an error if any ttl is greater than max ttl", func() {
 					*routes[1].TTL = maxTTL + 1
 
-					err := validator.ValidateCreate(routes, maxTTL)
+					err := validator.ValidateCreate(routes, nil, maxTTL)
 					Expect(err.Type).To(Equal(routing_api.RouteInvalidError))
 					Expect(err.Error()).To(Equal(fmt.Sprintf("Max ttl is %d", maxTTL)))
 				})
@@ -49,7 +51,7 @@ var _ = Describe("Validator", func() {
 				It("returns an error if any ttl is less than 1", func() {
 					*routes[1].TTL = 0
 
-					err := validator.ValidateCreate(routes, maxTTL)
+					err := validator.ValidateCreate(routes, nil, maxTTL)
 					Expect(err.Type).To(Equal(routing_api.RouteInvalidError))
 					Expect(err.Error()).To(Equal("Request requires a ttl greater than 0"))
 				})
@@ -57,15 +59,23 @@ var _ = Describe("Validator", func() {
 				It("returns an error if any request does not have a route", func() {
 					routes[0].Route = ""
 
-					err := validator.ValidateCreate(routes, maxTTL)
+					err := validator.ValidateCreate(routes, nil, maxTTL)
 					Expect(err.Type).To(Equal(routing_api.RouteInvalidError))
 					Expect(err.Error()).To(Equal("Each route request requires a valid route"))
 				})
 
+				It("returns an error if a route hostname is not a valid DNS name", func() {
+					routes[1].Route = "foo.*.example.com/path"
+
+					err := validator.ValidateCreate(routes, nil, maxTTL)
+					Expect(err.Type).To(Equal(routing_api.RouteInvalidError))
+					Expect(err.Error()).To(Equal("hostname 'foo.*.example.com' may only contain a wildcard as its leading label"))
+				})
+
 				It("returns an error if any port is less than 1", func() {
 					routes[0].Port = 0
 
-					err := validator.ValidateCreate(routes, maxTTL)
+					err := validator.ValidateCreate(routes, nil, maxTTL)
 					Expect(err.Type).To(Equal(routing_api.RouteInvalidError))
 					Expect(err.Error()).To(Equal("Each route request requires a port greater than 0"))
 				})
@@ -73,7 +83,7 @@ var _ = Describe("Validator", func() {
 				It("returns an error if the path contains invalid characters", func() {
 					routes[0].Route = "/foo/b ar"
 
-					err := validator.ValidateCreate(routes, maxTTL)
+					err := validator.ValidateCreate(routes, nil, maxTTL)
 					Expect(err).ToNot(BeNil())
 					Expect(err.Type).To(Equal(routing_api.RouteInvalidError))
 					Expect(err.Error()).To(Equal("url cannot contain invalid characters"))
@@ -82,7 +92,7 @@ var _ = Describe("Validator", func() {
 				It("returns an error if the path is not valid", func() {
 					routes[0].Route = "/foo/bar%"
 
-					err := validator.ValidateCreate(routes, maxTTL)
+					err := validator.ValidateCreate(routes, nil, maxTTL)
 					Expect(err).ToNot(BeNil())
 					Expect(err.Type).To(Equal(routing_api.RouteInvalidError))
 					Expect(err.Error()).To(ContainSubstring("invalid URL"))
@@ -91,7 +101,7 @@ var _ = Describe("Validator", func() {
 				It("returns an error if the path contains a question mark", func() {
 					routes[0].Route = "/foo/bar?a"
 
-					err := validator.ValidateCreate(routes, maxTTL)
+					err := validator.ValidateCreate(routes, nil, maxTTL)
 					Expect(err).ToNot(BeNil())
 					Expect(err.Type).To(Equal(routing_api.RouteInvalidError))
 					Expect(err.Error()).To(ContainSubstring("cannot contain any of [?, #]"))
@@ -100,7 +110,7 @@ var _ = Describe("Validator", func() {
 				It("returns an error if the path contains a hash mark", func() {
 					routes[0].Route = "/foo/bar#a"
 
-					err := validator.ValidateCreate(routes, maxTTL)
+					err := validator.ValidateCreate(routes, nil, maxTTL)
 					Expect(err).ToNot(BeNil())
 					Expect(err.Type).To(Equal(routing_api.RouteInvalidError))
 					Expect(err.Error()).To(ContainSubstring("cannot contain any of [?, #]"))
@@ -109,7 +119,7 @@ var _ = Describe("Validator", func() {
 				It("returns an error if the route service url is not https", func() {
 					routes[0].RouteServiceUrl = "http://my-rs.com/ab"
 
-					err := validator.ValidateCreate(routes, maxTTL)
+					err := validator.ValidateCreate(routes, nil, maxTTL)
 					Expect(err).ToNot(BeNil())
 					Expect(err.Type).To(Equal(routing_api.RouteServiceUrlInvalidError))
 					Expect(err.Error()).To(Equal("Route service url must use HTTPS."))
@@ -118,7 +128,7 @@ var _ = Describe("Validator", func() {
 				It("returns an error if the route service url contains invalid characters", func() {
 					routes[0].RouteServiceUrl = "https://my-rs.com/a  b"
 
-					err := validator.ValidateCreate(routes, maxTTL)
+					err := validator.ValidateCreate(routes, nil, maxTTL)
 					Expect(err).ToNot(BeNil())
 					Expect(err.Type).To(Equal(routing_api.RouteServiceUrlInvalidError))
 					Expect(err.Error()).To(Equal("url cannot contain invalid characters"))
@@ -127,7 +137,7 @@ var _ = Describe("Validator", func() {
 				It("returns an error if the route service url host is not valid", func() {
 					routes[0].RouteServiceUrl = "https://my-rs%.com"
 
-					err := validator.ValidateCreate(routes, maxTTL)
+					err := validator.ValidateCreate(routes, nil, maxTTL)
 					Expect(err).ToNot(BeNil())
 					Expect(err.Type).To(Equal(routing_api.RouteServiceUrlInvalidError))
 					Expect(err.Error()).To(ContainSubstring("invalid URL escape"))
@@ -136,7 +146,7 @@ var _ = Describe("Validator", func() {
 				It("returns an error if the route service url path is not valid", func() {
 					routes[0].RouteServiceUrl = "https://my-rs.com/ad%"
 
-					err := validator.ValidateCreate(routes, maxTTL)
+					err := validator.ValidateCreate(routes, nil, maxTTL)
 					Expect(err).ToNot(BeNil())
 					Expect(err.Type).To(Equal(routing_api.RouteServiceUrlInvalidError))
 					Expect(err.Error()).To(ContainSubstring("invalid URL"))
@@ -145,7 +155,7 @@ var _ = Describe("Validator", func() {
 				It("returns an error if the route service url contains a question mark", func() {
 					routes[0].RouteServiceUrl = "https://foo/bar?a"
 
-					err := validator.ValidateCreate(routes, maxTTL)
+					err := validator.ValidateCreate(routes, nil, maxTTL)
 					Expect(err).ToNot(BeNil())
 					Expect(err.Type).To(Equal(routing_api.RouteServiceUrlInvalidError))
 					Expect(err.Error()).To(ContainSubstring("cannot contain any of [?, #]"))
@@ -154,7 +164,7 @@ var _ = Describe("Validator", func() {
 				It("returns an error if the route service url contains a hash mark", func() {
 					routes[0].RouteServiceUrl = "https://foo/bar#a"
 
-					err := validator.ValidateCreate(routes, maxTTL)
+					err := validator.ValidateCreate(routes, nil, maxTTL)
 					Expect(err).ToNot(BeNil())
 					Expect(err.Type).To(Equal(routing_api.RouteServiceUrlInvalidError))
 					Expect(err.Error()).To(ContainSubstring("cannot contain any of [?, #]"))
@@ -163,10 +173,213 @@ var _ = Describe("Validator", func() {
 				It("returns an error if any request does not have an IP", func() {
 					routes[1].IP = ""
 
-					err := validator.ValidateCreate(routes, maxTTL)
+					err := validator.ValidateCreate(routes, nil, maxTTL)
 					Expect(err.Type).To(Equal(routing_api.RouteInvalidError))
 					Expect(err.Error()).To(Equal("Each route request requires an IP"))
 				})
+
+				It("returns an error if an IP is not an IPv4 or IPv6 address", func() {
+					routes[1].IP = "backend.example.com"
+
+					err := validator.ValidateCreate(routes, nil, maxTTL)
+					Expect(err.Type).To(Equal(routing_api.RouteInvalidError))
+					Expect(err.Error()).To(Equal("backend ip 'backend.example.com' is not an IPv4 or IPv6 address"))
+				})
+
+				It("returns an error if the tls port is greater than 65535", func() {
+					routes[1].TLSPort = 65536
+					routes[1].ServerCertDomainSAN = "instance-guid"
+
+					err := validator.ValidateCreate(routes, nil, maxTTL)
+					Expect(err.Type).To(Equal(routing_api.RouteInvalidError))
+					Expect(err.Error()).To(Equal("Each route request with a tls_port requires that port to be between 1 and 65535"))
+				})
+
+				It("returns an error if a tls port is given without a server cert domain san", func() {
+					routes[1].TLSPort = 8443
+
+					err := validator.ValidateCreate(routes, nil, maxTTL)
+					Expect(err.Type).To(Equal(routing_api.RouteInvalidError))
+					Expect(err.Error()).To(Equal("Each route request with a tls_port requires a server_cert_domain_san"))
+				})
+
+				It("returns an error if a server cert domain san is given without a tls port", func() {
+					routes[1].ServerCertDomainSAN = "instance-guid"
+
+					err := validator.ValidateCreate(routes, nil, maxTTL)
+					Expect(err.Type).To(Equal(routing_api.RouteInvalidError))
+					Expect(err.Error()).To(Equal("Each route request can define server_cert_domain_san only when tls_port is set"))
+				})
+			})
+
+			Context("when a route has a protocol", func() {
+				It("does not return an error for http1", func() {
+					routes[0].Protocol = models.RouteProtocolHTTP1
+
+					err := validator.ValidateCreate(routes, nil, maxTTL)
+					Expect(err).To(BeNil())
+				})
+
+				It("does not return an error for http2", func() {
+					routes[0].Protocol = models.RouteProtocolHTTP2
+
+					err := validator.ValidateCreate(routes, nil, maxTTL)
+					Expect(err).To(BeNil())
+				})
+
+				It("returns an error for an unknown protocol", func() {
+					routes[0].Protocol = "grpc"
+
+					err := validator.ValidateCreate(routes, nil, maxTTL)
+					Expect(err.Type).To(Equal(routing_api.RouteInvalidError))
+					Expect(err.Error()).To(Equal("protocol: grpc not allowed, must be one of [http1, http2]"))
+				})
+			})
+
+			Context("when a route has labels", func() {
+				It("does not return an error for valid labels", func() {
+					routes[0].Labels = models.LabelSet(`{"env":"prod","example.com/team":"routing"}`)
+
+					err := validator.ValidateCreate(routes, nil, maxTTL)
+					Expect(err).To(BeNil())
+				})
+
+				It("returns an error for an invalid label key", func() {
+					routes[0].Labels = models.LabelSet(`{"-env":"prod"}`)
+
+					err := validator.ValidateCreate(routes, nil, maxTTL)
+					Expect(err).ToNot(BeNil())
+					Expect(err.Type).To(Equal(routing_api.RouteInvalidError))
+					Expect(err.Error()).To(ContainSubstring("invalid label key -env"))
+				})
+
+				It("returns an error for an invalid label value", func() {
+					routes[0].Labels = models.LabelSet(`{"env":"prod stage"}`)
+
+					err := validator.ValidateCreate(routes, nil, maxTTL)
+					Expect(err).ToNot(BeNil())
+					Expect(err.Type).To(Equal(routing_api.RouteInvalidError))
+					Expect(err.Error()).To(ContainSubstring("invalid value for label env"))
+				})
+			})
+
+			Context("when a route has backend tls metadata", func() {
+				BeforeEach(func() {
+					routes[0].TLSPort = 8443
+					routes[0].ServerCertDomainSAN = "instance-guid"
+				})
+
+				It("does not return an error", func() {
+					err := validator.ValidateCreate(routes, nil, maxTTL)
+					Expect(err).To(BeNil())
+				})
+			})
+
+			Context("when a route belongs to a router group", func() {
+				var routerGroups models.RouterGroups
+
+				BeforeEach(func() {
+					routerGroups = models.RouterGroups{
+						{Guid: "http-guid", Name: "default-http", Type: models.RouterGroup_HTTP, MinTTL: 20, MaxTTL: 300},
+						{Guid: "tcp-guid", Name: "default-tcp", Type: models.RouterGroup_TCP, ReservablePorts: "1024-65535"},
+					}
+					routes[0].RouterGroupGuid = "http-guid"
+				})
+
+				It("allows ttls up to the max ttl of the router group", func() {
+					*routes[0].TTL = 300
+					err := validator.ValidateCreate(routes, routerGroups, maxTTL)
+					Expect(err).To(BeNil())
+				})
+
+				It("returns an error when the ttl is greater than the max ttl of the router group", func() {
+					*routes[0].TTL = 301
+					err := validator.ValidateCreate(routes, routerGroups, maxTTL)
+					Expect(err.Type).To(Equal(routing_api.RouteInvalidError))
+					Expect(err.Error()).To(Equal("Max ttl is 300"))
+				})
+
+				It("returns an error when the ttl is less than the min ttl of the router group", func() {
+					*routes[0].TTL = 19
+					err := validator.ValidateCreate(routes, routerGroups, maxTTL)
+					Expect(err.Type).To(Equal(routing_api.RouteInvalidError))
+					Expect(err.Error()).To(Equal("Min ttl is 20"))
+				})
+
+				It("returns an error when the router group is unknown", func() {
+					routes[0].RouterGroupGuid = "unknown-guid"
+					err := validator.ValidateCreate(routes, routerGroups, maxTTL)
+					Expect(err.Type).To(Equal(routing_api.RouteInvalidError))
+					Expect(err.Error()).To(Equal("router_group_guid: unknown-guid not found"))
+				})
+
+				It("returns an error when the router group is not of type http", func() {
+					routes[0].RouterGroupGuid = "tcp-guid"
+					err := validator.ValidateCreate(routes, routerGroups, maxTTL)
+					Expect(err.Type).To(Equal(routing_api.RouteInvalidError))
+					Expect(err.Error()).To(Equal("router_group_guid: tcp-guid is not an http router group"))
+				})
+			})
+
+			Context("when backend networks are restricted", func() {
+				BeforeEach(func() {
+					validator = handlers.NewValidator(models.PortPolicy{}, models.ConnectionLimitPolicy{}, nil, models.BackendNetworkPolicy{
+						AllowedCIDRs: []netip.Prefix{netip.MustParsePrefix("10.0.0.0/8"), netip.MustParsePrefix("fd00::/8")},
+						DeniedCIDRs:  []netip.Prefix{netip.MustParsePrefix("10.255.0.0/16")},
+					})
+				})
+
+				It("allows IPv4 and IPv6 backends in the allowed networks", func() {
+					routes[0].IP = "10.0.0.5"
+					routes = append(routes, routes[0])
+					routes[1].IP = "fd00::5"
+
+					err := validator.ValidateCreate(routes, nil, maxTTL)
+					Expect(err).To(BeNil())
+				})
+
+				It("returns an error when the IP is outside the allowed networks", func() {
+					routes[0].IP = "192.168.0.5"
+
+					err := validator.ValidateCreate(routes, nil, maxTTL)
+					Expect(err.Type).To(Equal(routing_api.RouteInvalidError))
+					Expect(err.Error()).To(Equal("backend ip 192.168.0.5 is not within the allowed networks"))
+				})
+
+				It("returns an error when the IP is in a denied network", func() {
+					routes[0].IP = "10.255.0.5"
+
+					err := validator.ValidateCreate(routes, nil, maxTTL)
+					Expect(err.Type).To(Equal(routing_api.RouteInvalidError))
+					Expect(err.Error()).To(Equal("backend ip 10.255.0.5 is within the denied network 10.255.0.0/16"))
+				})
+
+				Context("when the router group of the route has backend networks", func() {
+					var routerGroups models.RouterGroups
+
+					BeforeEach(func() {
+						routerGroups = models.RouterGroups{
+							{Guid: "http-guid", Name: "default-http", Type: models.RouterGroup_HTTP, AllowedBackendCIDRs: "192.168.0.0/16", DeniedBackendCIDRs: "192.168.1.0/24"},
+						}
+						routes[0].RouterGroupGuid = "http-guid"
+					})
+
+					It("allows the IPs of the router group instead of the global networks", func() {
+						routes[0].IP = "192.168.0.5"
+						err := validator.ValidateCreate(routes, routerGroups, maxTTL)
+						Expect(err).To(BeNil())
+
+						routes[0].IP = "10.0.0.5"
+						err = validator.ValidateCreate(routes, routerGroups, maxTTL)
+						Expect(err.Error()).To(Equal("backend ip 10.0.0.5 is not within the allowed networks"))
+					})
+
+					It("denies the IPs of the router group in addition to the global networks", func() {
+						routes[0].IP = "192.168.1.5"
+						err := validator.ValidateCreate(routes, routerGroups, maxTTL)
+						Expect(err.Error()).To(Equal("backend ip 192.168.1.5 is within the denied network 192.168.1.0/24"))
+					})
+				})
 			})
 		})
 
@@ -176,6 +389,13 @@ var _ = Describe("Validator", func() {
 				Expect(err).To(BeNil())
 			})
 
+			It("does not validate the hostname of the routes", func() {
+				routes[0].Route = "foo_bar..example.com/path"
+
+				err := validator.ValidateDelete(routes)
+				Expect(err).To(BeNil())
+			})
+
 			Context("when any route has an invalid value", func() {
 				BeforeEach(func() {
 					routes = append(routes, routes[0])
@@ -197,6 +417,13 @@ var _ = Describe("Validator", func() {
 					Expect(err.Error()).To(Equal("Each route request requires a port greater than 0"))
 				})
 
+				It("does not return an error if an IP is not an IPv4 or IPv6 address", func() {
+					routes[1].IP = "not-an-ip"
+
+					err := validator.ValidateDelete(routes)
+					Expect(err).To(BeNil())
+				})
+
 				It("returns an error if any request does not have an IP", func() {
 					routes[1].IP = ""
 
@@ -282,13 +509,6 @@ var _ = Describe("Validator", func() {
 					})
 				})
 
-				// We should be validating that TCP route registrations have ports within their
-				// router-group's port. However the current behavior has existed so long that
-				// changing it is pretty risky and could result in TCP routes ceasing to function.
-				// For services using tcp routes this could mean rebinding and restarting apps,
-				// or even deleting and recreating the service + binding + restarting the apps.
-				// There's no easy path to fixing this that won't require a lot of CF developer
-				// pain, so we're codifying the bad behavior now.
 				Context("when external port is not within the router group's reservable ports", func() {
 					BeforeEach(func() {
 						routerGroups = models.RouterGroups{
@@ -302,18 +522,79 @@ var _ = Describe("Validator", func() {
 						tcpMapping = models.NewTcpRouteMapping(DefaultRouterGroupGuid, 2048, "10.10.10.10", 8080, 0, "", nil, nil, 42, models.ModificationTag{}, false, "")
 					})
 
-					It("does not return an error", func() {
+					It("blows up", func() {
+						err := validator.ValidateCreateTcpRouteMappings([]models.TcpRouteMapping{tcpMapping}, routerGroups, 120)
+						Expect(err).ToNot(BeNil())
+						Expect(err.Type).To(Equal(routing_api.TcpRouteMappingInvalidError))
+						Expect(err.Error()).To(ContainSubstring("external port 2048 is not within the reservable ports (1024-1032) of router group default-tcp"))
+					})
+
+					Context("when live mappings of the router group already use the external port", func() {
+						It("does not return an error so that they keep being refreshed", func() {
+							similarTcpRouteMappings := []models.TcpRouteMapping{
+								models.NewTcpRouteMapping(DefaultRouterGroupGuid, 2048, "10.10.10.11", 8080, 0, "", nil, nil, 42, models.ModificationTag{}, false, ""),
+							}
+							err := validator.ValidateCreateTcpRouteMapping(tcpMapping, similarTcpRouteMappings, routerGroups, 120)
+							Expect(err).To(BeNil())
+						})
+
+						It("blows up when those mappings belong to another router group", func() {
+							similarTcpRouteMappings := []models.TcpRouteMapping{
+								models.NewTcpRouteMapping(DefaultOtherRouterGroupGuid, 2048, "10.10.10.11", 8080, 0, "", nil, nil, 42, models.ModificationTag{}, false, ""),
+							}
+							err := validator.ValidateCreateTcpRouteMapping(tcpMapping, similarTcpRouteMappings, routerGroups, 120)
+							Expect(err).ToNot(BeNil())
+							Expect(err.Type).To(Equal(routing_api.TcpRouteMappingInvalidError))
+						})
+					})
+				})
+
+				Context("when external port is a reserved system component port", func() {
+					BeforeEach(func() {
+						validator = handlers.NewValidator(models.PortPolicy{SystemComponentPorts: []uint16{52000}}, models.ConnectionLimitPolicy{}, nil, models.BackendNetworkPolicy{})
+					})
+
+					It("blows up", func() {
+						err := validator.ValidateCreateTcpRouteMappings([]models.TcpRouteMapping{tcpMapping}, routerGroups, 120)
+						Expect(err).ToNot(BeNil())
+						Expect(err.Type).To(Equal(routing_api.TcpRouteMappingInvalidError))
+						Expect(err.Error()).To(ContainSubstring("external port 52000 is a reserved system component port"))
+					})
+
+					It("does not blow up when the router group excludes other ports", func() {
+						routerGroups[0].ExcludedPorts = "1024"
 						err := validator.ValidateCreateTcpRouteMappings([]models.TcpRouteMapping{tcpMapping}, routerGroups, 120)
 						Expect(err).To(BeNil())
 					})
 				})
 
-				It("blows up when a external port is zero", func() {
-					tcpMapping.ExternalPort = 0
-					err := validator.ValidateCreateTcpRouteMappings([]models.TcpRouteMapping{tcpMapping}, routerGroups, 120)
-					Expect(err).ToNot(BeNil())
-					Expect(err.Type).To(Equal(routing_api.TcpRouteMappingInvalidError))
-					Expect(err.Error()).To(ContainSubstring("Each tcp mapping requires a positive external port"))
+				Context("when external port is excluded by the router group", func() {
+					It("blows up", func() {
+						routerGroups[0].ExcludedPorts = "52000"
+						err := validator.ValidateCreateTcpRouteMappings([]models.TcpRouteMapping{tcpMapping}, routerGroups, 120)
+						Expect(err).ToNot(BeNil())
+						Expect(err.Type).To(Equal(routing_api.TcpRouteMappingInvalidError))
+						Expect(err.Error()).To(ContainSubstring("external port 52000 is excluded by router group"))
+					})
+				})
+
+				Context("when external port is zero", func() {
+					BeforeEach(func() {
+						tcpMapping.ExternalPort = 0
+					})
+
+					It("does not return an error so that a port can be allocated", func() {
+						err := validator.ValidateCreateTcpRouteMappings([]models.TcpRouteMapping{tcpMapping}, routerGroups, 120)
+						Expect(err).To(BeNil())
+					})
+
+					It("blows up when the router group has no reservable ports", func() {
+						routerGroups[0].ReservablePorts = ""
+						err := validator.ValidateCreateTcpRouteMappings([]models.TcpRouteMapping{tcpMapping}, routerGroups, 120)
+						Expect(err).ToNot(BeNil())
+						Expect(err.Type).To(Equal(routing_api.TcpRouteMappingInvalidError))
+						Expect(err.Error()).To(ContainSubstring("has no reservable ports to allocate an external port from"))
+					})
 				})
 
 				It("blows up when backend ip empty", func() {
@@ -324,6 +605,43 @@ var _ = Describe("Validator", func() {
 					Expect(err.Error()).To(ContainSubstring("Each tcp mapping requires a non empty backend ip"))
 				})
 
+				It("blows up when backend ip is not an IPv4 or IPv6 address", func() {
+					tcpMapping.HostIP = "fe80::1%eth0"
+					err := validator.ValidateCreateTcpRouteMappings([]models.TcpRouteMapping{tcpMapping}, routerGroups, 120)
+					Expect(err).ToNot(BeNil())
+					Expect(err.Type).To(Equal(routing_api.TcpRouteMappingInvalidError))
+					Expect(err.Error()).To(ContainSubstring("backend ip 'fe80::1%eth0' is not an IPv4 or IPv6 address"))
+				})
+
+				Context("when backend networks are restricted", func() {
+					BeforeEach(func() {
+						validator = handlers.NewValidator(models.PortPolicy{}, models.ConnectionLimitPolicy{}, nil, models.BackendNetworkPolicy{
+							AllowedCIDRs: []netip.Prefix{netip.MustParsePrefix("1.2.0.0/16")},
+						})
+					})
+
+					It("does not return error for a backend ip in the allowed networks", func() {
+						err := validator.ValidateCreateTcpRouteMapping(tcpMapping, nil, routerGroups, 120)
+						Expect(err).To(BeNil())
+					})
+
+					It("blows up when the backend ip is outside the allowed networks", func() {
+						tcpMapping.HostIP = "2001:db8::1"
+						err := validator.ValidateCreateTcpRouteMapping(tcpMapping, nil, routerGroups, 120)
+						Expect(err).ToNot(BeNil())
+						Expect(err.Type).To(Equal(routing_api.TcpRouteMappingInvalidError))
+						Expect(err.Error()).To(ContainSubstring("backend ip 2001:db8::1 is not within the allowed networks"))
+					})
+
+					It("blows up when the router group denies the backend ip", func() {
+						routerGroups[0].DeniedBackendCIDRs = "1.2.3.0/24"
+						err := validator.ValidateCreateTcpRouteMapping(tcpMapping, nil, routerGroups, 120)
+						Expect(err).ToNot(BeNil())
+						Expect(err.Type).To(Equal(routing_api.TcpRouteMappingInvalidError))
+						Expect(err.Error()).To(ContainSubstring("backend ip 1.2.3.4 is within the denied network 1.2.3.0/24"))
+					})
+				})
+
 				It("blows up when group guid is empty", func() {
 					tcpMapping.RouterGroupGuid = ""
 					err := validator.ValidateCreateTcpRouteMappings([]models.TcpRouteMapping{tcpMapping}, routerGroups, 120)
@@ -340,6 +658,20 @@ var _ = Describe("Validator", func() {
 					Expect(err.Error()).To(ContainSubstring("router_group_guid: unknown-router-group-guid not found"))
 				})
 
+				It("blows up when the router group is not of type tcp", func() {
+					routerGroups = append(routerGroups,
+						models.RouterGroup{Guid: "udp-router-group-guid", Name: "default-udp", Type: models.RouterGroup_UDP, ReservablePorts: "1024-65535"},
+						models.RouterGroup{Guid: "http-router-group-guid", Name: "default-http", Type: models.RouterGroup_HTTP},
+					)
+					for _, guid := range []string{"udp-router-group-guid", "http-router-group-guid"} {
+						tcpMapping.RouterGroupGuid = guid
+						err := validator.ValidateCreateTcpRouteMappings([]models.TcpRouteMapping{tcpMapping}, routerGroups, 120)
+						Expect(err).ToNot(BeNil())
+						Expect(err.Type).To(Equal(routing_api.TcpRouteMappingInvalidError))
+						Expect(err.Error()).To(ContainSubstring("router_group_guid: " + guid + " is not a tcp router group"))
+					}
+				})
+
 				It("blows up when TTL is greater than 120", func() {
 					*tcpMapping.TTL = 200
 					err := validator.ValidateCreateTcpRouteMappings([]models.TcpRouteMapping{tcpMapping}, routerGroups, 120)
@@ -356,6 +688,35 @@ var _ = Describe("Validator", func() {
 					Expect(err.Error()).To(ContainSubstring("Each tcp route mapping requires a ttl greater than 0"))
 				})
 
+				Context("when the router group has a ttl policy", func() {
+					BeforeEach(func() {
+						routerGroups[0].MinTTL = 30
+						routerGroups[0].MaxTTL = 600
+					})
+
+					It("allows ttls up to the max ttl of the router group", func() {
+						*tcpMapping.TTL = 600
+						err := validator.ValidateCreateTcpRouteMapping(tcpMapping, nil, routerGroups, 120)
+						Expect(err).To(BeNil())
+					})
+
+					It("blows up when TTL is greater than the max ttl of the router group", func() {
+						*tcpMapping.TTL = 601
+						err := validator.ValidateCreateTcpRouteMapping(tcpMapping, nil, routerGroups, 120)
+						Expect(err).ToNot(BeNil())
+						Expect(err.Type).To(Equal(routing_api.TcpRouteMappingInvalidError))
+						Expect(err.Error()).To(ContainSubstring("Each tcp mapping requires TTL to be less than or equal to 600"))
+					})
+
+					It("blows up when TTL is less than the min ttl of the router group", func() {
+						*tcpMapping.TTL = 29
+						err := validator.ValidateCreateTcpRouteMapping(tcpMapping, nil, routerGroups, 120)
+						Expect(err).ToNot(BeNil())
+						Expect(err.Type).To(Equal(routing_api.TcpRouteMappingInvalidError))
+						Expect(err.Error()).To(ContainSubstring("Each tcp mapping requires TTL to be greater than or equal to 30"))
+					})
+				})
+
 				It("blows up when TerminateFrontendTLS is disabled and ALPNs are defined", func() {
 					tcpMapping.ALPNs = "alpn1,alpn2"
 					err := validator.ValidateCreateTcpRouteMappings([]models.TcpRouteMapping{tcpMapping}, routerGroups, 120)
@@ -377,6 +738,467 @@ var _ = Describe("Validator", func() {
 					Expect(err.Type).To(Equal(routing_api.TcpRouteMappingInvalidError))
 					Expect(err.Error()).To(ContainSubstring("terminate_frontend_tls: true not allowed"))
 				})
+
+				Context("when the connection limits are bounded", func() {
+					BeforeEach(func() {
+						validator = handlers.NewValidator(models.PortPolicy{}, models.ConnectionLimitPolicy{MaxConnections: 100}, nil, models.BackendNetworkPolicy{})
+					})
+
+					It("allows max_connections up to the bound", func() {
+						tcpMapping.MaxConnections = 100
+						err := validator.ValidateCreateTcpRouteMapping(tcpMapping, nil, routerGroups, 120)
+						Expect(err).To(BeNil())
+					})
+
+					It("blows up when max_connections is greater than the bound", func() {
+						tcpMapping.MaxConnections = 101
+						err := validator.ValidateCreateTcpRouteMapping(tcpMapping, nil, routerGroups, 120)
+						Expect(err).ToNot(BeNil())
+						Expect(err.Type).To(Equal(routing_api.TcpRouteMappingInvalidError))
+						Expect(err.Error()).To(ContainSubstring("max_connections 101 is greater than the maximum of 100"))
+					})
+				})
+
+				Context("when the mapping terminates frontend tls with ALPNs", func() {
+					BeforeEach(func() {
+						tcpMapping.TerminateFrontendTLS = true
+						tcpMapping.ALPNs = "h2,http/1.1"
+					})
+
+					It("does not return error", func() {
+						err := validator.ValidateCreateTcpRouteMapping(tcpMapping, nil, routerGroups, 120)
+						Expect(err).To(BeNil())
+					})
+
+					It("blows up when an ALPN is not registered with IANA", func() {
+						tcpMapping.ALPNs = "h2,my-proto"
+						err := validator.ValidateCreateTcpRouteMapping(tcpMapping, nil, routerGroups, 120)
+						Expect(err).ToNot(BeNil())
+						Expect(err.Type).To(Equal(routing_api.TcpRouteMappingInvalidError))
+						Expect(err.Error()).To(ContainSubstring("alpn 'my-proto' is neither registered with IANA nor a configured custom alpn"))
+					})
+
+					It("allows custom ALPNs", func() {
+						validator = handlers.NewValidator(models.PortPolicy{}, models.ConnectionLimitPolicy{}, []string{"my-proto"}, models.BackendNetworkPolicy{})
+						tcpMapping.ALPNs = "h2,my-proto"
+						err := validator.ValidateCreateTcpRouteMapping(tcpMapping, nil, routerGroups, 120)
+						Expect(err).To(BeNil())
+					})
+
+					It("blows up when similar TcpRouteMappings negotiate different ALPNs", func() {
+						similarTcpRouteMappings := []models.TcpRouteMapping{
+							{TcpMappingEntity: models.TcpMappingEntity{
+								TerminateFrontendTLS: true,
+								ALPNs:                "http/1.1",
+							}},
+						}
+
+						err := validator.ValidateCreateTcpRouteMapping(tcpMapping, similarTcpRouteMappings, routerGroups, 120)
+						Expect(err).ToNot(BeNil())
+						Expect(err.Type).To(Equal(routing_api.TcpRouteMappingInvalidError))
+						Expect(err.Error()).To(ContainSubstring("alpns: h2,http/1.1 not allowed"))
+					})
+
+					It("does not blow up when the only similar TcpRouteMapping is the mapping itself with different ALPNs", func() {
+						existing := tcpMapping
+						existing.ALPNs = "http/1.1"
+
+						err := validator.ValidateCreateTcpRouteMapping(tcpMapping, []models.TcpRouteMapping{existing}, routerGroups, 120)
+						Expect(err).To(BeNil())
+					})
+
+					It("blows up when other similar TcpRouteMappings negotiate different ALPNs besides the mapping itself", func() {
+						existing := tcpMapping
+						existing.ALPNs = "http/1.1"
+						other := existing
+						other.HostIP = "10.0.0.99"
+
+						err := validator.ValidateCreateTcpRouteMapping(tcpMapping, []models.TcpRouteMapping{existing, other}, routerGroups, 120)
+						Expect(err).ToNot(BeNil())
+						Expect(err.Error()).To(ContainSubstring("alpns: h2,http/1.1 not allowed"))
+					})
+
+					It("does not blow up when similar TcpRouteMappings negotiate the same ALPNs", func() {
+						similarTcpRouteMappings := []models.TcpRouteMapping{
+							{TcpMappingEntity: models.TcpMappingEntity{
+								TerminateFrontendTLS: true,
+								ALPNs:                "h2, http/1.1",
+							}},
+						}
+
+						err := validator.ValidateCreateTcpRouteMapping(tcpMapping, similarTcpRouteMappings, routerGroups, 120)
+						Expect(err).To(BeNil())
+					})
+				})
+
+				Context("when the mapping references a tls certificate", func() {
+					BeforeEach(func() {
+						tcpMapping.TerminateFrontendTLS = true
+						tcpMapping.TlsCertificateGuid = "certificate-guid"
+					})
+
+					It("does not return error", func() {
+						err := validator.ValidateCreateTcpRouteMapping(tcpMapping, nil, routerGroups, 120)
+						Expect(err).To(BeNil())
+					})
+
+					It("blows up when the mapping does not terminate frontend tls", func() {
+						tcpMapping.TerminateFrontendTLS = false
+						err := validator.ValidateCreateTcpRouteMapping(tcpMapping, nil, routerGroups, 120)
+						Expect(err).ToNot(BeNil())
+						Expect(err.Type).To(Equal(routing_api.TcpRouteMappingInvalidError))
+						Expect(err.Error()).To(ContainSubstring("Each tcp mapping can reference a tls certificate only when TerminateFrontendTLS is enabled"))
+					})
+
+					It("blows up when similar TcpRouteMappings use a different certificate", func() {
+						similarTcpRouteMappings := []models.TcpRouteMapping{
+							{TcpMappingEntity: models.TcpMappingEntity{
+								TerminateFrontendTLS: true,
+								TlsCertificateGuid:   "other-certificate-guid",
+							}},
+						}
+
+						err := validator.ValidateCreateTcpRouteMapping(tcpMapping, similarTcpRouteMappings, routerGroups, 120)
+						Expect(err).ToNot(BeNil())
+						Expect(err.Type).To(Equal(routing_api.TcpRouteMappingInvalidError))
+						Expect(err.Error()).To(ContainSubstring("tls_certificate_guid: certificate-guid not allowed"))
+					})
+
+					It("does not blow up when the sole backend is re-registered with a new certificate", func() {
+						existing := tcpMapping
+						existing.TlsCertificateGuid = "old-certificate-guid"
+
+						err := validator.ValidateCreateTcpRouteMapping(tcpMapping, []models.TcpRouteMapping{existing}, routerGroups, 120)
+						Expect(err).To(BeNil())
+					})
+
+					It("blows up when other similar TcpRouteMappings use a different certificate besides the mapping itself", func() {
+						existing := tcpMapping
+						existing.TlsCertificateGuid = "old-certificate-guid"
+						other := existing
+						other.HostIP = "10.0.0.99"
+
+						err := validator.ValidateCreateTcpRouteMapping(tcpMapping, []models.TcpRouteMapping{existing, other}, routerGroups, 120)
+						Expect(err).ToNot(BeNil())
+						Expect(err.Error()).To(ContainSubstring("tls_certificate_guid: certificate-guid not allowed"))
+					})
+				})
+
+				Context("when the mapping references a backend tls profile", func() {
+					BeforeEach(func() {
+						tcpMapping.EnableBackendMTLS = true
+						tcpMapping.BackendTlsProfile = "iso-seg-1"
+					})
+
+					It("does not return error", func() {
+						err := validator.ValidateCreateTcpRouteMapping(tcpMapping, nil, routerGroups, 120)
+						Expect(err).To(BeNil())
+					})
+
+					It("blows up when the mapping does not enable backend mTLS", func() {
+						tcpMapping.EnableBackendMTLS = false
+						err := validator.ValidateCreateTcpRouteMapping(tcpMapping, nil, routerGroups, 120)
+						Expect(err).ToNot(BeNil())
+						Expect(err.Type).To(Equal(routing_api.TcpRouteMappingInvalidError))
+						Expect(err.Error()).To(ContainSubstring("Each tcp mapping can reference a backend tls profile only when EnableBackendMTLS is enabled"))
+					})
+				})
+
+				It("blows up when the SNI hostname is not a valid DNS name", func() {
+					sniHostname := "foo_bar.example.com"
+					tcpMapping.SniHostname = &sniHostname
+					err := validator.ValidateCreateTcpRouteMapping(tcpMapping, nil, routerGroups, 120)
+					Expect(err).ToNot(BeNil())
+					Expect(err.Type).To(Equal(routing_api.TcpRouteMappingInvalidError))
+					Expect(err.Error()).To(ContainSubstring("backend_sni_hostname: hostname 'foo_bar.example.com' is not a valid DNS name"))
+				})
+
+				It("allows a wildcard SNI hostname", func() {
+					sniHostname := "*.example.com"
+					tcpMapping.SniHostname = &sniHostname
+					err := validator.ValidateCreateTcpRouteMapping(tcpMapping, nil, routerGroups, 120)
+					Expect(err).To(BeNil())
+				})
+
+				It("blows up when the SNI rewrite hostname is a wildcard", func() {
+					sniRewriteHostname := "*.example.com"
+					tcpMapping.SniRewriteHostname = &sniRewriteHostname
+					err := validator.ValidateCreateTcpRouteMapping(tcpMapping, nil, routerGroups, 120)
+					Expect(err).ToNot(BeNil())
+					Expect(err.Type).To(Equal(routing_api.TcpRouteMappingInvalidError))
+					Expect(err.Error()).To(ContainSubstring("sni_rewrite_hostname: hostname '*.example.com' must not be a wildcard"))
+				})
+
+				It("blows up when the proxy protocol is unknown", func() {
+					tcpMapping.ProxyProtocol = "v3"
+					err := validator.ValidateCreateTcpRouteMapping(tcpMapping, nil, routerGroups, 120)
+					Expect(err).ToNot(BeNil())
+					Expect(err.Type).To(Equal(routing_api.TcpRouteMappingInvalidError))
+					Expect(err.Error()).To(ContainSubstring("Each tcp mapping requires proxy_protocol to be one of none, v1 or v2."))
+				})
+
+				It("blows up when similar TcpRouteMappings use a different proxy protocol", func() {
+					tcpMapping.ProxyProtocol = models.ProxyProtocolV2
+					similarTcpRouteMappings := []models.TcpRouteMapping{
+						{TcpMappingEntity: models.TcpMappingEntity{
+							ProxyProtocol: models.ProxyProtocolV1,
+						}},
+					}
+
+					err := validator.ValidateCreateTcpRouteMapping(tcpMapping, similarTcpRouteMappings, routerGroups, 120)
+					Expect(err).ToNot(BeNil())
+					Expect(err.Type).To(Equal(routing_api.TcpRouteMappingInvalidError))
+					Expect(err.Error()).To(ContainSubstring("proxy_protocol: v2 not allowed"))
+				})
+
+				It("does not blow up when the only similar TcpRouteMapping is the mapping itself with a different proxy protocol", func() {
+					tcpMapping.ProxyProtocol = models.ProxyProtocolV2
+					existing := tcpMapping
+					existing.ProxyProtocol = models.ProxyProtocolV1
+
+					err := validator.ValidateCreateTcpRouteMapping(tcpMapping, []models.TcpRouteMapping{existing}, routerGroups, 120)
+					Expect(err).To(BeNil())
+				})
+
+				It("does not blow up when similar TcpRouteMappings omit the proxy protocol and the mapping sends none", func() {
+					tcpMapping.ProxyProtocol = models.ProxyProtocolNone
+					similarTcpRouteMappings := []models.TcpRouteMapping{
+						{TcpMappingEntity: models.TcpMappingEntity{}},
+					}
+
+					err := validator.ValidateCreateTcpRouteMapping(tcpMapping, similarTcpRouteMappings, routerGroups, 120)
+					Expect(err).To(BeNil())
+				})
+
+				It("blows up when a label key is invalid", func() {
+					tcpMapping.Labels = models.LabelSet(`{"-env":"prod"}`)
+					err := validator.ValidateCreateTcpRouteMapping(tcpMapping, nil, routerGroups, 120)
+					Expect(err).ToNot(BeNil())
+					Expect(err.Type).To(Equal(routing_api.TcpRouteMappingInvalidError))
+					Expect(err.Error()).To(ContainSubstring("invalid label key -env"))
+				})
+
+				It("blows up when a label value is invalid", func() {
+					tcpMapping.Labels = models.LabelSet(`{"env":"prod stage"}`)
+					err := validator.ValidateCreateTcpRouteMapping(tcpMapping, nil, routerGroups, 120)
+					Expect(err).ToNot(BeNil())
+					Expect(err.Type).To(Equal(routing_api.TcpRouteMappingInvalidError))
+					Expect(err.Error()).To(ContainSubstring("invalid value for label env"))
+				})
+			})
+
+			Context("when the mapping forwards a port range", func() {
+				BeforeEach(func() {
+					tcpMapping.ExternalPortEnd = 52099
+				})
+
+				It("does not return error", func() {
+					err := validator.ValidateCreateTcpRouteMapping(tcpMapping, nil, routerGroups, 120)
+					Expect(err).To(BeNil())
+				})
+
+				It("blows up when the range does not ascend", func() {
+					tcpMapping.ExternalPortEnd = 51999
+					err := validator.ValidateCreateTcpRouteMapping(tcpMapping, nil, routerGroups, 120)
+					Expect(err).ToNot(BeNil())
+					Expect(err.Type).To(Equal(routing_api.TcpRouteMappingInvalidError))
+					Expect(err.Error()).To(ContainSubstring("port_end 51999 must be greater than port 52000"))
+				})
+
+				It("blows up when the backend ports overflow", func() {
+					tcpMapping.HostPort = 65500
+					err := validator.ValidateCreateTcpRouteMapping(tcpMapping, nil, routerGroups, 120)
+					Expect(err).ToNot(BeNil())
+					Expect(err.Error()).To(ContainSubstring("backend ports starting at 65500 cannot hold the 100 ports of the range"))
+				})
+
+				It("blows up when a port of the range is outside the reservable ports", func() {
+					routerGroups[0].ReservablePorts = "52000-52050"
+					err := validator.ValidateCreateTcpRouteMapping(tcpMapping, nil, routerGroups, 120)
+					Expect(err).ToNot(BeNil())
+					Expect(err.Type).To(Equal(routing_api.TcpRouteMappingInvalidError))
+					Expect(err.Error()).To(ContainSubstring("external port 52051 is not within the reservable ports"))
+				})
+
+				It("blows up when a port of the range is excluded", func() {
+					routerGroups[0].ExcludedPorts = "52042"
+					err := validator.ValidateCreateTcpRouteMapping(tcpMapping, nil, routerGroups, 120)
+					Expect(err).ToNot(BeNil())
+					Expect(err.Error()).To(ContainSubstring("external port 52042 is excluded by router group"))
+				})
+
+				It("does not blow up when the same range is live outside the reservable ports", func() {
+					routerGroups[0].ReservablePorts = "52000-52050"
+					similarTcpRouteMappings := []models.TcpRouteMapping{tcpMapping}
+					err := validator.ValidateCreateTcpRouteMapping(tcpMapping, similarTcpRouteMappings, routerGroups, 120)
+					Expect(err).To(BeNil())
+				})
+			})
+
+			Context("when the mapping has a health check", func() {
+				BeforeEach(func() {
+					tcpMapping.HealthCheck = &models.TcpHealthCheck{
+						Type:     models.TcpHealthCheckHTTP,
+						Interval: 10,
+						Timeout:  2,
+						Path:     "/health",
+					}
+				})
+
+				It("does not return error", func() {
+					err := validator.ValidateCreateTcpRouteMapping(tcpMapping, nil, routerGroups, 120)
+					Expect(err).To(BeNil())
+				})
+
+				It("blows up when the health check type is unknown", func() {
+					tcpMapping.HealthCheck.Type = "icmp"
+					err := validator.ValidateCreateTcpRouteMapping(tcpMapping, nil, routerGroups, 120)
+					Expect(err).ToNot(BeNil())
+					Expect(err.Type).To(Equal(routing_api.TcpRouteMappingInvalidError))
+					Expect(err.Error()).To(ContainSubstring("health_check type 'icmp' must be one of tcp-connect, tls-handshake, http"))
+				})
+
+				It("blows up when the timeout is greater than the interval", func() {
+					tcpMapping.HealthCheck.Timeout = 11
+					err := validator.ValidateCreateTcpRouteMapping(tcpMapping, nil, routerGroups, 120)
+					Expect(err).ToNot(BeNil())
+					Expect(err.Type).To(Equal(routing_api.TcpRouteMappingInvalidError))
+					Expect(err.Error()).To(ContainSubstring("health_check timeout 11 must not be greater than its interval 10"))
+				})
+			})
+		})
+
+		Describe("ValidateTcpRouteMappingOverlaps", func() {
+			var tcpMapping models.TcpRouteMapping
+
+			BeforeEach(func() {
+				tcpMapping = models.NewTcpRouteMapping(DefaultRouterGroupGuid, 40000, "1.2.3.4", 50000, 0, "instanceId", nil, nil, 60, models.ModificationTag{}, false, "")
+				tcpMapping.ExternalPortEnd = 40099
+			})
+
+			It("does not return error for mappings of the same range", func() {
+				sameRange := tcpMapping
+				sameRange.HostIP = "1.2.3.5"
+				err := validator.ValidateTcpRouteMappingOverlaps(tcpMapping, []models.TcpRouteMapping{sameRange})
+				Expect(err).To(BeNil())
+			})
+
+			It("blows up when a single port mapping is inside the range", func() {
+				single := models.NewTcpRouteMapping(DefaultRouterGroupGuid, 40042, "1.2.3.5", 8080, 0, "", nil, nil, 60, models.ModificationTag{}, false, "")
+				err := validator.ValidateTcpRouteMappingOverlaps(tcpMapping, []models.TcpRouteMapping{single})
+				Expect(err).ToNot(BeNil())
+				Expect(err.Type).To(Equal(routing_api.TcpRouteMappingInvalidError))
+				Expect(err.Error()).To(ContainSubstring("external ports [40000-40099] overlap with tcp mapping [" + DefaultRouterGroupGuid + ":40042<->1.2.3.5:8080]"))
+			})
+
+			It("blows up when a single port mapping is inside a live range", func() {
+				single := models.NewTcpRouteMapping(DefaultRouterGroupGuid, 40042, "1.2.3.5", 8080, 0, "", nil, nil, 60, models.ModificationTag{}, false, "")
+				err := validator.ValidateTcpRouteMappingOverlaps(single, []models.TcpRouteMapping{tcpMapping})
+				Expect(err).ToNot(BeNil())
+				Expect(err.Error()).To(ContainSubstring("external ports 40042 overlap with tcp mapping"))
+			})
+		})
+
+		Describe("ValidateTcpRouteMappingTlsCertificate", func() {
+			var (
+				tcpMapping     models.TcpRouteMapping
+				tlsCertificate models.TlsCertificate
+			)
+
+			BeforeEach(func() {
+				sniHostname := "app.example.com"
+				tcpMapping = models.NewTcpRouteMapping(DefaultRouterGroupGuid, 52000, "1.2.3.4", 60000, 0, "instanceId", &sniHostname, nil, 60, models.ModificationTag{}, true, "")
+				tcpMapping.TlsCertificateGuid = "certificate-guid"
+
+				tlsCertificate = models.NewTlsCertificate("app.example.com", "", "")
+				tlsCertificate.Guid = "certificate-guid"
+				tlsCertificate.NotAfter = time.Now().Add(time.Hour)
+			})
+
+			It("does not return error", func() {
+				err := validator.ValidateTcpRouteMappingTlsCertificate(tcpMapping, tlsCertificate)
+				Expect(err).To(BeNil())
+			})
+
+			It("accepts a wildcard certificate for the SNI hostname", func() {
+				tlsCertificate.SniHostname = "*.example.com"
+				err := validator.ValidateTcpRouteMappingTlsCertificate(tcpMapping, tlsCertificate)
+				Expect(err).To(BeNil())
+			})
+
+			It("blows up when the certificate does not exist", func() {
+				err := validator.ValidateTcpRouteMappingTlsCertificate(tcpMapping, models.TlsCertificate{})
+				Expect(err).ToNot(BeNil())
+				Expect(err.Type).To(Equal(routing_api.TcpRouteMappingInvalidError))
+				Expect(err.Error()).To(ContainSubstring("tls_certificate_guid: certificate-guid not found"))
+			})
+
+			It("blows up when the certificate does not cover the SNI hostname", func() {
+				tlsCertificate.SniHostname = "other.example.com"
+				err := validator.ValidateTcpRouteMappingTlsCertificate(tcpMapping, tlsCertificate)
+				Expect(err).ToNot(BeNil())
+				Expect(err.Type).To(Equal(routing_api.TcpRouteMappingInvalidError))
+				Expect(err.Error()).To(ContainSubstring("tls certificate certificate-guid for sni_hostname 'other.example.com' is not valid for backend_sni_hostname 'app.example.com'"))
+			})
+
+			It("blows up when the certificate has expired", func() {
+				tlsCertificate.NotAfter = time.Now().Add(-time.Hour)
+				err := validator.ValidateTcpRouteMappingTlsCertificate(tcpMapping, tlsCertificate)
+				Expect(err).ToNot(BeNil())
+				Expect(err.Type).To(Equal(routing_api.TcpRouteMappingInvalidError))
+				Expect(err.Error()).To(ContainSubstring("tls certificate certificate-guid expired at"))
+			})
+		})
+
+		Describe("ValidateTcpRouteMappingBackendTlsProfile", func() {
+			var (
+				tcpMapping        models.TcpRouteMapping
+				backendTlsProfile models.BackendTlsProfile
+			)
+
+			BeforeEach(func() {
+				tcpMapping = models.NewTcpRouteMapping(DefaultRouterGroupGuid, 52000, "1.2.3.4", 60000, 0, "instanceId", nil, nil, 60, models.ModificationTag{}, false, "")
+				tcpMapping.IsolationSegment = "iso-seg-1"
+				tcpMapping.EnableBackendMTLS = true
+				tcpMapping.BackendTlsProfile = "iso-seg-1"
+
+				backendTlsProfile = models.NewBackendTlsProfile("iso-seg-1", "", "", "", "")
+				backendTlsProfile.Guid = "profile-guid"
+				backendTlsProfile.NotAfter = time.Now().Add(time.Hour)
+			})
+
+			It("does not return error", func() {
+				err := validator.ValidateTcpRouteMappingBackendTlsProfile(tcpMapping, backendTlsProfile)
+				Expect(err).To(BeNil())
+			})
+
+			It("accepts a profile reserved for the isolation segment of the mapping", func() {
+				backendTlsProfile.IsolationSegment = "iso-seg-1"
+				err := validator.ValidateTcpRouteMappingBackendTlsProfile(tcpMapping, backendTlsProfile)
+				Expect(err).To(BeNil())
+			})
+
+			It("blows up when the profile does not exist", func() {
+				err := validator.ValidateTcpRouteMappingBackendTlsProfile(tcpMapping, models.BackendTlsProfile{})
+				Expect(err).ToNot(BeNil())
+				Expect(err.Type).To(Equal(routing_api.TcpRouteMappingInvalidError))
+				Expect(err.Error()).To(ContainSubstring("backend_tls_profile: iso-seg-1 not found"))
+			})
+
+			It("blows up when the profile is reserved for another isolation segment", func() {
+				backendTlsProfile.IsolationSegment = "iso-seg-2"
+				err := validator.ValidateTcpRouteMappingBackendTlsProfile(tcpMapping, backendTlsProfile)
+				Expect(err).ToNot(BeNil())
+				Expect(err.Type).To(Equal(routing_api.TcpRouteMappingInvalidError))
+				Expect(err.Error()).To(ContainSubstring("backend tls profile iso-seg-1 is reserved for isolation segment 'iso-seg-2'"))
+			})
+
+			It("blows up when the client certificate of the profile has expired", func() {
+				backendTlsProfile.NotAfter = time.Now().Add(-time.Hour)
+				err := validator.ValidateTcpRouteMappingBackendTlsProfile(tcpMapping, backendTlsProfile)
+				Expect(err).ToNot(BeNil())
+				Expect(err.Type).To(Equal(routing_api.TcpRouteMappingInvalidError))
+				Expect(err.Error()).To(ContainSubstring("backend tls profile iso-seg-1 has a client certificate that expired at"))
 			})
 		})
 
@@ -448,6 +1270,12 @@ var _ = Describe("Validator", func() {
 					Expect(err.Error()).To(ContainSubstring("Each tcp mapping requires a non empty backend ip"))
 				})
 
+				It("does not blow up when backend ip is not an IPv4 or IPv6 address", func() {
+					tcpMapping.HostIP = "1.2.3"
+					err := validator.ValidateDeleteTcpRouteMapping([]models.TcpRouteMapping{tcpMapping})
+					Expect(err).To(BeNil())
+				})
+
 				It("blows up when group guid is empty", func() {
 					tcpMapping.RouterGroupGuid = ""
 					err := validator.ValidateDeleteTcpRouteMapping([]models.TcpRouteMapping{tcpMapping})
@@ -461,6 +1289,168 @@ var _ = Describe("Validator", func() {
 					err := validator.ValidateDeleteTcpRouteMapping([]models.TcpRouteMapping{tcpMapping})
 					Expect(err).To(BeNil())
 				})
+
+				It("does not validate the sni hostnames", func() {
+					sniHostname := "foo_bar..example.com"
+					sniRewriteHostname := "*.example.com"
+					tcpMapping.SniHostname = &sniHostname
+					tcpMapping.SniRewriteHostname = &sniRewriteHostname
+					err := validator.ValidateDeleteTcpRouteMapping([]models.TcpRouteMapping{tcpMapping})
+					Expect(err).To(BeNil())
+				})
+			})
+		})
+	})
+
+	Describe("UdpRouteMappings", func() {
+		var udpMapping models.UdpRouteMapping
+
+		BeforeEach(func() {
+			udpMapping = models.NewUdpRouteMapping("udp-router-group-guid", 5300, "1.2.3.4", 53, "instanceId", 60, models.ModificationTag{})
+		})
+
+		Describe("ValidateCreateUdpRouteMapping", func() {
+			var routerGroups models.RouterGroups
+
+			BeforeEach(func() {
+				routerGroups = models.RouterGroups{
+					{
+						Guid:            "udp-router-group-guid",
+						Name:            "default-udp",
+						Type:            models.RouterGroup_UDP,
+						ReservablePorts: "5000-6000",
+					},
+					{
+						Guid:            DefaultRouterGroupGuid,
+						Name:            "default-tcp",
+						Type:            models.RouterGroup_TCP,
+						ReservablePorts: "1024-65535",
+					},
+				}
+			})
+
+			It("does not return error for a valid udp mapping", func() {
+				err := validator.ValidateCreateUdpRouteMapping(udpMapping, routerGroups, 120)
+				Expect(err).To(BeNil())
+			})
+
+			It("blows up when the router group is unknown", func() {
+				udpMapping.RouterGroupGuid = "unknown-router-group-guid"
+				err := validator.ValidateCreateUdpRouteMapping(udpMapping, routerGroups, 120)
+				Expect(err).ToNot(BeNil())
+				Expect(err.Type).To(Equal(routing_api.UdpRouteMappingInvalidError))
+				Expect(err.Error()).To(ContainSubstring("router_group_guid: unknown-router-group-guid not found"))
+			})
+
+			It("blows up when the router group is not of type udp", func() {
+				udpMapping.RouterGroupGuid = DefaultRouterGroupGuid
+				err := validator.ValidateCreateUdpRouteMapping(udpMapping, routerGroups, 120)
+				Expect(err).ToNot(BeNil())
+				Expect(err.Type).To(Equal(routing_api.UdpRouteMappingInvalidError))
+				Expect(err.Error()).To(ContainSubstring("is not a udp router group"))
+			})
+
+			It("blows up when the external port is outside the reservable ports", func() {
+				udpMapping.ExternalPort = 7000
+				err := validator.ValidateCreateUdpRouteMapping(udpMapping, routerGroups, 120)
+				Expect(err).ToNot(BeNil())
+				Expect(err.Type).To(Equal(routing_api.UdpRouteMappingInvalidError))
+				Expect(err.Error()).To(ContainSubstring("RouteMapping=["))
+			})
+
+			It("blows up when the external port is zero", func() {
+				udpMapping.ExternalPort = 0
+				err := validator.ValidateCreateUdpRouteMapping(udpMapping, routerGroups, 120)
+				Expect(err).ToNot(BeNil())
+				Expect(err.Error()).To(ContainSubstring("Each udp mapping requires a positive external port"))
+			})
+
+			It("blows up when ttl is greater than max ttl", func() {
+				ttl := 121
+				udpMapping.TTL = &ttl
+				err := validator.ValidateCreateUdpRouteMapping(udpMapping, routerGroups, 120)
+				Expect(err).ToNot(BeNil())
+				Expect(err.Error()).To(ContainSubstring("Each udp mapping requires TTL to be less than or equal to 120"))
+			})
+
+			It("blows up when ttl is equal to 0", func() {
+				ttl := 0
+				udpMapping.TTL = &ttl
+				err := validator.ValidateCreateUdpRouteMapping(udpMapping, routerGroups, 120)
+				Expect(err).ToNot(BeNil())
+				Expect(err.Type).To(Equal(routing_api.UdpRouteMappingInvalidError))
+				Expect(err.Error()).To(ContainSubstring("Each udp route mapping requires a ttl greater than 0"))
+			})
+
+			It("blows up when backend ip is not an IPv4 or IPv6 address", func() {
+				udpMapping.HostIP = "1.2.3"
+				err := validator.ValidateCreateUdpRouteMapping(udpMapping, routerGroups, 120)
+				Expect(err).ToNot(BeNil())
+				Expect(err.Type).To(Equal(routing_api.UdpRouteMappingInvalidError))
+				Expect(err.Error()).To(ContainSubstring("backend ip '1.2.3' is not an IPv4 or IPv6 address"))
+			})
+
+			Context("when backend networks are restricted", func() {
+				BeforeEach(func() {
+					validator = handlers.NewValidator(models.PortPolicy{}, models.ConnectionLimitPolicy{}, nil, models.BackendNetworkPolicy{
+						AllowedCIDRs: []netip.Prefix{netip.MustParsePrefix("1.2.0.0/16")},
+					})
+				})
+
+				It("does not return error for a backend ip in the allowed networks", func() {
+					err := validator.ValidateCreateUdpRouteMapping(udpMapping, routerGroups, 120)
+					Expect(err).To(BeNil())
+				})
+
+				It("blows up when the backend ip is outside the allowed networks", func() {
+					udpMapping.HostIP = "2001:db8::1"
+					err := validator.ValidateCreateUdpRouteMapping(udpMapping, routerGroups, 120)
+					Expect(err).ToNot(BeNil())
+					Expect(err.Type).To(Equal(routing_api.UdpRouteMappingInvalidError))
+					Expect(err.Error()).To(ContainSubstring("backend ip 2001:db8::1 is not within the allowed networks"))
+				})
+
+				It("blows up when the router group denies the backend ip", func() {
+					routerGroups[0].DeniedBackendCIDRs = "1.2.3.0/24"
+					err := validator.ValidateCreateUdpRouteMapping(udpMapping, routerGroups, 120)
+					Expect(err).ToNot(BeNil())
+					Expect(err.Type).To(Equal(routing_api.UdpRouteMappingInvalidError))
+					Expect(err.Error()).To(ContainSubstring("backend ip 1.2.3.4 is within the denied network 1.2.3.0/24"))
+				})
+			})
+
+			It("applies the ttl policy of the router group", func() {
+				routerGroups[0].MinTTL = 90
+				err := validator.ValidateCreateUdpRouteMapping(udpMapping, routerGroups, 120)
+				Expect(err).ToNot(BeNil())
+				Expect(err.Error()).To(ContainSubstring("Each udp mapping requires TTL to be greater than or equal to 90"))
+			})
+		})
+
+		Describe("ValidateDeleteUdpRouteMapping", func() {
+			It("does not return error for a valid udp mapping", func() {
+				err := validator.ValidateDeleteUdpRouteMapping([]models.UdpRouteMapping{udpMapping})
+				Expect(err).To(BeNil())
+			})
+
+			It("blows up when backend ip is empty", func() {
+				udpMapping.HostIP = ""
+				err := validator.ValidateDeleteUdpRouteMapping([]models.UdpRouteMapping{udpMapping})
+				Expect(err).ToNot(BeNil())
+				Expect(err.Type).To(Equal(routing_api.UdpRouteMappingInvalidError))
+				Expect(err.Error()).To(ContainSubstring("Each udp mapping requires a non empty backend ip"))
+			})
+
+			It("does not blow up when group guid is unknown", func() {
+				udpMapping.RouterGroupGuid = "unknown-router-group-guid"
+				err := validator.ValidateDeleteUdpRouteMapping([]models.UdpRouteMapping{udpMapping})
+				Expect(err).To(BeNil())
+			})
+
+			It("does not blow up when backend ip is not an IPv4 or IPv6 address", func() {
+				udpMapping.HostIP = "1.2.3"
+				err := validator.ValidateDeleteUdpRouteMapping([]models.UdpRouteMapping{udpMapping})
+				Expect(err).To(BeNil())
 			})
 		})
 	})
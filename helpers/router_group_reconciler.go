@@ -0,0 +1,238 @@
+package helpers
+
+import (
+	"fmt"
+	"os"
+
+	"code.cloudfoundry.org/lager/v3"
+	"code.cloudfoundry.org/routing-api/db"
+	"code.cloudfoundry.org/routing-api/models"
+	uuid "github.com/nu7hatch/gouuid"
+)
+
+// ReconcileOptions controls what a reconciliation may change besides
+// creating and updating router groups.
+type ReconcileOptions struct {
+	// Prune deletes router groups missing from the configuration.
+	Prune bool
+	// Force saves reservable ports that no longer include the external ports
+	// of live tcp route mappings. Those router groups are skipped otherwise.
+	Force bool
+}
+
+// RouterGroupsLoader returns the router groups of the configuration and the
+// options to reconcile them with.
+type RouterGroupsLoader func() (models.RouterGroups, ReconcileOptions, error)
+
+// RouterGroupChange is a router group whose reservable ports, TTL policy or
+// quotas were updated.
+// StrandedTcpMappings lists the live tcp route mappings whose external port is
+// no longer within the reservable ports.
+type RouterGroupChange struct {
+	Name                string                   `json:"name"`
+	OldReservablePorts  models.ReservablePorts   `json:"old_reservable_ports"`
+	NewReservablePorts  models.ReservablePorts   `json:"new_reservable_ports"`
+	StrandedTcpMappings []models.TcpRouteMapping `json:"stranded_tcp_routes,omitempty"`
+}
+
+// SkippedRouterGroup is a router group that could not be reconciled.
+type SkippedRouterGroup struct {
+	Name   string `json:"name"`
+	Reason string `json:"reason"`
+}
+
+// RouterGroupsDiff describes what a reconciliation did.
+type RouterGroupsDiff struct {
+	Created []models.RouterGroup `json:"created"`
+	Updated []RouterGroupChange  `json:"updated"`
+	Pruned  []models.RouterGroup `json:"pruned"`
+	Skipped []SkippedRouterGroup `json:"skipped"`
+}
+
+func (d RouterGroupsDiff) Empty() bool {
+	return len(d.Created) == 0 && len(d.Updated) == 0 && len(d.Pruned) == 0 && len(d.Skipped) == 0
+}
+
+// RouterGroupReconciler makes the router groups in the database match the
+// configuration at startup and whenever it receives on reload. Router groups
+// are matched by name; missing ones are created and the reservable ports, TTL
+// policy and quotas of existing ones are updated, unless new reservable ports
+// would strand live tcp route mappings and the change is not forced. When
+// pruning, router groups missing from the configuration are deleted unless
+// they have tcp route mappings or port reservations.
+type RouterGroupReconciler struct {
+	database     db.DB
+	routerGroups models.RouterGroups
+	options      ReconcileOptions
+	load         RouterGroupsLoader
+	reload       <-chan os.Signal
+	logger       lager.Logger
+}
+
+func NewRouterGroupReconciler(database db.DB, routerGroups models.RouterGroups, options ReconcileOptions, load RouterGroupsLoader, reload <-chan os.Signal, logger lager.Logger) *RouterGroupReconciler {
+	return &RouterGroupReconciler{
+		database:     database,
+		routerGroups: routerGroups,
+		options:      options,
+		load:         load,
+		reload:       reload,
+		logger:       logger,
+	}
+}
+
+func (r *RouterGroupReconciler) Run(signals <-chan os.Signal, ready chan<- struct{}) error {
+	_, err := r.Reconcile(r.routerGroups, r.options)
+	if err != nil {
+		r.logger.Error("failed-to-reconcile-router-groups", err)
+		return err
+	}
+	close(ready)
+
+	for {
+		select {
+		case <-r.reload:
+			routerGroups, options, err := r.load()
+			if err != nil {
+				r.logger.Error("failed-to-reload-router-groups", err)
+				continue
+			}
+			_, err = r.Reconcile(routerGroups, options)
+			if err != nil {
+				r.logger.Error("failed-to-reconcile-router-groups", err)
+			}
+		case sig := <-signals:
+			r.logger.Info("received-signal", lager.Data{"signal": sig})
+			return nil
+		}
+	}
+}
+
+// Reconcile applies the router groups to the database and logs the
+// resulting diff, which is also logged when an error stops it part way.
+// Pruning is skipped when there are no router groups, so that an empty
+// configuration does not delete every router group.
+func (r *RouterGroupReconciler) Reconcile(routerGroups models.RouterGroups, options ReconcileOptions) (RouterGroupsDiff, error) {
+	diff, err := r.reconcile(routerGroups, options)
+	if !diff.Empty() {
+		r.logger.Info("reconciled-router-groups", lager.Data{"diff": diff})
+	} else if err == nil {
+		r.logger.Info("router-groups-up-to-date")
+	}
+	return diff, err
+}
+
+func (r *RouterGroupReconciler) reconcile(routerGroups models.RouterGroups, options ReconcileOptions) (RouterGroupsDiff, error) {
+	diff := RouterGroupsDiff{
+		Created: []models.RouterGroup{},
+		Updated: []RouterGroupChange{},
+		Pruned:  []models.RouterGroup{},
+		Skipped: []SkippedRouterGroup{},
+	}
+
+	existing, err := r.database.ReadRouterGroups()
+	if err != nil {
+		return diff, err
+	}
+	existingByName := map[string]models.RouterGroup{}
+	for _, rg := range existing {
+		existingByName[rg.Name] = rg
+	}
+
+	configured := map[string]bool{}
+	for _, rg := range routerGroups {
+		configured[rg.Name] = true
+
+		current, ok := existingByName[rg.Name]
+		if !ok {
+			guid, err := uuid.NewV4()
+			if err != nil {
+				return diff, err
+			}
+			rg.Guid = guid.String()
+			err = r.database.SaveRouterGroup(rg)
+			if err != nil {
+				return diff, err
+			}
+			diff.Created = append(diff.Created, rg)
+			continue
+		}
+
+		if current.Type != rg.Type {
+			diff.Skipped = append(diff.Skipped, SkippedRouterGroup{
+				Name:   rg.Name,
+				Reason: fmt.Sprintf("type %s does not match the configured type %s", current.Type, rg.Type),
+			})
+			continue
+		}
+
+		samePolicy := current.MinTTL == rg.MinTTL && current.MaxTTL == rg.MaxTTL && current.DefaultTTL == rg.DefaultTTL &&
+			current.MaxTcpRoutes == rg.MaxTcpRoutes && current.MaxTcpRoutesPerIsolationSegment == rg.MaxTcpRoutesPerIsolationSegment &&
+			current.ExcludedPorts == rg.ExcludedPorts &&
+			current.AllowedBackendCIDRs == rg.AllowedBackendCIDRs && current.DeniedBackendCIDRs == rg.DeniedBackendCIDRs
+		if current.ReservablePorts == rg.ReservablePorts && samePolicy {
+			continue
+		}
+
+		change := RouterGroupChange{
+			Name:               rg.Name,
+			OldReservablePorts: current.ReservablePorts,
+			NewReservablePorts: rg.ReservablePorts,
+		}
+		current.MinTTL = rg.MinTTL
+		current.MaxTTL = rg.MaxTTL
+		current.DefaultTTL = rg.DefaultTTL
+		current.MaxTcpRoutes = rg.MaxTcpRoutes
+		current.MaxTcpRoutesPerIsolationSegment = rg.MaxTcpRoutesPerIsolationSegment
+		current.ExcludedPorts = rg.ExcludedPorts
+		current.AllowedBackendCIDRs = rg.AllowedBackendCIDRs
+		current.DeniedBackendCIDRs = rg.DeniedBackendCIDRs
+
+		if current.ReservablePorts != rg.ReservablePorts {
+			current.ReservablePorts = rg.ReservablePorts
+
+			mappings, err := r.database.ReadTcpRouteMappings()
+			if err != nil {
+				return diff, err
+			}
+			change.StrandedTcpMappings, err = current.StrandedTcpRouteMappings(mappings)
+			if err != nil {
+				return diff, err
+			}
+			if len(change.StrandedTcpMappings) > 0 && !options.Force {
+				diff.Skipped = append(diff.Skipped, SkippedRouterGroup{
+					Name:   rg.Name,
+					Reason: fmt.Sprintf("reservable_ports %s would exclude the external ports of %d tcp routes", rg.ReservablePorts, len(change.StrandedTcpMappings)),
+				})
+				continue
+			}
+		}
+
+		err = r.database.SaveRouterGroup(current)
+		if err != nil {
+			return diff, err
+		}
+		diff.Updated = append(diff.Updated, change)
+	}
+
+	if options.Prune && len(routerGroups) == 0 {
+		r.logger.Info("skipping-prune-without-router-groups")
+	} else if options.Prune {
+		for _, rg := range existing {
+			if configured[rg.Name] {
+				continue
+			}
+
+			err = r.database.DeleteRouterGroup(rg.Guid)
+			if dberr, ok := err.(db.DBError); ok && dberr.Type == db.InUse {
+				diff.Skipped = append(diff.Skipped, SkippedRouterGroup{Name: rg.Name, Reason: dberr.Message})
+				continue
+			}
+			if err != nil {
+				return diff, err
+			}
+			diff.Pruned = append(diff.Pruned, rg)
+		}
+	}
+
+	return diff, nil
+}
@@ -0,0 +1,299 @@
+package helpers_test
+
+import (
+	"errors"
+	"os"
+	"syscall"
+
+	"code.cloudfoundry.org/lager/v3/lagertest"
+	"code.cloudfoundry.org/routing-api/db"
+	fake_db "code.cloudfoundry.org/routing-api/db/fakes"
+	"code.cloudfoundry.org/routing-api/helpers"
+	"code.cloudfoundry.org/routing-api/models"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+	"github.com/tedsuo/ifrit"
+)
+
+var _ = Describe("RouterGroupReconciler", func() {
+	var (
+		reconciler *helpers.RouterGroupReconciler
+		database   *fake_db.FakeDB
+		logger     *lagertest.TestLogger
+		reload     chan os.Signal
+
+		existingTCP  models.RouterGroup
+		existingHTTP models.RouterGroup
+		configured   models.RouterGroups
+		options      helpers.ReconcileOptions
+
+		loaded  models.RouterGroups
+		loadErr error
+	)
+
+	BeforeEach(func() {
+		database = &fake_db.FakeDB{}
+		logger = lagertest.NewTestLogger("router-group-reconciler-test")
+		reload = make(chan os.Signal, 1)
+
+		existingTCP = models.RouterGroup{
+			Guid:            "tcp-guid",
+			Name:            "default-tcp",
+			Type:            models.RouterGroup_TCP,
+			ReservablePorts: "1024-2048",
+		}
+		existingHTTP = models.RouterGroup{
+			Guid: "http-guid",
+			Name: "default-http",
+			Type: models.RouterGroup_HTTP,
+		}
+		database.ReadRouterGroupsReturns(models.RouterGroups{existingTCP, existingHTTP}, nil)
+
+		configured = models.RouterGroups{existingTCP, existingHTTP}
+		options = helpers.ReconcileOptions{}
+
+		loaded = nil
+		loadErr = nil
+	})
+
+	JustBeforeEach(func() {
+		load := func() (models.RouterGroups, helpers.ReconcileOptions, error) {
+			return loaded, helpers.ReconcileOptions{Prune: true}, loadErr
+		}
+		reconciler = helpers.NewRouterGroupReconciler(database, configured, options, load, reload, logger)
+	})
+
+	Describe("Reconcile", func() {
+		It("does nothing when the router groups match", func() {
+			diff, err := reconciler.Reconcile(configured, helpers.ReconcileOptions{Prune: true})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(diff.Empty()).To(BeTrue())
+			Expect(database.SaveRouterGroupCallCount()).To(Equal(0))
+			Expect(database.DeleteRouterGroupCallCount()).To(Equal(0))
+			Expect(logger).To(gbytes.Say("router-groups-up-to-date"))
+		})
+
+		It("creates missing router groups", func() {
+			newGroup := models.RouterGroup{Name: "new-tcp", Type: models.RouterGroup_TCP, ReservablePorts: "3000"}
+			diff, err := reconciler.Reconcile(append(configured, newGroup), helpers.ReconcileOptions{})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(database.SaveRouterGroupCallCount()).To(Equal(1))
+			saved := database.SaveRouterGroupArgsForCall(0)
+			Expect(saved.Guid).NotTo(BeEmpty())
+			Expect(saved.Name).To(Equal("new-tcp"))
+			Expect(saved.ReservablePorts).To(Equal(models.ReservablePorts("3000")))
+			Expect(diff.Created).To(ConsistOf(saved))
+			Expect(logger).To(gbytes.Say("reconciled-router-groups"))
+		})
+
+		It("updates the reservable ports of changed router groups", func() {
+			database.ReadTcpRouteMappingsReturns([]models.TcpRouteMapping{
+				models.NewTcpRouteMapping("tcp-guid", 1024, "10.0.0.2", 8080, 0, "", nil, nil, 60, models.ModificationTag{}, false, ""),
+			}, nil)
+
+			changed := existingTCP
+			changed.Guid = ""
+			changed.ReservablePorts = "1024-1500"
+			diff, err := reconciler.Reconcile(models.RouterGroups{changed, existingHTTP}, helpers.ReconcileOptions{})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(database.SaveRouterGroupCallCount()).To(Equal(1))
+			saved := database.SaveRouterGroupArgsForCall(0)
+			Expect(saved.Guid).To(Equal("tcp-guid"))
+			Expect(saved.ReservablePorts).To(Equal(models.ReservablePorts("1024-1500")))
+
+			Expect(diff.Updated).To(HaveLen(1))
+			Expect(diff.Updated[0].Name).To(Equal("default-tcp"))
+			Expect(diff.Updated[0].OldReservablePorts).To(Equal(models.ReservablePorts("1024-2048")))
+			Expect(diff.Updated[0].NewReservablePorts).To(Equal(models.ReservablePorts("1024-1500")))
+			Expect(diff.Updated[0].StrandedTcpMappings).To(BeEmpty())
+		})
+
+		It("skips router groups whose reservable ports would strand tcp routes", func() {
+			database.ReadTcpRouteMappingsReturns([]models.TcpRouteMapping{
+				models.NewTcpRouteMapping("tcp-guid", 2000, "10.0.0.1", 8080, 0, "", nil, nil, 60, models.ModificationTag{}, false, ""),
+			}, nil)
+
+			changed := existingTCP
+			changed.Guid = ""
+			changed.ReservablePorts = "1024-1500"
+			diff, err := reconciler.Reconcile(models.RouterGroups{changed, existingHTTP}, helpers.ReconcileOptions{})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(database.SaveRouterGroupCallCount()).To(Equal(0))
+			Expect(diff.Updated).To(BeEmpty())
+			Expect(diff.Skipped).To(ConsistOf(helpers.SkippedRouterGroup{
+				Name:   "default-tcp",
+				Reason: "reservable_ports 1024-1500 would exclude the external ports of 1 tcp routes",
+			}))
+		})
+
+		It("updates the reservable ports and reports stranded tcp routes when forced", func() {
+			stranded := models.NewTcpRouteMapping("tcp-guid", 2000, "10.0.0.1", 8080, 0, "", nil, nil, 60, models.ModificationTag{}, false, "")
+			database.ReadTcpRouteMappingsReturns([]models.TcpRouteMapping{
+				stranded,
+				models.NewTcpRouteMapping("tcp-guid", 1024, "10.0.0.2", 8080, 0, "", nil, nil, 60, models.ModificationTag{}, false, ""),
+			}, nil)
+
+			changed := existingTCP
+			changed.Guid = ""
+			changed.ReservablePorts = "1024-1500"
+			diff, err := reconciler.Reconcile(models.RouterGroups{changed, existingHTTP}, helpers.ReconcileOptions{Force: true})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(database.SaveRouterGroupCallCount()).To(Equal(1))
+			saved := database.SaveRouterGroupArgsForCall(0)
+			Expect(saved.Guid).To(Equal("tcp-guid"))
+			Expect(saved.ReservablePorts).To(Equal(models.ReservablePorts("1024-1500")))
+
+			Expect(diff.Updated).To(HaveLen(1))
+			Expect(diff.Updated[0].Name).To(Equal("default-tcp"))
+			Expect(diff.Updated[0].OldReservablePorts).To(Equal(models.ReservablePorts("1024-2048")))
+			Expect(diff.Updated[0].NewReservablePorts).To(Equal(models.ReservablePorts("1024-1500")))
+			Expect(diff.Updated[0].StrandedTcpMappings).To(ConsistOf(stranded))
+		})
+
+		It("updates the ttl policy of changed router groups", func() {
+			changed := existingHTTP
+			changed.MaxTTL = 300
+			diff, err := reconciler.Reconcile(models.RouterGroups{existingTCP, changed}, helpers.ReconcileOptions{})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(database.SaveRouterGroupCallCount()).To(Equal(1))
+			Expect(database.SaveRouterGroupArgsForCall(0).MaxTTL).To(Equal(300))
+			Expect(database.ReadTcpRouteMappingsCallCount()).To(Equal(0))
+			Expect(diff.Updated).To(HaveLen(1))
+			Expect(diff.Updated[0].Name).To(Equal("default-http"))
+		})
+
+		It("updates the backend networks of changed router groups", func() {
+			changed := existingHTTP
+			changed.AllowedBackendCIDRs = "10.0.0.0/8"
+			changed.DeniedBackendCIDRs = "10.255.0.0/16"
+			_, err := reconciler.Reconcile(models.RouterGroups{existingTCP, changed}, helpers.ReconcileOptions{})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(database.SaveRouterGroupCallCount()).To(Equal(1))
+			Expect(database.SaveRouterGroupArgsForCall(0).AllowedBackendCIDRs).To(Equal(models.CIDRs("10.0.0.0/8")))
+			Expect(database.SaveRouterGroupArgsForCall(0).DeniedBackendCIDRs).To(Equal(models.CIDRs("10.255.0.0/16")))
+		})
+
+		It("skips router groups whose type differs from the configuration", func() {
+			changed := existingHTTP
+			changed.Type = models.RouterGroup_TCP
+			changed.ReservablePorts = "3000"
+			diff, err := reconciler.Reconcile(models.RouterGroups{existingTCP, changed}, helpers.ReconcileOptions{})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(database.SaveRouterGroupCallCount()).To(Equal(0))
+			Expect(diff.Skipped).To(ConsistOf(helpers.SkippedRouterGroup{
+				Name:   "default-http",
+				Reason: "type http does not match the configured type tcp",
+			}))
+		})
+
+		It("keeps router groups missing from the configuration when not pruning", func() {
+			diff, err := reconciler.Reconcile(models.RouterGroups{existingTCP}, helpers.ReconcileOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(diff.Empty()).To(BeTrue())
+			Expect(database.DeleteRouterGroupCallCount()).To(Equal(0))
+		})
+
+		Context("when pruning", func() {
+			It("deletes router groups missing from the configuration", func() {
+				diff, err := reconciler.Reconcile(models.RouterGroups{existingTCP}, helpers.ReconcileOptions{Prune: true})
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(database.DeleteRouterGroupCallCount()).To(Equal(1))
+				Expect(database.DeleteRouterGroupArgsForCall(0)).To(Equal("http-guid"))
+				Expect(diff.Pruned).To(ConsistOf(existingHTTP))
+			})
+
+			It("skips router groups that are in use", func() {
+				database.DeleteRouterGroupReturns(db.DBError{Type: db.InUse, Message: "Delete Fails: Router Group has 0 http routes, 1 tcp routes, 0 udp routes and 0 port reservations"})
+				diff, err := reconciler.Reconcile(models.RouterGroups{existingTCP}, helpers.ReconcileOptions{Prune: true})
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(diff.Pruned).To(BeEmpty())
+				Expect(diff.Skipped).To(ConsistOf(helpers.SkippedRouterGroup{
+					Name:   "default-http",
+					Reason: "Delete Fails: Router Group has 0 http routes, 1 tcp routes, 0 udp routes and 0 port reservations",
+				}))
+			})
+
+			It("does not prune when there are no router groups in the configuration", func() {
+				diff, err := reconciler.Reconcile(models.RouterGroups{}, helpers.ReconcileOptions{Prune: true})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(diff.Empty()).To(BeTrue())
+				Expect(database.DeleteRouterGroupCallCount()).To(Equal(0))
+			})
+
+			It("returns other errors", func() {
+				database.DeleteRouterGroupReturns(errors.New("db communication failed"))
+				_, err := reconciler.Reconcile(models.RouterGroups{existingTCP}, helpers.ReconcileOptions{Prune: true})
+				Expect(err).To(MatchError("db communication failed"))
+			})
+		})
+
+		It("returns an error when the router groups cannot be read", func() {
+			database.ReadRouterGroupsReturns(nil, errors.New("db communication failed"))
+			_, err := reconciler.Reconcile(configured, helpers.ReconcileOptions{})
+			Expect(err).To(MatchError("db communication failed"))
+		})
+	})
+
+	Describe("Run", func() {
+		var process ifrit.Process
+
+		BeforeEach(func() {
+			configured = models.RouterGroups{existingTCP}
+			options = helpers.ReconcileOptions{Prune: true}
+		})
+
+		JustBeforeEach(func() {
+			process = ifrit.Background(reconciler)
+		})
+
+		AfterEach(func() {
+			process.Signal(syscall.SIGTERM)
+			Eventually(process.Wait()).Should(Receive(BeNil()))
+		})
+
+		It("reconciles the configured router groups before becoming ready", func() {
+			Eventually(process.Ready()).Should(BeClosed())
+			Expect(database.DeleteRouterGroupCallCount()).To(Equal(1))
+			Expect(database.DeleteRouterGroupArgsForCall(0)).To(Equal("http-guid"))
+		})
+
+		It("reconciles the reloaded router groups on reload", func() {
+			Eventually(process.Ready()).Should(BeClosed())
+			loaded = models.RouterGroups{existingTCP, {Name: "new-http", Type: models.RouterGroup_HTTP}}
+			reload <- syscall.SIGHUP
+
+			Eventually(database.SaveRouterGroupCallCount).Should(Equal(1))
+			Expect(database.SaveRouterGroupArgsForCall(0).Name).To(Equal("new-http"))
+		})
+
+		It("keeps running when the configuration cannot be reloaded", func() {
+			Eventually(process.Ready()).Should(BeClosed())
+			loadErr = errors.New("invalid config")
+			reload <- syscall.SIGHUP
+
+			Eventually(logger).Should(gbytes.Say("failed-to-reload-router-groups"))
+			Consistently(process.Wait()).ShouldNot(Receive())
+		})
+	})
+
+	Context("when the initial reconciliation fails", func() {
+		BeforeEach(func() {
+			database.ReadRouterGroupsReturns(nil, errors.New("db communication failed"))
+		})
+
+		It("exits with the error", func() {
+			process := ifrit.Background(reconciler)
+			Eventually(process.Wait()).Should(Receive(MatchError("db communication failed")))
+		})
+	})
+})
@@ -61,6 +61,15 @@ func MatchHttpRoute(target models.Route) types.GomegaMatcher {
 		gomega.WithTransform(func(t models.Route) string {
 			return t.RouteServiceUrl
 		}, gomega.Equal(target.RouteServiceUrl)),
+		gomega.WithTransform(func(t models.Route) int {
+			return t.TLSPort
+		}, gomega.Equal(target.TLSPort)),
+		gomega.WithTransform(func(t models.Route) string {
+			return t.ServerCertDomainSAN
+		}, gomega.Equal(target.ServerCertDomainSAN)),
+		gomega.WithTransform(func(t models.Route) string {
+			return t.Protocol
+		}, gomega.Equal(target.Protocol)),
 	)
 }
 
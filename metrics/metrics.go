@@ -3,12 +3,14 @@ package metrics
 import (
 	"errors"
 	"os"
+	"strings"
 	"time"
 
 	"sync/atomic"
 
 	"code.cloudfoundry.org/lager/v3"
 	"code.cloudfoundry.org/routing-api/db"
+	"code.cloudfoundry.org/routing-api/models"
 )
 
 const (
@@ -18,6 +20,20 @@ const (
 	TotalTcpRoutes         = "total_tcp_routes"
 	TotalTokenErrors       = "total_token_errors"
 	KeyRefreshEvents       = "key_refresh_events"
+
+	// Port occupancy gauges are emitted per tcp router group as
+	// router_group.<name>.<gauge>, see routerGroupMetricName for the name.
+	RouterGroupPortsTotal          = "ports_total"
+	RouterGroupPortsFree           = "ports_free"
+	RouterGroupPortsMapped         = "ports_mapped"
+	RouterGroupPortsReserved       = "ports_reserved"
+	RouterGroupPortsSystemReserved = "ports_system_reserved"
+
+	// Quota gauges are only emitted for tcp router groups with quotas.
+	RouterGroupTcpRoutes                         = "tcp_routes"
+	RouterGroupTcpRoutesQuota                    = "tcp_routes_quota"
+	RouterGroupTcpRoutesPerIsolationSegmentMax   = "tcp_routes_per_isolation_segment_max"
+	RouterGroupTcpRoutesPerIsolationSegmentQuota = "tcp_routes_per_isolation_segment_quota"
 )
 
 type PartialStatsdClient interface {
@@ -26,10 +42,11 @@ type PartialStatsdClient interface {
 }
 
 type MetricsReporter struct {
-	db     db.DB
-	stats  PartialStatsdClient
-	ticker *time.Ticker
-	logger lager.Logger
+	db         db.DB
+	stats      PartialStatsdClient
+	ticker     *time.Ticker
+	portPolicy models.PortPolicy
+	logger     lager.Logger
 }
 
 var (
@@ -37,8 +54,8 @@ var (
 	totalKeyRefreshEventCount int64
 )
 
-func NewMetricsReporter(database db.DB, stats PartialStatsdClient, ticker *time.Ticker, logger lager.Logger) *MetricsReporter {
-	return &MetricsReporter{db: database, stats: stats, ticker: ticker, logger: logger}
+func NewMetricsReporter(database db.DB, stats PartialStatsdClient, ticker *time.Ticker, portPolicy models.PortPolicy, logger lager.Logger) *MetricsReporter {
+	return &MetricsReporter{db: database, stats: stats, ticker: ticker, portPolicy: portPolicy, logger: logger}
 }
 
 func (r *MetricsReporter) Run(signals <-chan os.Signal, ready chan<- struct{}) error {
@@ -84,6 +101,7 @@ func (r *MetricsReporter) Run(signals <-chan os.Signal, ready chan<- struct{}) e
 			errs = append(errs, err)
 			err = r.stats.Gauge(KeyRefreshEvents, GetKeyVerificationRefreshCount(), 1.0)
 			errs = append(errs, err)
+			errs = append(errs, r.emitRouterGroupGauges()...)
 			if len(errs) > 0 {
 				r.logger.Info("error-emitting-metrics", lager.Data{"error": errors.Join(errs...)})
 			}
@@ -107,6 +125,78 @@ func (r MetricsReporter) getTotalTcpRoutes() int64 {
 	return int64(len(routes))
 }
 
+// emitRouterGroupGauges emits the port occupancy and quota usage of the tcp
+// router groups.
+func (r MetricsReporter) emitRouterGroupGauges() []error {
+	routerGroups, err := r.db.ReadRouterGroups()
+	if err != nil {
+		return []error{err}
+	}
+
+	var (
+		mappings     []models.TcpRouteMapping
+		reservations []models.PortReservation
+		loaded       bool
+		errs         []error
+	)
+	for _, routerGroup := range routerGroups {
+		if routerGroup.Type != models.RouterGroup_TCP {
+			continue
+		}
+
+		// only read mappings and reservations when there is a tcp router group
+		if !loaded {
+			mappings, err = r.db.ReadTcpRouteMappings()
+			if err != nil {
+				return []error{err}
+			}
+			reservations, err = r.db.ReadPortReservations()
+			if err != nil {
+				return []error{err}
+			}
+			loaded = true
+		}
+
+		occupancy, err := models.NewPortOccupancy(routerGroup, mappings, reservations, r.portPolicy)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+
+		prefix := "router_group." + routerGroupMetricName(routerGroup.Name) + "."
+		errs = append(errs,
+			r.stats.Gauge(prefix+RouterGroupPortsTotal, int64(occupancy.Summary.Total), 1.0),
+			r.stats.Gauge(prefix+RouterGroupPortsFree, int64(occupancy.Summary.Free), 1.0),
+			r.stats.Gauge(prefix+RouterGroupPortsMapped, int64(occupancy.Summary.Mapped), 1.0),
+			r.stats.Gauge(prefix+RouterGroupPortsReserved, int64(occupancy.Summary.Reserved), 1.0),
+			r.stats.Gauge(prefix+RouterGroupPortsSystemReserved, int64(occupancy.Summary.SystemReserved), 1.0),
+		)
+
+		if routerGroup.HasTcpRouteQuotas() {
+			usage := models.NewRouterGroupQuotaUsage(routerGroup, mappings)
+			errs = append(errs,
+				r.stats.Gauge(prefix+RouterGroupTcpRoutes, int64(usage.TcpRoutes), 1.0),
+				r.stats.Gauge(prefix+RouterGroupTcpRoutesQuota, int64(routerGroup.MaxTcpRoutes), 1.0),
+				r.stats.Gauge(prefix+RouterGroupTcpRoutesPerIsolationSegmentMax, int64(usage.MaxTcpRoutesPerIsolationSegment()), 1.0),
+				r.stats.Gauge(prefix+RouterGroupTcpRoutesPerIsolationSegmentQuota, int64(routerGroup.MaxTcpRoutesPerIsolationSegment), 1.0),
+			)
+		}
+	}
+	return errs
+}
+
+// routerGroupMetricName replaces the characters of the router group name that
+// are not letters, digits, '-' or '_' with '_', so that a '.' does not split
+// the metric path and ':' or '|' do not corrupt the statsd line.
+func routerGroupMetricName(name string) string {
+	return strings.Map(func(r rune) rune {
+		if (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9') || r == '-' || r == '_' {
+			return r
+		}
+		return '_'
+	}, name)
+}
+
 func getStatsEventType(event db.Event) int64 {
 	if event.Type == db.CreateEvent {
 		return 1
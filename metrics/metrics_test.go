@@ -36,7 +36,7 @@ var _ = Describe("Metrics", func() {
 
 			tickChan = make(chan time.Time, 1)
 			logger := lagertest.NewTestLogger("metrics")
-			reporter = NewMetricsReporter(database, stats, &time.Ticker{C: tickChan}, logger)
+			reporter = NewMetricsReporter(database, stats, &time.Ticker{C: tickChan}, models.PortPolicy{}, logger)
 
 			sigChan = make(chan os.Signal, 1)
 			readyChan = make(chan struct{}, 1)
@@ -234,5 +234,64 @@ var _ = Describe("Metrics", func() {
 			})
 		})
 
+		Context("When there are tcp router groups", func() {
+			BeforeEach(func() {
+				database.ReadRouterGroupsReturns(models.RouterGroups{
+					{Guid: "tcp-guid", Name: "default-tcp", Type: models.RouterGroup_TCP, ReservablePorts: "1024-1033"},
+					{Guid: "http-guid", Name: "default-http", Type: models.RouterGroup_HTTP},
+				}, nil)
+				database.ReadTcpRouteMappingsReturns([]models.TcpRouteMapping{
+					models.NewTcpRouteMapping("tcp-guid", 1024, "10.0.0.1", 8080, 0, "", nil, nil, 60, models.ModificationTag{}, false, ""),
+					models.NewTcpRouteMapping("tcp-guid", 1024, "10.0.0.2", 8080, 0, "", nil, nil, 60, models.ModificationTag{}, false, ""),
+					models.NewTcpRouteMapping("tcp-guid", 1025, "10.0.0.3", 8080, 0, "", nil, nil, 60, models.ModificationTag{}, false, ""),
+				}, nil)
+				database.ReadPortReservationsReturns([]models.PortReservation{
+					models.NewPortReservation("tcp-guid", 1026, "some-owner", nil),
+				}, nil)
+			})
+
+			It("emits port occupancy metrics for each tcp router group", func() {
+				tickChan <- time.Now()
+				Eventually(stats.GaugeCallCount).Should(Equal(11))
+				verifyGaugeCall("router_group.default-tcp.ports_total", 10, 1.0, 6)
+				verifyGaugeCall("router_group.default-tcp.ports_free", 7, 1.0, 7)
+				verifyGaugeCall("router_group.default-tcp.ports_mapped", 2, 1.0, 8)
+				verifyGaugeCall("router_group.default-tcp.ports_reserved", 1, 1.0, 9)
+				verifyGaugeCall("router_group.default-tcp.ports_system_reserved", 0, 1.0, 10)
+			})
+
+			Context("when a tcp router group has quotas", func() {
+				BeforeEach(func() {
+					database.ReadRouterGroupsReturns(models.RouterGroups{
+						{Guid: "tcp-guid", Name: "default-tcp", Type: models.RouterGroup_TCP, ReservablePorts: "1024-1033", MaxTcpRoutes: 5, MaxTcpRoutesPerIsolationSegment: 4},
+					}, nil)
+				})
+
+				It("emits quota usage metrics", func() {
+					tickChan <- time.Now()
+					Eventually(stats.GaugeCallCount).Should(Equal(15))
+					verifyGaugeCall("router_group.default-tcp.tcp_routes", 3, 1.0, 11)
+					verifyGaugeCall("router_group.default-tcp.tcp_routes_quota", 5, 1.0, 12)
+					verifyGaugeCall("router_group.default-tcp.tcp_routes_per_isolation_segment_max", 3, 1.0, 13)
+					verifyGaugeCall("router_group.default-tcp.tcp_routes_per_isolation_segment_quota", 4, 1.0, 14)
+				})
+			})
+
+			Context("when the name of a tcp router group has statsd separators", func() {
+				BeforeEach(func() {
+					database.ReadRouterGroupsReturns(models.RouterGroups{
+						{Guid: "tcp-guid", Name: "tcp.group:1|g", Type: models.RouterGroup_TCP, ReservablePorts: "1024-1033"},
+					}, nil)
+				})
+
+				It("replaces them in the metric name", func() {
+					tickChan <- time.Now()
+					Eventually(stats.GaugeCallCount).Should(Equal(11))
+					verifyGaugeCall("router_group.tcp_group_1_g.ports_total", 10, 1.0, 6)
+					verifyGaugeCall("router_group.tcp_group_1_g.ports_system_reserved", 0, 1.0, 10)
+				})
+			})
+		})
+
 	})
 })
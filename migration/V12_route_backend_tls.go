@@ -0,0 +1,24 @@
+package migration
+
+import (
+	"code.cloudfoundry.org/routing-api/db"
+	"code.cloudfoundry.org/routing-api/models"
+)
+
+type V12RouteBackendTLS struct{}
+
+var _ Migration = new(V12RouteBackendTLS)
+
+func NewV12RouteBackendTLS() *V12RouteBackendTLS {
+	return &V12RouteBackendTLS{}
+}
+
+func (v *V12RouteBackendTLS) Version() int {
+	return 12
+}
+
+func (v *V12RouteBackendTLS) Run(sqlDB *db.SqlDB) error {
+	// Run AutoMigrate to add the TLSPort and ServerCertDomainSAN columns.
+	// Existing routes get a tls_port of 0, which keeps them plain HTTP.
+	return sqlDB.Client.AutoMigrate(&models.Route{})
+}
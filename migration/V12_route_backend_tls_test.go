@@ -0,0 +1,102 @@
+package migration_test
+
+import (
+	"time"
+
+	"code.cloudfoundry.org/routing-api/cmd/routing-api/testrunner"
+	"code.cloudfoundry.org/routing-api/db"
+	"code.cloudfoundry.org/routing-api/migration"
+	v7 "code.cloudfoundry.org/routing-api/migration/v7"
+	"code.cloudfoundry.org/routing-api/models"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("V12RouteBackendTLS", func() {
+	var (
+		sqlDB       *db.SqlDB
+		dbAllocator testrunner.DbAllocator
+	)
+
+	BeforeEach(func() {
+		dbAllocator = testrunner.NewDbAllocator()
+		sqlCfg, err := dbAllocator.Create()
+		Expect(err).NotTo(HaveOccurred())
+
+		sqlDB, err = db.NewSqlDB(sqlCfg)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		err := dbAllocator.Delete()
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	Describe("Version", func() {
+		It("returns 12 for the version", func() {
+			v12Migration := migration.NewV12RouteBackendTLS()
+			Expect(v12Migration.Version()).To(Equal(12))
+		})
+	})
+
+	Describe("Run", func() {
+		Context("when there are existing tables with the old route model", func() {
+			BeforeEach(func() {
+				err := sqlDB.Client.AutoMigrate(&v7.RouterGroupDB{}, &v7.TcpRouteMapping{}, &v7.Route{})
+				Expect(err).ToNot(HaveOccurred())
+
+				ttl := 120
+				route := v7.Route{
+					Model:     v7.Model{Guid: "guid-0"},
+					ExpiresAt: time.Now().Add(1 * time.Hour),
+					RouteEntity: v7.RouteEntity{
+						Route: "example.com",
+						Port:  8080,
+						IP:    "1.2.3.4",
+						TTL:   &ttl,
+					},
+				}
+
+				_, err = sqlDB.Client.Create(&route)
+				Expect(err).NotTo(HaveOccurred())
+
+				v12Migration := migration.NewV12RouteBackendTLS()
+				err = v12Migration.Run(sqlDB)
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("defaults existing routes to no backend TLS", func() {
+				routes, err := sqlDB.ReadRoutes()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(routes).To(HaveLen(1))
+				Expect(routes[0].TLSPort).To(Equal(0))
+				Expect(routes[0].ServerCertDomainSAN).To(BeEmpty())
+			})
+
+			It("stores routes with backend TLS metadata", func() {
+				ttl := 120
+				route := models.Route{
+					Model:     models.Model{Guid: "guid-1"},
+					ExpiresAt: time.Now().Add(1 * time.Hour),
+					RouteEntity: models.RouteEntity{
+						Route:               "example.com",
+						Port:                8080,
+						IP:                  "1.2.3.4",
+						TTL:                 &ttl,
+						TLSPort:             8443,
+						ServerCertDomainSAN: "instance-guid",
+					},
+				}
+				_, err := sqlDB.Client.Create(&route)
+				Expect(err).NotTo(HaveOccurred())
+
+				var createdRoute models.Route
+				err = sqlDB.Client.Where("guid = ?", "guid-1").First(&createdRoute)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(createdRoute.TLSPort).To(Equal(8443))
+				Expect(createdRoute.ServerCertDomainSAN).To(Equal("instance-guid"))
+			})
+		})
+	})
+})
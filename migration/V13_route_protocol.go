@@ -0,0 +1,24 @@
+package migration
+
+import (
+	"code.cloudfoundry.org/routing-api/db"
+	"code.cloudfoundry.org/routing-api/models"
+)
+
+type V13RouteProtocol struct{}
+
+var _ Migration = new(V13RouteProtocol)
+
+func NewV13RouteProtocol() *V13RouteProtocol {
+	return &V13RouteProtocol{}
+}
+
+func (v *V13RouteProtocol) Version() int {
+	return 13
+}
+
+func (v *V13RouteProtocol) Run(sqlDB *db.SqlDB) error {
+	// Run AutoMigrate to add the Protocol column
+	// Note: Protocol is NOT part of the unique index
+	return sqlDB.Client.AutoMigrate(&models.Route{})
+}
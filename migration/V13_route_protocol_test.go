@@ -0,0 +1,75 @@
+package migration_test
+
+import (
+	"time"
+
+	"code.cloudfoundry.org/routing-api/cmd/routing-api/testrunner"
+	"code.cloudfoundry.org/routing-api/db"
+	"code.cloudfoundry.org/routing-api/migration"
+	"code.cloudfoundry.org/routing-api/models"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("V13RouteProtocol", func() {
+	var (
+		sqlDB       *db.SqlDB
+		dbAllocator testrunner.DbAllocator
+	)
+
+	BeforeEach(func() {
+		dbAllocator = testrunner.NewDbAllocator()
+		sqlCfg, err := dbAllocator.Create()
+		Expect(err).NotTo(HaveOccurred())
+
+		sqlDB, err = db.NewSqlDB(sqlCfg)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		err := dbAllocator.Delete()
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	Describe("Version", func() {
+		It("returns 13 for the version", func() {
+			v13Migration := migration.NewV13RouteProtocol()
+			Expect(v13Migration.Version()).To(Equal(13))
+		})
+	})
+
+	Describe("Run", func() {
+		BeforeEach(func() {
+			v0Migration := migration.NewV0InitMigration()
+			err := v0Migration.Run(sqlDB)
+			Expect(err).ToNot(HaveOccurred())
+
+			v13Migration := migration.NewV13RouteProtocol()
+			err = v13Migration.Run(sqlDB)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("stores the protocol of a route", func() {
+			ttl := 120
+			route := models.Route{
+				Model:     models.Model{Guid: "guid-1"},
+				ExpiresAt: time.Now().Add(1 * time.Hour),
+				RouteEntity: models.RouteEntity{
+					Route:    "example.com",
+					Port:     8080,
+					IP:       "1.2.3.4",
+					TTL:      &ttl,
+					Protocol: models.RouteProtocolHTTP2,
+				},
+			}
+			_, err := sqlDB.Client.Create(&route)
+			Expect(err).NotTo(HaveOccurred())
+
+			var createdRoute models.Route
+			err = sqlDB.Client.Where("guid = ?", "guid-1").First(&createdRoute)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(createdRoute.Protocol).To(Equal(models.RouteProtocolHTTP2))
+		})
+	})
+})
@@ -0,0 +1,119 @@
+package migration_test
+
+import (
+	"time"
+
+	"code.cloudfoundry.org/routing-api/cmd/routing-api/testrunner"
+	"code.cloudfoundry.org/routing-api/db"
+	"code.cloudfoundry.org/routing-api/migration"
+	"code.cloudfoundry.org/routing-api/models"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("V14Labels", func() {
+	var (
+		sqlDB       *db.SqlDB
+		dbAllocator testrunner.DbAllocator
+		labels      models.LabelSet
+	)
+
+	BeforeEach(func() {
+		dbAllocator = testrunner.NewDbAllocator()
+		sqlCfg, err := dbAllocator.Create()
+		Expect(err).NotTo(HaveOccurred())
+
+		sqlDB, err = db.NewSqlDB(sqlCfg)
+		Expect(err).ToNot(HaveOccurred())
+
+		labels, err = models.NewLabelSet(map[string]string{"app_guid": "abc"})
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		err := dbAllocator.Delete()
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	Describe("Version", func() {
+		It("returns 14 for the version", func() {
+			v14Migration := migration.NewV14Labels()
+			Expect(v14Migration.Version()).To(Equal(14))
+		})
+	})
+
+	Describe("Run", func() {
+		BeforeEach(func() {
+			v0Migration := migration.NewV0InitMigration()
+			err := v0Migration.Run(sqlDB)
+			Expect(err).ToNot(HaveOccurred())
+
+			v11Migration := migration.NewV11EnableBackendMTLS()
+			err = v11Migration.Run(sqlDB)
+			Expect(err).ToNot(HaveOccurred())
+
+			v14Migration := migration.NewV14Labels()
+			err = v14Migration.Run(sqlDB)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("stores labels on http routes", func() {
+			ttl := 120
+			route := models.Route{
+				Model:     models.Model{Guid: "guid-1"},
+				ExpiresAt: time.Now().Add(1 * time.Hour),
+				RouteEntity: models.RouteEntity{
+					Route:  "example.com",
+					Port:   8080,
+					IP:     "1.2.3.4",
+					TTL:    &ttl,
+					Labels: labels,
+				},
+			}
+			_, err := sqlDB.Client.Create(&route)
+			Expect(err).NotTo(HaveOccurred())
+
+			var createdRoute models.Route
+			err = sqlDB.Client.Where("guid = ?", "guid-1").First(&createdRoute)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(createdRoute.Labels).To(Equal(labels))
+		})
+
+		It("stores labels on tcp route mappings", func() {
+			tcpRoute := models.TcpRouteMapping{
+				Model:     models.Model{Guid: "guid-1"},
+				ExpiresAt: time.Now().Add(1 * time.Hour),
+				TcpMappingEntity: models.TcpMappingEntity{
+					RouterGroupGuid: "test1",
+					HostPort:        80,
+					HostIP:          "1.2.3.4",
+					ExternalPort:    80,
+					Labels:          labels,
+				},
+			}
+			_, err := sqlDB.Client.Create(&tcpRoute)
+			Expect(err).NotTo(HaveOccurred())
+
+			var createdRoute models.TcpRouteMapping
+			err = sqlDB.Client.Where("guid = ?", "guid-1").First(&createdRoute)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(createdRoute.Labels).To(Equal(labels))
+		})
+
+		It("stores labels on router groups", func() {
+			routerGroup := models.NewRouterGroupDB(models.RouterGroup{
+				Guid:   "guid-1",
+				Name:   "rg-1",
+				Type:   models.RouterGroup_HTTP,
+				Labels: labels,
+			})
+			_, err := sqlDB.Client.Create(&routerGroup)
+			Expect(err).NotTo(HaveOccurred())
+
+			rg, err := sqlDB.ReadRouterGroup("guid-1")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(rg.Labels).To(Equal(labels))
+		})
+	})
+})
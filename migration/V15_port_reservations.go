@@ -0,0 +1,36 @@
+package migration
+
+import (
+	"code.cloudfoundry.org/routing-api/db"
+	"code.cloudfoundry.org/routing-api/models"
+)
+
+type V15PortReservations struct{}
+
+var _ Migration = new(V15PortReservations)
+
+func NewV15PortReservations() *V15PortReservations {
+	return &V15PortReservations{}
+}
+
+func (v *V15PortReservations) Version() int {
+	return 15
+}
+
+func (v *V15PortReservations) Run(sqlDB *db.SqlDB) error {
+	err := sqlDB.Client.AutoMigrate(&models.PortReservation{})
+	if err != nil {
+		return err
+	}
+
+	dropIndex(sqlDB, "idx_port_reservation", "port_reservations")
+
+	// A port can only be reserved once per router group
+	var indexSQL string
+	if sqlDB.Client.Dialect().Name() == "mysql" {
+		indexSQL = "CREATE UNIQUE INDEX idx_port_reservation ON port_reservations (router_group_guid(191), port)"
+	} else {
+		indexSQL = "CREATE UNIQUE INDEX idx_port_reservation ON port_reservations (router_group_guid, port)"
+	}
+	return sqlDB.Client.ExecWithError(indexSQL)
+}
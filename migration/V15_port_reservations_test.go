@@ -0,0 +1,78 @@
+package migration_test
+
+import (
+	"code.cloudfoundry.org/routing-api/cmd/routing-api/testrunner"
+	"code.cloudfoundry.org/routing-api/db"
+	"code.cloudfoundry.org/routing-api/migration"
+	v7 "code.cloudfoundry.org/routing-api/migration/v7"
+	"code.cloudfoundry.org/routing-api/models"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("V15PortReservations", func() {
+	var (
+		sqlDB       *db.SqlDB
+		dbAllocator testrunner.DbAllocator
+	)
+
+	BeforeEach(func() {
+		dbAllocator = testrunner.NewDbAllocator()
+		sqlCfg, err := dbAllocator.Create()
+		Expect(err).NotTo(HaveOccurred())
+
+		sqlDB, err = db.NewSqlDB(sqlCfg)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		err := dbAllocator.Delete()
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	Describe("Version", func() {
+		It("returns 15 for the version", func() {
+			v15Migration := migration.NewV15PortReservations()
+			Expect(v15Migration.Version()).To(Equal(15))
+		})
+	})
+
+	Describe("Run", func() {
+		BeforeEach(func() {
+			err := sqlDB.Client.AutoMigrate(&v7.RouterGroupDB{}, &v7.TcpRouteMapping{}, &v7.Route{})
+			Expect(err).ToNot(HaveOccurred())
+
+			v15Migration := migration.NewV15PortReservations()
+			err = v15Migration.Run(sqlDB)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("creates the port reservations table", func() {
+			Expect(sqlDB.Client.HasTable(&models.PortReservation{})).To(BeTrue())
+		})
+
+		It("allows a port to be reserved only once per router group", func() {
+			reservation, err := models.NewPortReservationWithModel(models.NewPortReservation("rg-guid", 1024, "owner-1", nil))
+			Expect(err).NotTo(HaveOccurred())
+			_, err = sqlDB.Client.Create(&reservation)
+			Expect(err).NotTo(HaveOccurred())
+
+			duplicate, err := models.NewPortReservationWithModel(models.NewPortReservation("rg-guid", 1024, "owner-2", nil))
+			Expect(err).NotTo(HaveOccurred())
+			_, err = sqlDB.Client.Create(&duplicate)
+			Expect(err).To(HaveOccurred())
+
+			otherGroup, err := models.NewPortReservationWithModel(models.NewPortReservation("other-rg-guid", 1024, "owner-2", nil))
+			Expect(err).NotTo(HaveOccurred())
+			_, err = sqlDB.Client.Create(&otherGroup)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("is idempotent", func() {
+			v15Migration := migration.NewV15PortReservations()
+			err := v15Migration.Run(sqlDB)
+			Expect(err).ToNot(HaveOccurred())
+		})
+	})
+})
@@ -0,0 +1,22 @@
+package migration
+
+import (
+	"code.cloudfoundry.org/routing-api/db"
+	"code.cloudfoundry.org/routing-api/models"
+)
+
+type V16RouterGroupDescription struct{}
+
+var _ Migration = new(V16RouterGroupDescription)
+
+func NewV16RouterGroupDescription() *V16RouterGroupDescription {
+	return &V16RouterGroupDescription{}
+}
+
+func (v *V16RouterGroupDescription) Version() int {
+	return 16
+}
+
+func (v *V16RouterGroupDescription) Run(sqlDB *db.SqlDB) error {
+	return sqlDB.Client.AutoMigrate(&models.RouterGroupDB{})
+}
@@ -0,0 +1,72 @@
+package migration_test
+
+import (
+	"code.cloudfoundry.org/routing-api/cmd/routing-api/testrunner"
+	"code.cloudfoundry.org/routing-api/db"
+	"code.cloudfoundry.org/routing-api/migration"
+	v7 "code.cloudfoundry.org/routing-api/migration/v7"
+	"code.cloudfoundry.org/routing-api/models"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("V16RouterGroupDescription", func() {
+	var (
+		sqlDB       *db.SqlDB
+		dbAllocator testrunner.DbAllocator
+	)
+
+	BeforeEach(func() {
+		dbAllocator = testrunner.NewDbAllocator()
+		sqlCfg, err := dbAllocator.Create()
+		Expect(err).NotTo(HaveOccurred())
+
+		sqlDB, err = db.NewSqlDB(sqlCfg)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		err := dbAllocator.Delete()
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	Describe("Version", func() {
+		It("returns 16 for the version", func() {
+			v16Migration := migration.NewV16RouterGroupDescription()
+			Expect(v16Migration.Version()).To(Equal(16))
+		})
+	})
+
+	Describe("Run", func() {
+		BeforeEach(func() {
+			err := sqlDB.Client.AutoMigrate(&v7.RouterGroupDB{}, &v7.TcpRouteMapping{}, &v7.Route{})
+			Expect(err).ToNot(HaveOccurred())
+
+			v16Migration := migration.NewV16RouterGroupDescription()
+			err = v16Migration.Run(sqlDB)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("stores descriptions on router groups", func() {
+			routerGroup := models.NewRouterGroupDB(models.RouterGroup{
+				Guid:        "guid-1",
+				Name:        "rg-1",
+				Type:        models.RouterGroup_HTTP,
+				Description: "routers in the public zone",
+			})
+			_, err := sqlDB.Client.Create(&routerGroup)
+			Expect(err).NotTo(HaveOccurred())
+
+			rg, err := sqlDB.ReadRouterGroup("guid-1")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(rg.Description).To(Equal("routers in the public zone"))
+		})
+
+		It("is idempotent", func() {
+			v16Migration := migration.NewV16RouterGroupDescription()
+			err := v16Migration.Run(sqlDB)
+			Expect(err).ToNot(HaveOccurred())
+		})
+	})
+})
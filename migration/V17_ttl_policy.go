@@ -0,0 +1,24 @@
+package migration
+
+import (
+	"code.cloudfoundry.org/routing-api/db"
+	"code.cloudfoundry.org/routing-api/models"
+)
+
+type V17TTLPolicy struct{}
+
+var _ Migration = new(V17TTLPolicy)
+
+func NewV17TTLPolicy() *V17TTLPolicy {
+	return &V17TTLPolicy{}
+}
+
+func (v *V17TTLPolicy) Version() int {
+	return 17
+}
+
+func (v *V17TTLPolicy) Run(sqlDB *db.SqlDB) error {
+	// Adds the TTL policy columns to router groups and the router group guid
+	// column to routes. Neither is part of a unique index.
+	return sqlDB.Client.AutoMigrate(&models.RouterGroupDB{}, &models.Route{})
+}
@@ -0,0 +1,100 @@
+package migration_test
+
+import (
+	"time"
+
+	"code.cloudfoundry.org/routing-api/cmd/routing-api/testrunner"
+	"code.cloudfoundry.org/routing-api/db"
+	"code.cloudfoundry.org/routing-api/migration"
+	v7 "code.cloudfoundry.org/routing-api/migration/v7"
+	"code.cloudfoundry.org/routing-api/models"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("V17TTLPolicy", func() {
+	var (
+		sqlDB       *db.SqlDB
+		dbAllocator testrunner.DbAllocator
+	)
+
+	BeforeEach(func() {
+		dbAllocator = testrunner.NewDbAllocator()
+		sqlCfg, err := dbAllocator.Create()
+		Expect(err).NotTo(HaveOccurred())
+
+		sqlDB, err = db.NewSqlDB(sqlCfg)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		err := dbAllocator.Delete()
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	Describe("Version", func() {
+		It("returns 17 for the version", func() {
+			v17Migration := migration.NewV17TTLPolicy()
+			Expect(v17Migration.Version()).To(Equal(17))
+		})
+	})
+
+	Describe("Run", func() {
+		BeforeEach(func() {
+			err := sqlDB.Client.AutoMigrate(&v7.RouterGroupDB{}, &v7.TcpRouteMapping{}, &v7.Route{})
+			Expect(err).ToNot(HaveOccurred())
+
+			v17Migration := migration.NewV17TTLPolicy()
+			err = v17Migration.Run(sqlDB)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("stores the ttl policy of router groups", func() {
+			routerGroup := models.NewRouterGroupDB(models.RouterGroup{
+				Guid:       "guid-1",
+				Name:       "rg-1",
+				Type:       models.RouterGroup_HTTP,
+				MinTTL:     10,
+				MaxTTL:     300,
+				DefaultTTL: 60,
+			})
+			_, err := sqlDB.Client.Create(&routerGroup)
+			Expect(err).NotTo(HaveOccurred())
+
+			rg, err := sqlDB.ReadRouterGroup("guid-1")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(rg.MinTTL).To(Equal(10))
+			Expect(rg.MaxTTL).To(Equal(300))
+			Expect(rg.DefaultTTL).To(Equal(60))
+		})
+
+		It("stores the router group of a route", func() {
+			ttl := 120
+			route := models.Route{
+				Model:     models.Model{Guid: "guid-1"},
+				ExpiresAt: time.Now().Add(1 * time.Hour),
+				RouteEntity: models.RouteEntity{
+					Route:           "example.com",
+					Port:            8080,
+					IP:              "1.2.3.4",
+					TTL:             &ttl,
+					RouterGroupGuid: "rg-guid",
+				},
+			}
+			_, err := sqlDB.Client.Create(&route)
+			Expect(err).NotTo(HaveOccurred())
+
+			var createdRoute models.Route
+			err = sqlDB.Client.Where("guid = ?", "guid-1").First(&createdRoute)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(createdRoute.RouterGroupGuid).To(Equal("rg-guid"))
+		})
+
+		It("is idempotent", func() {
+			v17Migration := migration.NewV17TTLPolicy()
+			err := v17Migration.Run(sqlDB)
+			Expect(err).ToNot(HaveOccurred())
+		})
+	})
+})
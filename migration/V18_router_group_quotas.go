@@ -0,0 +1,22 @@
+package migration
+
+import (
+	"code.cloudfoundry.org/routing-api/db"
+	"code.cloudfoundry.org/routing-api/models"
+)
+
+type V18RouterGroupQuotas struct{}
+
+var _ Migration = new(V18RouterGroupQuotas)
+
+func NewV18RouterGroupQuotas() *V18RouterGroupQuotas {
+	return &V18RouterGroupQuotas{}
+}
+
+func (v *V18RouterGroupQuotas) Version() int {
+	return 18
+}
+
+func (v *V18RouterGroupQuotas) Run(sqlDB *db.SqlDB) error {
+	return sqlDB.Client.AutoMigrate(&models.RouterGroupDB{})
+}
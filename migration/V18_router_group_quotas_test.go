@@ -0,0 +1,75 @@
+package migration_test
+
+import (
+	"code.cloudfoundry.org/routing-api/cmd/routing-api/testrunner"
+	"code.cloudfoundry.org/routing-api/db"
+	"code.cloudfoundry.org/routing-api/migration"
+	v7 "code.cloudfoundry.org/routing-api/migration/v7"
+	"code.cloudfoundry.org/routing-api/models"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("V18RouterGroupQuotas", func() {
+	var (
+		sqlDB       *db.SqlDB
+		dbAllocator testrunner.DbAllocator
+	)
+
+	BeforeEach(func() {
+		dbAllocator = testrunner.NewDbAllocator()
+		sqlCfg, err := dbAllocator.Create()
+		Expect(err).NotTo(HaveOccurred())
+
+		sqlDB, err = db.NewSqlDB(sqlCfg)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		err := dbAllocator.Delete()
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	Describe("Version", func() {
+		It("returns 18 for the version", func() {
+			v18Migration := migration.NewV18RouterGroupQuotas()
+			Expect(v18Migration.Version()).To(Equal(18))
+		})
+	})
+
+	Describe("Run", func() {
+		BeforeEach(func() {
+			err := sqlDB.Client.AutoMigrate(&v7.RouterGroupDB{}, &v7.TcpRouteMapping{}, &v7.Route{})
+			Expect(err).ToNot(HaveOccurred())
+
+			v18Migration := migration.NewV18RouterGroupQuotas()
+			err = v18Migration.Run(sqlDB)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("stores the quotas of router groups", func() {
+			routerGroup := models.NewRouterGroupDB(models.RouterGroup{
+				Guid:                            "guid-1",
+				Name:                            "rg-1",
+				Type:                            models.RouterGroup_TCP,
+				ReservablePorts:                 "1024-2048",
+				MaxTcpRoutes:                    100,
+				MaxTcpRoutesPerIsolationSegment: 10,
+			})
+			_, err := sqlDB.Client.Create(&routerGroup)
+			Expect(err).NotTo(HaveOccurred())
+
+			rg, err := sqlDB.ReadRouterGroup("guid-1")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(rg.MaxTcpRoutes).To(Equal(100))
+			Expect(rg.MaxTcpRoutesPerIsolationSegment).To(Equal(10))
+		})
+
+		It("is idempotent", func() {
+			v18Migration := migration.NewV18RouterGroupQuotas()
+			err := v18Migration.Run(sqlDB)
+			Expect(err).ToNot(HaveOccurred())
+		})
+	})
+})
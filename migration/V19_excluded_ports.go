@@ -0,0 +1,22 @@
+package migration
+
+import (
+	"code.cloudfoundry.org/routing-api/db"
+	"code.cloudfoundry.org/routing-api/models"
+)
+
+type V19ExcludedPorts struct{}
+
+var _ Migration = new(V19ExcludedPorts)
+
+func NewV19ExcludedPorts() *V19ExcludedPorts {
+	return &V19ExcludedPorts{}
+}
+
+func (v *V19ExcludedPorts) Version() int {
+	return 19
+}
+
+func (v *V19ExcludedPorts) Run(sqlDB *db.SqlDB) error {
+	return sqlDB.Client.AutoMigrate(&models.RouterGroupDB{})
+}
@@ -0,0 +1,73 @@
+package migration_test
+
+import (
+	"code.cloudfoundry.org/routing-api/cmd/routing-api/testrunner"
+	"code.cloudfoundry.org/routing-api/db"
+	"code.cloudfoundry.org/routing-api/migration"
+	v7 "code.cloudfoundry.org/routing-api/migration/v7"
+	"code.cloudfoundry.org/routing-api/models"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("V19ExcludedPorts", func() {
+	var (
+		sqlDB       *db.SqlDB
+		dbAllocator testrunner.DbAllocator
+	)
+
+	BeforeEach(func() {
+		dbAllocator = testrunner.NewDbAllocator()
+		sqlCfg, err := dbAllocator.Create()
+		Expect(err).NotTo(HaveOccurred())
+
+		sqlDB, err = db.NewSqlDB(sqlCfg)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		err := dbAllocator.Delete()
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	Describe("Version", func() {
+		It("returns 19 for the version", func() {
+			v19Migration := migration.NewV19ExcludedPorts()
+			Expect(v19Migration.Version()).To(Equal(19))
+		})
+	})
+
+	Describe("Run", func() {
+		BeforeEach(func() {
+			err := sqlDB.Client.AutoMigrate(&v7.RouterGroupDB{}, &v7.TcpRouteMapping{}, &v7.Route{})
+			Expect(err).ToNot(HaveOccurred())
+
+			v19Migration := migration.NewV19ExcludedPorts()
+			err = v19Migration.Run(sqlDB)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("stores the excluded ports of router groups", func() {
+			routerGroup := models.NewRouterGroupDB(models.RouterGroup{
+				Guid:            "guid-1",
+				Name:            "rg-1",
+				Type:            models.RouterGroup_TCP,
+				ReservablePorts: "1024-2048",
+				ExcludedPorts:   "1500-1510",
+			})
+			_, err := sqlDB.Client.Create(&routerGroup)
+			Expect(err).NotTo(HaveOccurred())
+
+			rg, err := sqlDB.ReadRouterGroup("guid-1")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(rg.ExcludedPorts).To(Equal(models.ReservablePorts("1500-1510")))
+		})
+
+		It("is idempotent", func() {
+			v19Migration := migration.NewV19ExcludedPorts()
+			err := v19Migration.Run(sqlDB)
+			Expect(err).ToNot(HaveOccurred())
+		})
+	})
+})
@@ -0,0 +1,35 @@
+package migration
+
+import (
+	"code.cloudfoundry.org/routing-api/db"
+	"code.cloudfoundry.org/routing-api/models"
+)
+
+type V20UdpRoutes struct{}
+
+var _ Migration = new(V20UdpRoutes)
+
+func NewV20UdpRoutes() *V20UdpRoutes {
+	return &V20UdpRoutes{}
+}
+
+func (v *V20UdpRoutes) Version() int {
+	return 20
+}
+
+func (v *V20UdpRoutes) Run(sqlDB *db.SqlDB) error {
+	err := sqlDB.Client.AutoMigrate(&models.UdpRouteMapping{})
+	if err != nil {
+		return err
+	}
+
+	dropIndex(sqlDB, "idx_udp_route", "udp_routes")
+
+	var indexSQL string
+	if sqlDB.Client.Dialect().Name() == "mysql" {
+		indexSQL = "CREATE UNIQUE INDEX idx_udp_route ON udp_routes (router_group_guid(191), host_port, host_ip(191), external_port)"
+	} else {
+		indexSQL = "CREATE UNIQUE INDEX idx_udp_route ON udp_routes (router_group_guid, host_port, host_ip, external_port)"
+	}
+	return sqlDB.Client.ExecWithError(indexSQL)
+}
@@ -0,0 +1,78 @@
+package migration_test
+
+import (
+	"code.cloudfoundry.org/routing-api/cmd/routing-api/testrunner"
+	"code.cloudfoundry.org/routing-api/db"
+	"code.cloudfoundry.org/routing-api/migration"
+	v7 "code.cloudfoundry.org/routing-api/migration/v7"
+	"code.cloudfoundry.org/routing-api/models"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("V20UdpRoutes", func() {
+	var (
+		sqlDB       *db.SqlDB
+		dbAllocator testrunner.DbAllocator
+	)
+
+	BeforeEach(func() {
+		dbAllocator = testrunner.NewDbAllocator()
+		sqlCfg, err := dbAllocator.Create()
+		Expect(err).NotTo(HaveOccurred())
+
+		sqlDB, err = db.NewSqlDB(sqlCfg)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		err := dbAllocator.Delete()
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	Describe("Version", func() {
+		It("returns 20 for the version", func() {
+			v20Migration := migration.NewV20UdpRoutes()
+			Expect(v20Migration.Version()).To(Equal(20))
+		})
+	})
+
+	Describe("Run", func() {
+		BeforeEach(func() {
+			err := sqlDB.Client.AutoMigrate(&v7.RouterGroupDB{}, &v7.TcpRouteMapping{}, &v7.Route{})
+			Expect(err).ToNot(HaveOccurred())
+
+			v20Migration := migration.NewV20UdpRoutes()
+			err = v20Migration.Run(sqlDB)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("creates the udp routes table", func() {
+			Expect(sqlDB.Client.HasTable(&models.UdpRouteMapping{})).To(BeTrue())
+		})
+
+		It("allows a backend to be mapped only once per external port of a router group", func() {
+			mapping, err := models.NewUdpRouteMappingWithModel(models.NewUdpRouteMapping("rg-guid", 5300, "10.0.0.1", 53, "", 60, models.ModificationTag{}))
+			Expect(err).NotTo(HaveOccurred())
+			_, err = sqlDB.Client.Create(&mapping)
+			Expect(err).NotTo(HaveOccurred())
+
+			duplicate, err := models.NewUdpRouteMappingWithModel(models.NewUdpRouteMapping("rg-guid", 5300, "10.0.0.1", 53, "instance-2", 60, models.ModificationTag{}))
+			Expect(err).NotTo(HaveOccurred())
+			_, err = sqlDB.Client.Create(&duplicate)
+			Expect(err).To(HaveOccurred())
+
+			otherPort, err := models.NewUdpRouteMappingWithModel(models.NewUdpRouteMapping("rg-guid", 5301, "10.0.0.1", 53, "", 60, models.ModificationTag{}))
+			Expect(err).NotTo(HaveOccurred())
+			_, err = sqlDB.Client.Create(&otherPort)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("is idempotent", func() {
+			v20Migration := migration.NewV20UdpRoutes()
+			err := v20Migration.Run(sqlDB)
+			Expect(err).ToNot(HaveOccurred())
+		})
+	})
+})
@@ -0,0 +1,80 @@
+package migration_test
+
+import (
+	"time"
+
+	"code.cloudfoundry.org/routing-api/cmd/routing-api/testrunner"
+	"code.cloudfoundry.org/routing-api/db"
+	"code.cloudfoundry.org/routing-api/migration"
+	"code.cloudfoundry.org/routing-api/models"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("V21TcpRoutePortRanges", func() {
+	var (
+		sqlDB       *db.SqlDB
+		dbAllocator testrunner.DbAllocator
+	)
+
+	BeforeEach(func() {
+		dbAllocator = testrunner.NewDbAllocator()
+		sqlCfg, err := dbAllocator.Create()
+		Expect(err).NotTo(HaveOccurred())
+
+		sqlDB, err = db.NewSqlDB(sqlCfg)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		err := dbAllocator.Delete()
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	Describe("Version", func() {
+		It("returns 21 for the version", func() {
+			v21Migration := migration.NewV21TcpRoutePortRanges()
+			Expect(v21Migration.Version()).To(Equal(21))
+		})
+	})
+
+	Describe("Run", func() {
+		BeforeEach(func() {
+			v0Migration := migration.NewV0InitMigration()
+			err := v0Migration.Run(sqlDB)
+			Expect(err).ToNot(HaveOccurred())
+
+			v14Migration := migration.NewV14Labels()
+			err = v14Migration.Run(sqlDB)
+			Expect(err).ToNot(HaveOccurred())
+
+			v21Migration := migration.NewV21TcpRoutePortRanges()
+			err = v21Migration.Run(sqlDB)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("allows mappings of different port ranges starting at the same external port", func() {
+			for i, portEnd := range []uint16{40099, 40049} {
+				tcpRoute := models.TcpRouteMapping{
+					Model:     models.Model{Guid: []string{"guid-1", "guid-2"}[i]},
+					ExpiresAt: time.Now().Add(1 * time.Hour),
+					TcpMappingEntity: models.TcpMappingEntity{
+						RouterGroupGuid: "rg-guid",
+						HostPort:        50000,
+						HostIP:          "1.2.3.4",
+						ExternalPort:    40000,
+						ExternalPortEnd: portEnd,
+					},
+				}
+				_, err := sqlDB.Client.Create(&tcpRoute)
+				Expect(err).NotTo(HaveOccurred())
+			}
+
+			var tcpRoutes []models.TcpRouteMapping
+			err := sqlDB.Client.Where("router_group_guid = ?", "rg-guid").Find(&tcpRoutes)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(tcpRoutes).To(HaveLen(2))
+		})
+	})
+})
@@ -0,0 +1,42 @@
+package migration
+
+import (
+	"code.cloudfoundry.org/routing-api/db"
+	"code.cloudfoundry.org/routing-api/models"
+)
+
+type V22TcpRouteHealthChecks struct{}
+
+var _ Migration = new(V22TcpRouteHealthChecks)
+
+func NewV22TcpRouteHealthChecks() *V22TcpRouteHealthChecks {
+	return &V22TcpRouteHealthChecks{}
+}
+
+func (v *V22TcpRouteHealthChecks) Version() int {
+	return 22
+}
+
+func (v *V22TcpRouteHealthChecks) Run(sqlDB *db.SqlDB) error {
+	// Drop index BEFORE AutoMigrate to avoid MySQL error 1170
+	// when Gorm v2 tries to change VARCHAR columns to LONGTEXT
+	dropIndex(sqlDB, "idx_tcp_route", "tcp_routes")
+
+	// Run AutoMigrate to add the JSON encoded HealthCheck column
+	err := sqlDB.Client.AutoMigrate(&models.TcpRouteMapping{})
+	if err != nil {
+		return err
+	}
+
+	// Recreate unique index with proper MySQL prefix lengths for LONGTEXT columns
+	// Note: HealthCheck is NOT part of the unique index
+	var indexSQL string
+	if sqlDB.Client.Dialect().Name() == "mysql" {
+		// MySQL requires prefix lengths for TEXT/LONGTEXT columns in indexes
+		indexSQL = "CREATE UNIQUE INDEX idx_tcp_route ON tcp_routes (router_group_guid(191), host_port, host_ip(191), external_port, external_port_end, sni_hostname(191), host_tls_port, terminate_frontend_tls, enable_backend_m_tls)"
+	} else {
+		// PostgreSQL doesn't require prefix lengths
+		indexSQL = "CREATE UNIQUE INDEX idx_tcp_route ON tcp_routes (router_group_guid, host_port, host_ip, external_port, external_port_end, sni_hostname, host_tls_port, terminate_frontend_tls, enable_backend_m_tls)"
+	}
+	return sqlDB.Client.ExecWithError(indexSQL)
+}
@@ -0,0 +1,93 @@
+package migration_test
+
+import (
+	"time"
+
+	"code.cloudfoundry.org/routing-api/cmd/routing-api/testrunner"
+	"code.cloudfoundry.org/routing-api/db"
+	"code.cloudfoundry.org/routing-api/migration"
+	"code.cloudfoundry.org/routing-api/models"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("V22TcpRouteHealthChecks", func() {
+	var (
+		sqlDB       *db.SqlDB
+		dbAllocator testrunner.DbAllocator
+	)
+
+	BeforeEach(func() {
+		dbAllocator = testrunner.NewDbAllocator()
+		sqlCfg, err := dbAllocator.Create()
+		Expect(err).NotTo(HaveOccurred())
+
+		sqlDB, err = db.NewSqlDB(sqlCfg)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		err := dbAllocator.Delete()
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	Describe("Version", func() {
+		It("returns 22 for the version", func() {
+			v22Migration := migration.NewV22TcpRouteHealthChecks()
+			Expect(v22Migration.Version()).To(Equal(22))
+		})
+	})
+
+	Describe("Run", func() {
+		BeforeEach(func() {
+			v0Migration := migration.NewV0InitMigration()
+			err := v0Migration.Run(sqlDB)
+			Expect(err).ToNot(HaveOccurred())
+
+			v14Migration := migration.NewV14Labels()
+			err = v14Migration.Run(sqlDB)
+			Expect(err).ToNot(HaveOccurred())
+
+			v21Migration := migration.NewV21TcpRoutePortRanges()
+			err = v21Migration.Run(sqlDB)
+			Expect(err).ToNot(HaveOccurred())
+
+			v22Migration := migration.NewV22TcpRouteHealthChecks()
+			err = v22Migration.Run(sqlDB)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("stores the health checks of tcp route mappings", func() {
+			tcpRoute := models.TcpRouteMapping{
+				Model:     models.Model{Guid: "guid-1"},
+				ExpiresAt: time.Now().Add(1 * time.Hour),
+				TcpMappingEntity: models.TcpMappingEntity{
+					RouterGroupGuid: "rg-guid",
+					HostPort:        50000,
+					HostIP:          "1.2.3.4",
+					ExternalPort:    40000,
+					HealthCheck: &models.TcpHealthCheck{
+						Type:     models.TcpHealthCheckHTTP,
+						Interval: 10,
+						Path:     "/health",
+					},
+				},
+			}
+			_, err := sqlDB.Client.Create(&tcpRoute)
+			Expect(err).NotTo(HaveOccurred())
+
+			var tcpRoutes []models.TcpRouteMapping
+			err = sqlDB.Client.Where("router_group_guid = ?", "rg-guid").Find(&tcpRoutes)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(tcpRoutes).To(HaveLen(1))
+			Expect(tcpRoutes[0].HealthCheck).To(Equal(tcpRoute.HealthCheck))
+		})
+
+		It("is idempotent", func() {
+			v22Migration := migration.NewV22TcpRouteHealthChecks()
+			err := v22Migration.Run(sqlDB)
+			Expect(err).ToNot(HaveOccurred())
+		})
+	})
+})
@@ -0,0 +1,81 @@
+package migration_test
+
+import (
+	"time"
+
+	"code.cloudfoundry.org/routing-api/cmd/routing-api/testrunner"
+	"code.cloudfoundry.org/routing-api/db"
+	"code.cloudfoundry.org/routing-api/migration"
+	"code.cloudfoundry.org/routing-api/models"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("V23TcpRouteProxyProtocol", func() {
+	var (
+		sqlDB       *db.SqlDB
+		dbAllocator testrunner.DbAllocator
+	)
+
+	BeforeEach(func() {
+		dbAllocator = testrunner.NewDbAllocator()
+		sqlCfg, err := dbAllocator.Create()
+		Expect(err).NotTo(HaveOccurred())
+
+		sqlDB, err = db.NewSqlDB(sqlCfg)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		err := dbAllocator.Delete()
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	Describe("Version", func() {
+		It("returns 23 for the version", func() {
+			v23Migration := migration.NewV23TcpRouteProxyProtocol()
+			Expect(v23Migration.Version()).To(Equal(23))
+		})
+	})
+
+	Describe("Run", func() {
+		BeforeEach(func() {
+			v0Migration := migration.NewV0InitMigration()
+			err := v0Migration.Run(sqlDB)
+			Expect(err).ToNot(HaveOccurred())
+
+			v23Migration := migration.NewV23TcpRouteProxyProtocol()
+			err = v23Migration.Run(sqlDB)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("stores the proxy protocol of tcp route mappings", func() {
+			tcpRoute := models.TcpRouteMapping{
+				Model:     models.Model{Guid: "guid-1"},
+				ExpiresAt: time.Now().Add(1 * time.Hour),
+				TcpMappingEntity: models.TcpMappingEntity{
+					RouterGroupGuid: "rg-guid",
+					HostPort:        50000,
+					HostIP:          "1.2.3.4",
+					ExternalPort:    40000,
+					ProxyProtocol:   models.ProxyProtocolV2,
+				},
+			}
+			_, err := sqlDB.Client.Create(&tcpRoute)
+			Expect(err).NotTo(HaveOccurred())
+
+			var tcpRoutes []models.TcpRouteMapping
+			err = sqlDB.Client.Where("router_group_guid = ?", "rg-guid").Find(&tcpRoutes)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(tcpRoutes).To(HaveLen(1))
+			Expect(tcpRoutes[0].ProxyProtocol).To(Equal(models.ProxyProtocolV2))
+		})
+
+		It("is idempotent", func() {
+			v23Migration := migration.NewV23TcpRouteProxyProtocol()
+			err := v23Migration.Run(sqlDB)
+			Expect(err).ToNot(HaveOccurred())
+		})
+	})
+})
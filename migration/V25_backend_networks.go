@@ -0,0 +1,22 @@
+package migration
+
+import (
+	"code.cloudfoundry.org/routing-api/db"
+	"code.cloudfoundry.org/routing-api/models"
+)
+
+type V25BackendNetworks struct{}
+
+var _ Migration = new(V25BackendNetworks)
+
+func NewV25BackendNetworks() *V25BackendNetworks {
+	return &V25BackendNetworks{}
+}
+
+func (v *V25BackendNetworks) Version() int {
+	return 25
+}
+
+func (v *V25BackendNetworks) Run(sqlDB *db.SqlDB) error {
+	return sqlDB.Client.AutoMigrate(&models.RouterGroupDB{})
+}
@@ -0,0 +1,75 @@
+package migration_test
+
+import (
+	"code.cloudfoundry.org/routing-api/cmd/routing-api/testrunner"
+	"code.cloudfoundry.org/routing-api/db"
+	"code.cloudfoundry.org/routing-api/migration"
+	"code.cloudfoundry.org/routing-api/models"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("V25BackendNetworks", func() {
+	var (
+		sqlDB       *db.SqlDB
+		dbAllocator testrunner.DbAllocator
+	)
+
+	BeforeEach(func() {
+		dbAllocator = testrunner.NewDbAllocator()
+		sqlCfg, err := dbAllocator.Create()
+		Expect(err).NotTo(HaveOccurred())
+
+		sqlDB, err = db.NewSqlDB(sqlCfg)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		err := dbAllocator.Delete()
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	Describe("Version", func() {
+		It("returns 25 for the version", func() {
+			v25Migration := migration.NewV25BackendNetworks()
+			Expect(v25Migration.Version()).To(Equal(25))
+		})
+	})
+
+	Describe("Run", func() {
+		BeforeEach(func() {
+			v0Migration := migration.NewV0InitMigration()
+			err := v0Migration.Run(sqlDB)
+			Expect(err).ToNot(HaveOccurred())
+
+			v25Migration := migration.NewV25BackendNetworks()
+			err = v25Migration.Run(sqlDB)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("stores the backend networks of router groups", func() {
+			routerGroup := models.NewRouterGroupDB(models.RouterGroup{
+				Guid:                "guid-1",
+				Name:                "rg-1",
+				Type:                models.RouterGroup_TCP,
+				ReservablePorts:     "1024-2048",
+				AllowedBackendCIDRs: "10.0.0.0/8,fd00::/8",
+				DeniedBackendCIDRs:  "10.255.0.0/16",
+			})
+			_, err := sqlDB.Client.Create(&routerGroup)
+			Expect(err).NotTo(HaveOccurred())
+
+			rg, err := sqlDB.ReadRouterGroup("guid-1")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(rg.AllowedBackendCIDRs).To(Equal(models.CIDRs("10.0.0.0/8,fd00::/8")))
+			Expect(rg.DeniedBackendCIDRs).To(Equal(models.CIDRs("10.255.0.0/16")))
+		})
+
+		It("is idempotent", func() {
+			v25Migration := migration.NewV25BackendNetworks()
+			err := v25Migration.Run(sqlDB)
+			Expect(err).ToNot(HaveOccurred())
+		})
+	})
+})
@@ -0,0 +1,60 @@
+package migration
+
+import (
+	"code.cloudfoundry.org/routing-api/db"
+	"code.cloudfoundry.org/routing-api/models"
+)
+
+type V26TlsCertificates struct{}
+
+var _ Migration = new(V26TlsCertificates)
+
+func NewV26TlsCertificates() *V26TlsCertificates {
+	return &V26TlsCertificates{}
+}
+
+func (v *V26TlsCertificates) Version() int {
+	return 26
+}
+
+func (v *V26TlsCertificates) Run(sqlDB *db.SqlDB) error {
+	err := sqlDB.Client.AutoMigrate(&models.TlsCertificate{})
+	if err != nil {
+		return err
+	}
+
+	dropIndex(sqlDB, "idx_tls_certificate_sni_hostname", "tls_certificates")
+
+	// There is only one certificate per sni hostname
+	var indexSQL string
+	if sqlDB.Client.Dialect().Name() == "mysql" {
+		indexSQL = "CREATE UNIQUE INDEX idx_tls_certificate_sni_hostname ON tls_certificates (sni_hostname(191))"
+	} else {
+		indexSQL = "CREATE UNIQUE INDEX idx_tls_certificate_sni_hostname ON tls_certificates (sni_hostname)"
+	}
+	err = sqlDB.Client.ExecWithError(indexSQL)
+	if err != nil {
+		return err
+	}
+
+	// Drop index BEFORE AutoMigrate to avoid MySQL error 1170
+	// when Gorm v2 tries to change VARCHAR columns to LONGTEXT
+	dropIndex(sqlDB, "idx_tcp_route", "tcp_routes")
+
+	// Run AutoMigrate to add the tls_certificate_guid column
+	err = sqlDB.Client.AutoMigrate(&models.TcpRouteMapping{})
+	if err != nil {
+		return err
+	}
+
+	// Recreate unique index with proper MySQL prefix lengths for LONGTEXT columns
+	// Note: tls_certificate_guid is NOT part of the unique index
+	if sqlDB.Client.Dialect().Name() == "mysql" {
+		// MySQL requires prefix lengths for TEXT/LONGTEXT columns in indexes
+		indexSQL = "CREATE UNIQUE INDEX idx_tcp_route ON tcp_routes (router_group_guid(191), host_port, host_ip(191), external_port, external_port_end, sni_hostname(191), host_tls_port, terminate_frontend_tls, enable_backend_m_tls)"
+	} else {
+		// PostgreSQL doesn't require prefix lengths
+		indexSQL = "CREATE UNIQUE INDEX idx_tcp_route ON tcp_routes (router_group_guid, host_port, host_ip, external_port, external_port_end, sni_hostname, host_tls_port, terminate_frontend_tls, enable_backend_m_tls)"
+	}
+	return sqlDB.Client.ExecWithError(indexSQL)
+}
@@ -0,0 +1,110 @@
+package migration_test
+
+import (
+	"time"
+
+	"code.cloudfoundry.org/routing-api/cmd/routing-api/testrunner"
+	"code.cloudfoundry.org/routing-api/db"
+	"code.cloudfoundry.org/routing-api/migration"
+	"code.cloudfoundry.org/routing-api/models"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("V26TlsCertificates", func() {
+	var (
+		sqlDB       *db.SqlDB
+		dbAllocator testrunner.DbAllocator
+	)
+
+	BeforeEach(func() {
+		dbAllocator = testrunner.NewDbAllocator()
+		sqlCfg, err := dbAllocator.Create()
+		Expect(err).NotTo(HaveOccurred())
+
+		sqlDB, err = db.NewSqlDB(sqlCfg)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		err := dbAllocator.Delete()
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	Describe("Version", func() {
+		It("returns 26 for the version", func() {
+			v26Migration := migration.NewV26TlsCertificates()
+			Expect(v26Migration.Version()).To(Equal(26))
+		})
+	})
+
+	Describe("Run", func() {
+		BeforeEach(func() {
+			v0Migration := migration.NewV0InitMigration()
+			err := v0Migration.Run(sqlDB)
+			Expect(err).ToNot(HaveOccurred())
+
+			v26Migration := migration.NewV26TlsCertificates()
+			err = v26Migration.Run(sqlDB)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("creates the tls certificates table", func() {
+			Expect(sqlDB.Client.HasTable(&models.TlsCertificate{})).To(BeTrue())
+		})
+
+		It("allows only one tls certificate per sni hostname", func() {
+			certificate, err := models.NewTlsCertificateWithModel(models.TlsCertificate{
+				TlsCertificateEntity: models.TlsCertificateEntity{
+					SniHostname:         "example.com",
+					CertChain:           "chain-1",
+					EncryptedPrivateKey: "key-1",
+				},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			_, err = sqlDB.Client.Create(&certificate)
+			Expect(err).NotTo(HaveOccurred())
+
+			duplicate, err := models.NewTlsCertificateWithModel(models.TlsCertificate{
+				TlsCertificateEntity: models.TlsCertificateEntity{
+					SniHostname:         "example.com",
+					CertChain:           "chain-2",
+					EncryptedPrivateKey: "key-2",
+				},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			_, err = sqlDB.Client.Create(&duplicate)
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("stores the tls certificate guid of tcp route mappings", func() {
+			tcpRoute := models.TcpRouteMapping{
+				Model:     models.Model{Guid: "guid-1"},
+				ExpiresAt: time.Now().Add(1 * time.Hour),
+				TcpMappingEntity: models.TcpMappingEntity{
+					RouterGroupGuid:      "rg-guid",
+					HostPort:             50000,
+					HostIP:               "1.2.3.4",
+					ExternalPort:         40000,
+					TerminateFrontendTLS: true,
+					TlsCertificateGuid:   "certificate-guid",
+				},
+			}
+			_, err := sqlDB.Client.Create(&tcpRoute)
+			Expect(err).NotTo(HaveOccurred())
+
+			var tcpRoutes []models.TcpRouteMapping
+			err = sqlDB.Client.Where("router_group_guid = ?", "rg-guid").Find(&tcpRoutes)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(tcpRoutes).To(HaveLen(1))
+			Expect(tcpRoutes[0].TlsCertificateGuid).To(Equal("certificate-guid"))
+		})
+
+		It("is idempotent", func() {
+			v26Migration := migration.NewV26TlsCertificates()
+			err := v26Migration.Run(sqlDB)
+			Expect(err).ToNot(HaveOccurred())
+		})
+	})
+})
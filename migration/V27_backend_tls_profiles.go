@@ -0,0 +1,60 @@
+package migration
+
+import (
+	"code.cloudfoundry.org/routing-api/db"
+	"code.cloudfoundry.org/routing-api/models"
+)
+
+type V27BackendTlsProfiles struct{}
+
+var _ Migration = new(V27BackendTlsProfiles)
+
+func NewV27BackendTlsProfiles() *V27BackendTlsProfiles {
+	return &V27BackendTlsProfiles{}
+}
+
+func (v *V27BackendTlsProfiles) Version() int {
+	return 27
+}
+
+func (v *V27BackendTlsProfiles) Run(sqlDB *db.SqlDB) error {
+	err := sqlDB.Client.AutoMigrate(&models.BackendTlsProfile{})
+	if err != nil {
+		return err
+	}
+
+	dropIndex(sqlDB, "idx_backend_tls_profile_name", "backend_tls_profiles")
+
+	// Tcp route mappings refer to profiles by name
+	var indexSQL string
+	if sqlDB.Client.Dialect().Name() == "mysql" {
+		indexSQL = "CREATE UNIQUE INDEX idx_backend_tls_profile_name ON backend_tls_profiles (name(191))"
+	} else {
+		indexSQL = "CREATE UNIQUE INDEX idx_backend_tls_profile_name ON backend_tls_profiles (name)"
+	}
+	err = sqlDB.Client.ExecWithError(indexSQL)
+	if err != nil {
+		return err
+	}
+
+	// Drop index BEFORE AutoMigrate to avoid MySQL error 1170
+	// when Gorm v2 tries to change VARCHAR columns to LONGTEXT
+	dropIndex(sqlDB, "idx_tcp_route", "tcp_routes")
+
+	// Run AutoMigrate to add the backend_tls_profile column
+	err = sqlDB.Client.AutoMigrate(&models.TcpRouteMapping{})
+	if err != nil {
+		return err
+	}
+
+	// Recreate unique index with proper MySQL prefix lengths for LONGTEXT columns
+	// Note: backend_tls_profile is NOT part of the unique index
+	if sqlDB.Client.Dialect().Name() == "mysql" {
+		// MySQL requires prefix lengths for TEXT/LONGTEXT columns in indexes
+		indexSQL = "CREATE UNIQUE INDEX idx_tcp_route ON tcp_routes (router_group_guid(191), host_port, host_ip(191), external_port, external_port_end, sni_hostname(191), host_tls_port, terminate_frontend_tls, enable_backend_m_tls)"
+	} else {
+		// PostgreSQL doesn't require prefix lengths
+		indexSQL = "CREATE UNIQUE INDEX idx_tcp_route ON tcp_routes (router_group_guid, host_port, host_ip, external_port, external_port_end, sni_hostname, host_tls_port, terminate_frontend_tls, enable_backend_m_tls)"
+	}
+	return sqlDB.Client.ExecWithError(indexSQL)
+}
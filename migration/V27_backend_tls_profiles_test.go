@@ -0,0 +1,112 @@
+package migration_test
+
+import (
+	"time"
+
+	"code.cloudfoundry.org/routing-api/cmd/routing-api/testrunner"
+	"code.cloudfoundry.org/routing-api/db"
+	"code.cloudfoundry.org/routing-api/migration"
+	"code.cloudfoundry.org/routing-api/models"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("V27BackendTlsProfiles", func() {
+	var (
+		sqlDB       *db.SqlDB
+		dbAllocator testrunner.DbAllocator
+	)
+
+	BeforeEach(func() {
+		dbAllocator = testrunner.NewDbAllocator()
+		sqlCfg, err := dbAllocator.Create()
+		Expect(err).NotTo(HaveOccurred())
+
+		sqlDB, err = db.NewSqlDB(sqlCfg)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		err := dbAllocator.Delete()
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	Describe("Version", func() {
+		It("returns 27 for the version", func() {
+			v27Migration := migration.NewV27BackendTlsProfiles()
+			Expect(v27Migration.Version()).To(Equal(27))
+		})
+	})
+
+	Describe("Run", func() {
+		BeforeEach(func() {
+			v0Migration := migration.NewV0InitMigration()
+			err := v0Migration.Run(sqlDB)
+			Expect(err).ToNot(HaveOccurred())
+
+			v27Migration := migration.NewV27BackendTlsProfiles()
+			err = v27Migration.Run(sqlDB)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("creates the backend tls profiles table", func() {
+			Expect(sqlDB.Client.HasTable(&models.BackendTlsProfile{})).To(BeTrue())
+		})
+
+		It("allows only one backend tls profile per name", func() {
+			profile, err := models.NewBackendTlsProfileWithModel(models.BackendTlsProfile{
+				BackendTlsProfileEntity: models.BackendTlsProfileEntity{
+					Name:                      "iso-seg-1",
+					ClientCertChain:           "chain-1",
+					EncryptedClientPrivateKey: "key-1",
+					TrustedCA:                 "ca-1",
+				},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			_, err = sqlDB.Client.Create(&profile)
+			Expect(err).NotTo(HaveOccurred())
+
+			duplicate, err := models.NewBackendTlsProfileWithModel(models.BackendTlsProfile{
+				BackendTlsProfileEntity: models.BackendTlsProfileEntity{
+					Name:                      "iso-seg-1",
+					ClientCertChain:           "chain-2",
+					EncryptedClientPrivateKey: "key-2",
+					TrustedCA:                 "ca-2",
+				},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			_, err = sqlDB.Client.Create(&duplicate)
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("stores the backend tls profile of tcp route mappings", func() {
+			tcpRoute := models.TcpRouteMapping{
+				Model:     models.Model{Guid: "guid-1"},
+				ExpiresAt: time.Now().Add(1 * time.Hour),
+				TcpMappingEntity: models.TcpMappingEntity{
+					RouterGroupGuid:   "rg-guid",
+					HostPort:          50000,
+					HostIP:            "1.2.3.4",
+					ExternalPort:      40000,
+					EnableBackendMTLS: true,
+					BackendTlsProfile: "iso-seg-1",
+				},
+			}
+			_, err := sqlDB.Client.Create(&tcpRoute)
+			Expect(err).NotTo(HaveOccurred())
+
+			var tcpRoutes []models.TcpRouteMapping
+			err = sqlDB.Client.Where("router_group_guid = ?", "rg-guid").Find(&tcpRoutes)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(tcpRoutes).To(HaveLen(1))
+			Expect(tcpRoutes[0].BackendTlsProfile).To(Equal("iso-seg-1"))
+		})
+
+		It("is idempotent", func() {
+			v27Migration := migration.NewV27BackendTlsProfiles()
+			err := v27Migration.Run(sqlDB)
+			Expect(err).ToNot(HaveOccurred())
+		})
+	})
+})
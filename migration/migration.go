@@ -111,6 +111,54 @@ func InitializeMigrations() []Migration {
 	migration = NewV11EnableBackendMTLS()
 	migrations = append(migrations, migration)
 
+	migration = NewV12RouteBackendTLS()
+	migrations = append(migrations, migration)
+
+	migration = NewV13RouteProtocol()
+	migrations = append(migrations, migration)
+
+	migration = NewV14Labels()
+	migrations = append(migrations, migration)
+
+	migration = NewV15PortReservations()
+	migrations = append(migrations, migration)
+
+	migration = NewV16RouterGroupDescription()
+	migrations = append(migrations, migration)
+
+	migration = NewV17TTLPolicy()
+	migrations = append(migrations, migration)
+
+	migration = NewV18RouterGroupQuotas()
+	migrations = append(migrations, migration)
+
+	migration = NewV19ExcludedPorts()
+	migrations = append(migrations, migration)
+
+	migration = NewV20UdpRoutes()
+	migrations = append(migrations, migration)
+
+	migration = NewV21TcpRoutePortRanges()
+	migrations = append(migrations, migration)
+
+	migration = NewV22TcpRouteHealthChecks()
+	migrations = append(migrations, migration)
+
+	migration = NewV23TcpRouteProxyProtocol()
+	migrations = append(migrations, migration)
+
+	migration = NewV24TcpRouteConnectionLimits()
+	migrations = append(migrations, migration)
+
+	migration = NewV25BackendNetworks()
+	migrations = append(migrations, migration)
+
+	migration = NewV26TlsCertificates()
+	migrations = append(migrations, migration)
+
+	migration = NewV27BackendTlsProfiles()
+	migrations = append(migrations, migration)
+
 	return migrations
 }
 
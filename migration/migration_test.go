@@ -43,7 +43,7 @@ var _ = Describe("Migration", func() {
 				done := make(chan struct{})
 				defer close(done)
 				migrations := migration.InitializeMigrations()
-				Expect(migrations).To(HaveLen(11))
+				Expect(migrations).To(HaveLen(27))
 
 				Expect(migrations[0]).To(BeAssignableToTypeOf(new(migration.V0InitMigration)))
 				Expect(migrations[1]).To(BeAssignableToTypeOf(new(migration.V2UpdateRgMigration)))
@@ -56,6 +56,22 @@ var _ = Describe("Migration", func() {
 				Expect(migrations[8]).To(BeAssignableToTypeOf(new(migration.V9TerminateFrontendTLS)))
 				Expect(migrations[9]).To(BeAssignableToTypeOf(new(migration.V10SniRewriteHostname)))
 				Expect(migrations[10]).To(BeAssignableToTypeOf(new(migration.V11EnableBackendMTLS)))
+				Expect(migrations[11]).To(BeAssignableToTypeOf(new(migration.V12RouteBackendTLS)))
+				Expect(migrations[12]).To(BeAssignableToTypeOf(new(migration.V13RouteProtocol)))
+				Expect(migrations[13]).To(BeAssignableToTypeOf(new(migration.V14Labels)))
+				Expect(migrations[14]).To(BeAssignableToTypeOf(new(migration.V15PortReservations)))
+				Expect(migrations[15]).To(BeAssignableToTypeOf(new(migration.V16RouterGroupDescription)))
+				Expect(migrations[16]).To(BeAssignableToTypeOf(new(migration.V17TTLPolicy)))
+				Expect(migrations[17]).To(BeAssignableToTypeOf(new(migration.V18RouterGroupQuotas)))
+				Expect(migrations[18]).To(BeAssignableToTypeOf(new(migration.V19ExcludedPorts)))
+				Expect(migrations[19]).To(BeAssignableToTypeOf(new(migration.V20UdpRoutes)))
+				Expect(migrations[20]).To(BeAssignableToTypeOf(new(migration.V21TcpRoutePortRanges)))
+				Expect(migrations[21]).To(BeAssignableToTypeOf(new(migration.V22TcpRouteHealthChecks)))
+				Expect(migrations[22]).To(BeAssignableToTypeOf(new(migration.V23TcpRouteProxyProtocol)))
+				Expect(migrations[23]).To(BeAssignableToTypeOf(new(migration.V24TcpRouteConnectionLimits)))
+				Expect(migrations[24]).To(BeAssignableToTypeOf(new(migration.V25BackendNetworks)))
+				Expect(migrations[25]).To(BeAssignableToTypeOf(new(migration.V26TlsCertificates)))
+				Expect(migrations[26]).To(BeAssignableToTypeOf(new(migration.V27BackendTlsProfiles)))
 			})
 		})
 
@@ -0,0 +1,87 @@
+package models
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ianaALPNProtocolIDs are the protocol ids of the IANA TLS Application-Layer
+// Protocol Negotiation (ALPN) Protocol IDs registry.
+var ianaALPNProtocolIDs = []string{
+	"http/0.9", "http/1.0", "http/1.1",
+	"spdy/1", "spdy/2", "spdy/3",
+	"stun.turn", "stun.nat-discovery",
+	"h2", "h2c", "h3",
+	"webrtc", "c-webrtc",
+	"ftp", "imap", "pop3", "managesieve",
+	"coap", "xmpp-client", "xmpp-server",
+	"acme-tls/1", "mqtt", "dot", "doq", "ntske/1", "sunrpc",
+	"smb", "irc", "nntp", "nnsp", "sip/2", "tds/8.0", "dicom",
+	"postgresql", "radius/1.0", "radius/1.1",
+}
+
+// ALPNs is a comma separated list of ALPN protocol ids, in the order of
+// preference. It is unmarshaled from a csv string or a JSON array of strings
+// and normalized, so that "h2 ,http/1.1" is stored as "h2,http/1.1".
+type ALPNs string
+
+// NewALPNs joins the protocol ids, dropping blanks and duplicates.
+func NewALPNs(ids []string) ALPNs {
+	normalized := []string{}
+	for _, id := range ids {
+		id = strings.TrimSpace(id)
+		if id != "" && !containsString(normalized, id) {
+			normalized = append(normalized, id)
+		}
+	}
+	return ALPNs(strings.Join(normalized, ","))
+}
+
+// List returns the protocol ids.
+func (a ALPNs) List() []string {
+	if a == "" {
+		return []string{}
+	}
+	return strings.Split(string(a.Normalize()), ",")
+}
+
+// Normalize drops blanks and duplicates from the protocol ids.
+func (a ALPNs) Normalize() ALPNs {
+	return NewALPNs(strings.Split(string(a), ","))
+}
+
+func (a *ALPNs) UnmarshalJSON(data []byte) error {
+	var csv string
+	if err := json.Unmarshal(data, &csv); err == nil {
+		*a = ALPNs(csv).Normalize()
+		return nil
+	}
+
+	var ids []string
+	if err := json.Unmarshal(data, &ids); err != nil {
+		return errors.New("alpns must be a comma separated string or an array of strings")
+	}
+	for _, id := range ids {
+		if strings.Contains(id, ",") {
+			return fmt.Errorf("alpn '%s' must not contain a comma", id)
+		}
+	}
+	*a = NewALPNs(ids)
+	return nil
+}
+
+// Validate returns an error for protocol ids that are neither registered with
+// IANA nor one of the custom ids.
+func (a ALPNs) Validate(customIDs []string) error {
+	for _, id := range a.List() {
+		if len(id) > 255 {
+			return fmt.Errorf("alpn '%s' must be at most 255 bytes", id)
+		}
+		if !containsString(ianaALPNProtocolIDs, id) && !containsString(customIDs, id) {
+			return fmt.Errorf("alpn '%s' is neither registered with IANA nor a configured custom alpn", id)
+		}
+	}
+	return nil
+}
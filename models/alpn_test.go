@@ -0,0 +1,70 @@
+package models_test
+
+import (
+	"encoding/json"
+
+	"code.cloudfoundry.org/routing-api/models"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ALPNs", func() {
+	Describe("UnmarshalJSON", func() {
+		It("normalizes a csv string", func() {
+			var alpns models.ALPNs
+			err := json.Unmarshal([]byte(`"h2 ,http/1.1,,h2"`), &alpns)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(alpns).To(Equal(models.ALPNs("h2,http/1.1")))
+		})
+
+		It("accepts an array of strings", func() {
+			var alpns models.ALPNs
+			err := json.Unmarshal([]byte(`["h2", " http/1.1"]`), &alpns)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(alpns).To(Equal(models.ALPNs("h2,http/1.1")))
+		})
+
+		It("rejects array entries with a comma", func() {
+			var alpns models.ALPNs
+			err := json.Unmarshal([]byte(`["h2,http/1.1"]`), &alpns)
+			Expect(err).To(MatchError("alpn 'h2,http/1.1' must not contain a comma"))
+		})
+
+		It("rejects other values", func() {
+			var alpns models.ALPNs
+			err := json.Unmarshal([]byte(`{"h2": true}`), &alpns)
+			Expect(err).To(MatchError("alpns must be a comma separated string or an array of strings"))
+		})
+
+		It("is marshaled as a csv string", func() {
+			data, err := json.Marshal(models.NewALPNs([]string{"h2", "http/1.1"}))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(data).To(MatchJSON(`"h2,http/1.1"`))
+		})
+	})
+
+	Describe("List", func() {
+		It("returns the protocol ids in order", func() {
+			Expect(models.ALPNs("http/1.1, h2").List()).To(Equal([]string{"http/1.1", "h2"}))
+		})
+
+		It("returns no protocol ids when empty", func() {
+			Expect(models.ALPNs("").List()).To(BeEmpty())
+		})
+	})
+
+	Describe("Validate", func() {
+		It("accepts protocol ids registered with IANA", func() {
+			Expect(models.ALPNs("h2,http/1.1,acme-tls/1").Validate(nil)).To(Succeed())
+		})
+
+		It("accepts custom protocol ids", func() {
+			Expect(models.ALPNs("h2,my-proto").Validate([]string{"my-proto"})).To(Succeed())
+		})
+
+		It("rejects unknown protocol ids", func() {
+			Expect(models.ALPNs("h2,HTTP/1.1").Validate([]string{"my-proto"})).To(MatchError("alpn 'HTTP/1.1' is neither registered with IANA nor a configured custom alpn"))
+		})
+	})
+})
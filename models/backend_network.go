@@ -0,0 +1,98 @@
+package models
+
+import (
+	"fmt"
+	"net/netip"
+	"strings"
+)
+
+// CanonicalIP returns the canonical form of an IPv4 or IPv6 address, so that
+// "::FFFF:10.0.0.1" and "10.0.0.1" or "2001:DB8::0:1" and "2001:db8::1" are
+// the same backend.
+func CanonicalIP(ip string) (string, error) {
+	addr, err := netip.ParseAddr(ip)
+	if err != nil || addr.Zone() != "" {
+		return "", fmt.Errorf("backend ip '%s' is not an IPv4 or IPv6 address", ip)
+	}
+	return addr.Unmap().String(), nil
+}
+
+// CIDRs is a comma separated list of networks in CIDR notation.
+type CIDRs string
+
+// Parse returns the networks of the list.
+func (c CIDRs) Parse() ([]netip.Prefix, error) {
+	if c == "" {
+		return nil, nil
+	}
+	return ParseCIDRs(strings.Split(string(c), ","))
+}
+
+// ParseCIDRs parses networks in CIDR notation.
+func ParseCIDRs(cidrs []string) ([]netip.Prefix, error) {
+	prefixes := []netip.Prefix{}
+	for _, cidr := range cidrs {
+		prefix, err := netip.ParsePrefix(strings.TrimSpace(cidr))
+		if err != nil {
+			return nil, fmt.Errorf("invalid cidr '%s'", strings.TrimSpace(cidr))
+		}
+		prefixes = append(prefixes, prefix.Masked())
+	}
+	return prefixes, nil
+}
+
+// BackendNetworkPolicy restricts the addresses that routes may forward to.
+// Addresses in a denied network are always rejected. When there are allowed
+// networks, addresses outside of them are rejected as well.
+type BackendNetworkPolicy struct {
+	AllowedCIDRs []netip.Prefix
+	DeniedCIDRs  []netip.Prefix
+}
+
+// ForRouterGroup returns the policy for the routes of the router group: its
+// allowed backend networks replace the allowed networks of the policy, and
+// its denied backend networks add to the denied networks.
+func (p BackendNetworkPolicy) ForRouterGroup(g RouterGroup) (BackendNetworkPolicy, error) {
+	allowed, err := g.AllowedBackendCIDRs.Parse()
+	if err != nil {
+		return BackendNetworkPolicy{}, err
+	}
+	denied, err := g.DeniedBackendCIDRs.Parse()
+	if err != nil {
+		return BackendNetworkPolicy{}, err
+	}
+
+	policy := BackendNetworkPolicy{
+		AllowedCIDRs: p.AllowedCIDRs,
+		DeniedCIDRs:  append(append([]netip.Prefix{}, p.DeniedCIDRs...), denied...),
+	}
+	if len(allowed) > 0 {
+		policy.AllowedCIDRs = allowed
+	}
+	return policy, nil
+}
+
+// Validate returns an error when the backend ip is not an address or is not
+// allowed by the policy.
+func (p BackendNetworkPolicy) Validate(ip string) error {
+	canonical, err := CanonicalIP(ip)
+	if err != nil {
+		return err
+	}
+	addr := netip.MustParseAddr(canonical)
+
+	for _, prefix := range p.DeniedCIDRs {
+		if prefix.Contains(addr) {
+			return fmt.Errorf("backend ip %s is within the denied network %s", canonical, prefix)
+		}
+	}
+	if len(p.AllowedCIDRs) == 0 {
+		return nil
+	}
+	for _, prefix := range p.AllowedCIDRs {
+		if prefix.Contains(addr) {
+			return nil
+		}
+	}
+	return fmt.Errorf("backend ip %s is not within the allowed networks", canonical)
+}
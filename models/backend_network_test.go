@@ -0,0 +1,135 @@
+package models_test
+
+import (
+	"net/netip"
+
+	"code.cloudfoundry.org/routing-api/models"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Backend Networks", func() {
+	Describe("CanonicalIP", func() {
+		DescribeTable("canonicalizes IPv4 and IPv6 addresses",
+			func(ip, expected string) {
+				canonical, err := models.CanonicalIP(ip)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(canonical).To(Equal(expected))
+			},
+			Entry("an IPv4 address", "10.0.0.1", "10.0.0.1"),
+			Entry("an IPv6 address", "2001:db8::1", "2001:db8::1"),
+			Entry("an upper case IPv6 address", "2001:DB8::1", "2001:db8::1"),
+			Entry("an uncompressed IPv6 address", "2001:0db8:0000:0000:0000:0000:0000:0001", "2001:db8::1"),
+			Entry("an IPv4-mapped IPv6 address", "::ffff:10.0.0.1", "10.0.0.1"),
+		)
+
+		DescribeTable("rejects anything else",
+			func(ip string) {
+				_, err := models.CanonicalIP(ip)
+				Expect(err).To(MatchError("backend ip '" + ip + "' is not an IPv4 or IPv6 address"))
+			},
+			Entry("a hostname", "backend.example.com"),
+			Entry("a truncated IPv4 address", "10.0.1"),
+			Entry("an IPv4 address with a leading zero", "010.0.0.1"),
+			Entry("an address with a port", "10.0.0.1:8080"),
+			Entry("a network", "10.0.0.0/8"),
+			Entry("an IPv6 address with a zone", "fe80::1%eth0"),
+		)
+	})
+
+	Describe("CIDRs", func() {
+		It("parses comma separated networks", func() {
+			prefixes, err := models.CIDRs("10.0.0.0/8, fd00::/8").Parse()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(prefixes).To(Equal([]netip.Prefix{netip.MustParsePrefix("10.0.0.0/8"), netip.MustParsePrefix("fd00::/8")}))
+		})
+
+		It("masks the host bits of networks", func() {
+			prefixes, err := models.CIDRs("10.1.2.3/16").Parse()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(prefixes).To(Equal([]netip.Prefix{netip.MustParsePrefix("10.1.0.0/16")}))
+		})
+
+		It("returns no networks for an empty list", func() {
+			prefixes, err := models.CIDRs("").Parse()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(prefixes).To(BeEmpty())
+		})
+
+		It("rejects invalid networks", func() {
+			_, err := models.CIDRs("10.0.0.0/8,10.0.0.1").Parse()
+			Expect(err).To(MatchError("invalid cidr '10.0.0.1'"))
+		})
+	})
+
+	Describe("BackendNetworkPolicy", func() {
+		var policy models.BackendNetworkPolicy
+
+		BeforeEach(func() {
+			policy = models.BackendNetworkPolicy{
+				AllowedCIDRs: []netip.Prefix{netip.MustParsePrefix("10.0.0.0/8"), netip.MustParsePrefix("fd00::/8")},
+				DeniedCIDRs:  []netip.Prefix{netip.MustParsePrefix("10.255.0.0/16")},
+			}
+		})
+
+		It("allows any address without networks", func() {
+			Expect(models.BackendNetworkPolicy{}.Validate("192.168.0.1")).To(Succeed())
+			Expect(models.BackendNetworkPolicy{}.Validate("2001:db8::1")).To(Succeed())
+		})
+
+		It("allows addresses in the allowed networks", func() {
+			Expect(policy.Validate("10.0.0.1")).To(Succeed())
+			Expect(policy.Validate("::ffff:10.0.0.1")).To(Succeed())
+			Expect(policy.Validate("FD00::1")).To(Succeed())
+		})
+
+		It("rejects addresses outside the allowed networks", func() {
+			Expect(policy.Validate("192.168.0.1")).To(MatchError("backend ip 192.168.0.1 is not within the allowed networks"))
+		})
+
+		It("rejects addresses in denied networks even when they are allowed", func() {
+			Expect(policy.Validate("10.255.0.1")).To(MatchError("backend ip 10.255.0.1 is within the denied network 10.255.0.0/16"))
+		})
+
+		It("rejects invalid addresses", func() {
+			Expect(policy.Validate("backend.example.com")).To(MatchError("backend ip 'backend.example.com' is not an IPv4 or IPv6 address"))
+		})
+
+		Describe("ForRouterGroup", func() {
+			It("keeps the policy for router groups without backend networks", func() {
+				routerGroupPolicy, err := policy.ForRouterGroup(models.RouterGroup{})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(routerGroupPolicy).To(Equal(policy))
+			})
+
+			It("replaces the allowed networks and adds to the denied networks", func() {
+				routerGroupPolicy, err := policy.ForRouterGroup(models.RouterGroup{
+					AllowedBackendCIDRs: "192.168.0.0/16",
+					DeniedBackendCIDRs:  "192.168.1.0/24",
+				})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(routerGroupPolicy.AllowedCIDRs).To(Equal([]netip.Prefix{netip.MustParsePrefix("192.168.0.0/16")}))
+				Expect(routerGroupPolicy.DeniedCIDRs).To(Equal([]netip.Prefix{netip.MustParsePrefix("10.255.0.0/16"), netip.MustParsePrefix("192.168.1.0/24")}))
+				Expect(policy.DeniedCIDRs).To(HaveLen(1))
+			})
+
+			It("returns an error for invalid networks", func() {
+				_, err := policy.ForRouterGroup(models.RouterGroup{DeniedBackendCIDRs: "not-a-cidr"})
+				Expect(err).To(MatchError("invalid cidr 'not-a-cidr'"))
+			})
+		})
+	})
+
+	Describe("Normalize", func() {
+		It("canonicalizes the backend ips of routes and tcp route mappings", func() {
+			route := models.NewRoute("foo.example.com", 8080, "2001:DB8::0:1", "", "", 5)
+			route.Normalize()
+			Expect(route.IP).To(Equal("2001:db8::1"))
+
+			mapping := models.NewTcpRouteMapping("a-guid", 1234, "::ffff:1.2.3.4", 5678, 0, "", nil, nil, 5, models.ModificationTag{}, false, "")
+			mapping.Normalize()
+			Expect(mapping.HostIP).To(Equal("1.2.3.4"))
+		})
+	})
+})
@@ -0,0 +1,155 @@
+package models
+
+import (
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net/netip"
+	"net/url"
+	"time"
+
+	uuid "github.com/nu7hatch/gouuid"
+)
+
+// BackendTlsProfile is the client identity that routers present to backends
+// of tcp route mappings with backend mTLS, and how they verify the backends.
+// The client private key is only stored encrypted.
+type BackendTlsProfile struct {
+	Guid      string    `gorm:"primary_key" json:"guid"`
+	CreatedAt time.Time `json:"-"`
+	UpdatedAt time.Time `json:"-"`
+	BackendTlsProfileEntity
+}
+
+type BackendTlsProfileEntity struct {
+	Name                      string `gorm:"not null" json:"name"`
+	ClientCertChain           string `gorm:"not null" json:"client_cert_chain"`
+	ClientPrivateKey          string `gorm:"-" json:"client_private_key,omitempty"`
+	EncryptedClientPrivateKey string `gorm:"not null" json:"-"`
+	TrustedCA                 string `gorm:"not null" json:"trusted_ca"`
+	// ExpectedSAN is a DNS name, ip or uri that backend certificates must
+	// have as a subject alternative name. When it is empty, routers only
+	// verify that backend certificates are issued by the trusted CA.
+	ExpectedSAN      string    `json:"expected_san,omitempty"`
+	IsolationSegment string    `json:"isolation_segment,omitempty"`
+	NotAfter         time.Time `json:"not_after"`
+	ModificationTag  `json:"modification_tag"`
+}
+
+func (BackendTlsProfile) TableName() string {
+	return "backend_tls_profiles"
+}
+
+func NewBackendTlsProfile(name, clientCertChain, clientPrivateKey, trustedCA, expectedSAN string) BackendTlsProfile {
+	return BackendTlsProfile{
+		BackendTlsProfileEntity: BackendTlsProfileEntity{
+			Name:             name,
+			ClientCertChain:  clientCertChain,
+			ClientPrivateKey: clientPrivateKey,
+			TrustedCA:        trustedCA,
+			ExpectedSAN:      expectedSAN,
+		},
+	}
+}
+
+func NewBackendTlsProfileWithModel(profile BackendTlsProfile) (BackendTlsProfile, error) {
+	guid, err := uuid.NewV4()
+	if err != nil {
+		return BackendTlsProfile{}, err
+	}
+
+	tag, err := NewModificationTag()
+	if err != nil {
+		return BackendTlsProfile{}, err
+	}
+
+	newProfile := BackendTlsProfile{
+		Guid:                    guid.String(),
+		BackendTlsProfileEntity: profile.BackendTlsProfileEntity,
+	}
+	newProfile.ModificationTag = tag
+	return newProfile, nil
+}
+
+// ClientLeaf returns the first certificate of the client certificate chain
+// after checking that it belongs to the client private key.
+func (p BackendTlsProfile) ClientLeaf() (*x509.Certificate, error) {
+	leaf, err := parseKeyPair(p.ClientCertChain, p.ClientPrivateKey)
+	if err != nil {
+		return nil, fmt.Errorf("client_cert_chain and client_private_key are not a valid key pair: %s", err)
+	}
+	return leaf, nil
+}
+
+// Validate returns an error when routers cannot use the profile for backend
+// mTLS: the name is invalid, the client certificate does not belong to the
+// private key, cannot be used for client authentication or has expired, or
+// the trusted CA or the expected SAN are invalid.
+func (p BackendTlsProfile) Validate(now time.Time) error {
+	if p.Name == "" {
+		return errors.New("each backend tls profile requires a non empty name")
+	}
+	if len(p.Name) > maxLabelNameLength || !labelNameRegexp.MatchString(p.Name) {
+		return fmt.Errorf("name '%s' must be at most %d letters, digits, '-', '_' or '.', starting and ending with a letter or digit", p.Name, maxLabelNameLength)
+	}
+	if p.ClientCertChain == "" || p.ClientPrivateKey == "" {
+		return errors.New("each backend tls profile requires a client_cert_chain and a client_private_key")
+	}
+
+	leaf, err := p.ClientLeaf()
+	if err != nil {
+		return err
+	}
+	if !allowsClientAuth(leaf) {
+		return errors.New("client_cert_chain is not valid for client authentication")
+	}
+	if now.After(leaf.NotAfter) {
+		return fmt.Errorf("client_cert_chain expired at %s", leaf.NotAfter.UTC().Format(time.RFC3339))
+	}
+
+	if p.TrustedCA == "" {
+		return errors.New("each backend tls profile requires a trusted_ca")
+	}
+	if !x509.NewCertPool().AppendCertsFromPEM([]byte(p.TrustedCA)) {
+		return errors.New("trusted_ca does not contain a PEM encoded certificate")
+	}
+
+	if p.ExpectedSAN != "" && !validSAN(p.ExpectedSAN) {
+		return fmt.Errorf("expected_san '%s' is neither a DNS name, an ip nor a uri", p.ExpectedSAN)
+	}
+	return nil
+}
+
+// AllowsIsolationSegment reports whether mappings of the isolation segment may
+// use the profile. Profiles without an isolation segment may be used by all
+// mappings.
+func (p BackendTlsProfile) AllowsIsolationSegment(isolationSegment string) bool {
+	return p.IsolationSegment == "" || p.IsolationSegment == isolationSegment
+}
+
+func (p BackendTlsProfile) String() string {
+	return fmt.Sprintf("%s name=%s", p.Guid, p.Name)
+}
+
+func allowsClientAuth(leaf *x509.Certificate) bool {
+	if len(leaf.ExtKeyUsage) == 0 {
+		return true
+	}
+	for _, usage := range leaf.ExtKeyUsage {
+		if usage == x509.ExtKeyUsageClientAuth || usage == x509.ExtKeyUsageAny {
+			return true
+		}
+	}
+	return false
+}
+
+func validSAN(san string) bool {
+	if _, err := netip.ParseAddr(san); err == nil {
+		return true
+	}
+	if u, err := url.Parse(san); err == nil && u.Scheme != "" && u.Host != "" {
+		return true
+	}
+	_, err := NormalizeHostname(san)
+	return err == nil
+}
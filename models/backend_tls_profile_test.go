@@ -0,0 +1,142 @@
+package models_test
+
+import (
+	"time"
+
+	"code.cloudfoundry.org/routing-api/models"
+	"code.cloudfoundry.org/routing-api/test_helpers"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("BackendTlsProfile", func() {
+	var (
+		clientCertChain, clientPrivateKey, trustedCA string
+		notAfter                                     time.Time
+	)
+
+	BeforeEach(func() {
+		var err error
+		notAfter = time.Now().Add(24 * time.Hour).Truncate(time.Second)
+		clientCertChain, clientPrivateKey, err = test_helpers.CreateClientCertificate(notAfter)
+		Expect(err).NotTo(HaveOccurred())
+		trustedCA, _, err = test_helpers.CreateHostnameCertificate([]string{"backend.internal"}, notAfter)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	Describe("NewBackendTlsProfileWithModel", func() {
+		It("sets a guid and a modification tag", func() {
+			profile, err := models.NewBackendTlsProfileWithModel(models.NewBackendTlsProfile("iso-seg-1", clientCertChain, clientPrivateKey, trustedCA, ""))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(profile.Guid).NotTo(BeEmpty())
+			Expect(profile.ModificationTag.Guid).NotTo(BeEmpty())
+			Expect(profile.Name).To(Equal("iso-seg-1"))
+			Expect(profile.ClientPrivateKey).To(Equal(clientPrivateKey))
+		})
+	})
+
+	Describe("ClientLeaf", func() {
+		It("returns the first certificate of the client certificate chain", func() {
+			leaf, err := models.NewBackendTlsProfile("iso-seg-1", clientCertChain, clientPrivateKey, trustedCA, "").ClientLeaf()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(leaf.NotAfter).To(BeTemporally("==", notAfter))
+		})
+	})
+
+	Describe("Normalize", func() {
+		It("normalizes an expected SAN that is a DNS name", func() {
+			profile := models.NewBackendTlsProfile("iso-seg-1", clientCertChain, clientPrivateKey, trustedCA, "Backend.Internal.")
+			profile.Normalize()
+			Expect(profile.ExpectedSAN).To(Equal("backend.internal"))
+		})
+
+		It("leaves an expected SAN that is a uri as it is", func() {
+			profile := models.NewBackendTlsProfile("iso-seg-1", clientCertChain, clientPrivateKey, trustedCA, "spiffe://Example.org/backend")
+			profile.Normalize()
+			Expect(profile.ExpectedSAN).To(Equal("spiffe://Example.org/backend"))
+		})
+	})
+
+	Describe("Validate", func() {
+		It("accepts a profile without an expected SAN", func() {
+			Expect(models.NewBackendTlsProfile("iso-seg-1", clientCertChain, clientPrivateKey, trustedCA, "").Validate(time.Now())).To(Succeed())
+		})
+
+		DescribeTable("accepts an expected SAN",
+			func(expectedSAN string) {
+				Expect(models.NewBackendTlsProfile("iso-seg-1", clientCertChain, clientPrivateKey, trustedCA, expectedSAN).Validate(time.Now())).To(Succeed())
+			},
+			Entry("that is a DNS name", "backend.internal"),
+			Entry("that is an ip", "10.0.0.1"),
+			Entry("that is a uri", "spiffe://example.org/backend"),
+		)
+
+		It("rejects an invalid expected SAN", func() {
+			err := models.NewBackendTlsProfile("iso-seg-1", clientCertChain, clientPrivateKey, trustedCA, "backend..internal").Validate(time.Now())
+			Expect(err).To(MatchError("expected_san 'backend..internal' is neither a DNS name, an ip nor a uri"))
+		})
+
+		It("requires a name", func() {
+			err := models.NewBackendTlsProfile("", clientCertChain, clientPrivateKey, trustedCA, "").Validate(time.Now())
+			Expect(err).To(MatchError("each backend tls profile requires a non empty name"))
+		})
+
+		It("rejects an invalid name", func() {
+			err := models.NewBackendTlsProfile("iso seg", clientCertChain, clientPrivateKey, trustedCA, "").Validate(time.Now())
+			Expect(err).To(MatchError(HavePrefix("name 'iso seg' must be at most 63 letters")))
+		})
+
+		It("requires a client cert chain and a client private key", func() {
+			err := models.NewBackendTlsProfile("iso-seg-1", clientCertChain, "", trustedCA, "").Validate(time.Now())
+			Expect(err).To(MatchError("each backend tls profile requires a client_cert_chain and a client_private_key"))
+		})
+
+		It("rejects a client private key of another certificate", func() {
+			_, otherKey, err := test_helpers.CreateClientCertificate(notAfter)
+			Expect(err).NotTo(HaveOccurred())
+
+			err = models.NewBackendTlsProfile("iso-seg-1", clientCertChain, otherKey, trustedCA, "").Validate(time.Now())
+			Expect(err).To(MatchError(HavePrefix("client_cert_chain and client_private_key are not a valid key pair: ")))
+		})
+
+		It("rejects a certificate that is not valid for client authentication", func() {
+			serverCertChain, serverKey, err := test_helpers.CreateHostnameCertificate([]string{"app.example.com"}, notAfter)
+			Expect(err).NotTo(HaveOccurred())
+
+			err = models.NewBackendTlsProfile("iso-seg-1", serverCertChain, serverKey, trustedCA, "").Validate(time.Now())
+			Expect(err).To(MatchError("client_cert_chain is not valid for client authentication"))
+		})
+
+		It("rejects an expired client certificate", func() {
+			err := models.NewBackendTlsProfile("iso-seg-1", clientCertChain, clientPrivateKey, trustedCA, "").Validate(notAfter.Add(time.Second))
+			Expect(err).To(MatchError(HavePrefix("client_cert_chain expired at ")))
+		})
+
+		It("requires a trusted CA", func() {
+			err := models.NewBackendTlsProfile("iso-seg-1", clientCertChain, clientPrivateKey, "", "").Validate(time.Now())
+			Expect(err).To(MatchError("each backend tls profile requires a trusted_ca"))
+		})
+
+		It("rejects a trusted CA without certificates", func() {
+			err := models.NewBackendTlsProfile("iso-seg-1", clientCertChain, clientPrivateKey, "not a certificate", "").Validate(time.Now())
+			Expect(err).To(MatchError("trusted_ca does not contain a PEM encoded certificate"))
+		})
+	})
+
+	Describe("AllowsIsolationSegment", func() {
+		It("allows all isolation segments when the profile has none", func() {
+			profile := models.NewBackendTlsProfile("iso-seg-1", clientCertChain, clientPrivateKey, trustedCA, "")
+			Expect(profile.AllowsIsolationSegment("")).To(BeTrue())
+			Expect(profile.AllowsIsolationSegment("iso-seg-1")).To(BeTrue())
+		})
+
+		It("allows only the isolation segment of the profile", func() {
+			profile := models.NewBackendTlsProfile("iso-seg-1", clientCertChain, clientPrivateKey, trustedCA, "")
+			profile.IsolationSegment = "iso-seg-1"
+			Expect(profile.AllowsIsolationSegment("iso-seg-1")).To(BeTrue())
+			Expect(profile.AllowsIsolationSegment("iso-seg-2")).To(BeFalse())
+			Expect(profile.AllowsIsolationSegment("")).To(BeFalse())
+		})
+	})
+})
@@ -0,0 +1,33 @@
+package models
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ConnectionLimitPolicy bounds the connection limits and timeouts that tcp
+// route mappings ask routers to enforce. Timeouts are in seconds. A bound of 0
+// leaves the setting unbounded.
+type ConnectionLimitPolicy struct {
+	MaxConnections    int
+	MaxConnectTimeout int
+	MaxIdleTimeout    int
+}
+
+// Validate returns an error when a connection limit or timeout of the mapping
+// is negative or exceeds its bound.
+func (p ConnectionLimitPolicy) Validate(m TcpRouteMapping) error {
+	if m.MaxConnections < 0 || m.ConnectTimeout < 0 || m.IdleTimeout < 0 {
+		return errors.New("max_connections, connect_timeout and idle_timeout must not be negative")
+	}
+	if p.MaxConnections > 0 && m.MaxConnections > p.MaxConnections {
+		return fmt.Errorf("max_connections %d is greater than the maximum of %d", m.MaxConnections, p.MaxConnections)
+	}
+	if p.MaxConnectTimeout > 0 && m.ConnectTimeout > p.MaxConnectTimeout {
+		return fmt.Errorf("connect_timeout %d is greater than the maximum of %d seconds", m.ConnectTimeout, p.MaxConnectTimeout)
+	}
+	if p.MaxIdleTimeout > 0 && m.IdleTimeout > p.MaxIdleTimeout {
+		return fmt.Errorf("idle_timeout %d is greater than the maximum of %d seconds", m.IdleTimeout, p.MaxIdleTimeout)
+	}
+	return nil
+}
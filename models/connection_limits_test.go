@@ -0,0 +1,59 @@
+package models_test
+
+import (
+	"code.cloudfoundry.org/routing-api/models"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ConnectionLimitPolicy", func() {
+	var (
+		policy          models.ConnectionLimitPolicy
+		tcpRouteMapping models.TcpRouteMapping
+	)
+
+	BeforeEach(func() {
+		policy = models.ConnectionLimitPolicy{MaxConnections: 100, MaxConnectTimeout: 10, MaxIdleTimeout: 600}
+		tcpRouteMapping = models.NewTcpRouteMapping("a-guid", 1234, "1.2.3.4", 5678, 0, "", nil, nil, 5, models.ModificationTag{}, false, "")
+		tcpRouteMapping.MaxConnections = 100
+		tcpRouteMapping.ConnectTimeout = 10
+		tcpRouteMapping.IdleTimeout = 600
+	})
+
+	It("accepts limits up to the bounds", func() {
+		Expect(policy.Validate(tcpRouteMapping)).To(Succeed())
+	})
+
+	It("accepts mappings without limits", func() {
+		tcpRouteMapping = models.NewTcpRouteMapping("a-guid", 1234, "1.2.3.4", 5678, 0, "", nil, nil, 5, models.ModificationTag{}, false, "")
+		Expect(policy.Validate(tcpRouteMapping)).To(Succeed())
+	})
+
+	It("rejects negative limits", func() {
+		tcpRouteMapping.IdleTimeout = -1
+		Expect(policy.Validate(tcpRouteMapping)).To(MatchError("max_connections, connect_timeout and idle_timeout must not be negative"))
+	})
+
+	It("rejects max_connections above the bound", func() {
+		tcpRouteMapping.MaxConnections = 101
+		Expect(policy.Validate(tcpRouteMapping)).To(MatchError("max_connections 101 is greater than the maximum of 100"))
+	})
+
+	It("rejects a connect_timeout above the bound", func() {
+		tcpRouteMapping.ConnectTimeout = 11
+		Expect(policy.Validate(tcpRouteMapping)).To(MatchError("connect_timeout 11 is greater than the maximum of 10 seconds"))
+	})
+
+	It("rejects an idle_timeout above the bound", func() {
+		tcpRouteMapping.IdleTimeout = 601
+		Expect(policy.Validate(tcpRouteMapping)).To(MatchError("idle_timeout 601 is greater than the maximum of 600 seconds"))
+	})
+
+	It("leaves settings without a bound unbounded", func() {
+		policy = models.ConnectionLimitPolicy{}
+		tcpRouteMapping.MaxConnections = 1000000
+		tcpRouteMapping.IdleTimeout = 86400
+		Expect(policy.Validate(tcpRouteMapping)).To(Succeed())
+	})
+})
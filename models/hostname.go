@@ -0,0 +1,121 @@
+package models
+
+import (
+	"fmt"
+	"strings"
+
+	"golang.org/x/net/idna"
+)
+
+var hostnameProfile = idna.New(idna.MapForLookup(), idna.BidiRule(), idna.Transitional(false))
+
+// NormalizeHostname returns the hostname in the form it is stored and matched
+// in: lower case, without a trailing dot and with internationalized labels
+// converted to punycode, so that "Foo.Example.COM." and "foo.example.com"
+// are the same hostname. It returns an error when the hostname is not a valid
+// DNS name. A leading "*." label makes the hostname a wildcard; "*" is not
+// allowed anywhere else.
+func NormalizeHostname(hostname string) (string, error) {
+	name := strings.TrimSuffix(hostname, ".")
+	wildcard := strings.HasPrefix(name, "*.")
+	if wildcard {
+		name = strings.TrimPrefix(name, "*.")
+	}
+	if strings.Contains(name, "*") {
+		return "", fmt.Errorf("hostname '%s' may only contain a wildcard as its leading label", hostname)
+	}
+
+	ascii, err := hostnameProfile.ToASCII(name)
+	if err != nil {
+		return "", fmt.Errorf("hostname '%s' is not a valid DNS name: %s", hostname, strings.TrimPrefix(err.Error(), "idna: "))
+	}
+	if wildcard {
+		ascii = "*." + ascii
+	}
+
+	if len(ascii) > 253 {
+		return "", fmt.Errorf("hostname '%s' must be at most 253 characters", hostname)
+	}
+	for _, label := range strings.Split(ascii, ".") {
+		if label == "" {
+			return "", fmt.Errorf("hostname '%s' must not contain empty labels", hostname)
+		}
+		if len(label) > 63 {
+			return "", fmt.Errorf("hostname '%s' has a label of more than 63 characters", hostname)
+		}
+	}
+	return ascii, nil
+}
+
+// NormalizeRouteURL normalizes the hostname of an http route, leaving an
+// optional scheme, port and path as they are.
+func NormalizeRouteURL(route string) (string, error) {
+	scheme := ""
+	if i := strings.Index(route, "://"); i >= 0 {
+		scheme, route = route[:i+len("://")], route[i+len("://"):]
+	}
+	hostPort, path, hasPath := strings.Cut(route, "/")
+	hostname, port, hasPort := strings.Cut(hostPort, ":")
+
+	hostname, err := NormalizeHostname(hostname)
+	if err != nil {
+		return "", err
+	}
+
+	normalized := scheme + hostname
+	if hasPort {
+		normalized += ":" + port
+	}
+	if hasPath {
+		normalized += "/" + path
+	}
+	return normalized, nil
+}
+
+// Normalize normalizes the hostname and backend ip of the route. Invalid
+// values are left as they are for the validator to reject.
+func (r *RouteEntity) Normalize() {
+	if route, err := NormalizeRouteURL(r.Route); err == nil {
+		r.Route = route
+	}
+	if ip, err := CanonicalIP(r.IP); err == nil {
+		r.IP = ip
+	}
+}
+
+// Normalize normalizes the SNI hostname of the certificate. An invalid hostname
+// is left as it is to be rejected by Validate.
+func (c *TlsCertificateEntity) Normalize() {
+	if hostname, err := NormalizeHostname(c.SniHostname); err == nil {
+		c.SniHostname = hostname
+	}
+}
+
+// Normalize normalizes the expected SAN of the profile when it is a DNS name.
+// URIs are left as they are.
+func (p *BackendTlsProfileEntity) Normalize() {
+	if strings.Contains(p.ExpectedSAN, "://") {
+		return
+	}
+	if hostname, err := NormalizeHostname(p.ExpectedSAN); err == nil {
+		p.ExpectedSAN = hostname
+	}
+}
+
+// Normalize normalizes the SNI hostnames and backend ip of the mapping.
+// Invalid values are left as they are for the validator to reject.
+func (m *TcpMappingEntity) Normalize() {
+	if ip, err := CanonicalIP(m.HostIP); err == nil {
+		m.HostIP = ip
+	}
+	if m.SniHostname != nil {
+		if hostname, err := NormalizeHostname(*m.SniHostname); err == nil {
+			m.SniHostname = &hostname
+		}
+	}
+	if m.SniRewriteHostname != nil {
+		if hostname, err := NormalizeHostname(*m.SniRewriteHostname); err == nil {
+			m.SniRewriteHostname = &hostname
+		}
+	}
+}
@@ -0,0 +1,86 @@
+package models_test
+
+import (
+	"strings"
+
+	"code.cloudfoundry.org/routing-api/models"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Hostnames", func() {
+	Describe("NormalizeHostname", func() {
+		DescribeTable("normalizes valid hostnames",
+			func(hostname, expected string) {
+				normalized, err := models.NormalizeHostname(hostname)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(normalized).To(Equal(expected))
+			},
+			Entry("lower case", "foo.example.com", "foo.example.com"),
+			Entry("mixed case", "Foo.Example.COM", "foo.example.com"),
+			Entry("trailing dot", "foo.example.com.", "foo.example.com"),
+			Entry("internationalized labels", "Bücher.example", "xn--bcher-kva.example"),
+			Entry("punycode labels", "xn--bcher-kva.example", "xn--bcher-kva.example"),
+			Entry("a leading wildcard", "*.Example.com", "*.example.com"),
+			Entry("an ip address", "127.0.0.1", "127.0.0.1"),
+		)
+
+		DescribeTable("rejects invalid hostnames",
+			func(hostname, message string) {
+				_, err := models.NormalizeHostname(hostname)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring(message))
+			},
+			Entry("an empty hostname", "", "must not contain empty labels"),
+			Entry("an empty label", "foo..example.com", "must not contain empty labels"),
+			Entry("a wildcard that is not the leading label", "foo.*.example.com", "may only contain a wildcard as its leading label"),
+			Entry("a partial wildcard", "f*.example.com", "may only contain a wildcard as its leading label"),
+			Entry("an underscore", "foo_bar.example.com", "is not a valid DNS name"),
+			Entry("a leading hyphen", "-foo.example.com", "is not a valid DNS name"),
+			Entry("a label of 64 characters", strings.Repeat("a", 64)+".example.com", "has a label of more than 63 characters"),
+			Entry("more than 253 characters", strings.Repeat(strings.Repeat("a", 60)+".", 4)+"example.com", "must be at most 253 characters"),
+		)
+	})
+
+	Describe("NormalizeRouteURL", func() {
+		It("normalizes the hostname and keeps the path", func() {
+			normalized, err := models.NormalizeRouteURL("Foo.Example.COM./Some/Path")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(normalized).To(Equal("foo.example.com/Some/Path"))
+		})
+
+		It("keeps a scheme and a port", func() {
+			normalized, err := models.NormalizeRouteURL("http://Foo.Example.com:8080/path")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(normalized).To(Equal("http://foo.example.com:8080/path"))
+		})
+
+		It("rejects invalid hostnames", func() {
+			_, err := models.NormalizeRouteURL("foo_bar.example.com/path")
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Describe("Normalize", func() {
+		It("normalizes the SNI hostnames of tcp route mappings", func() {
+			sniHostname, sniRewriteHostname := "Foo.Example.com.", "Backend.Example.com"
+			mapping := models.NewTcpRouteMapping("a-guid", 1234, "1.2.3.4", 5678, 0, "", &sniHostname, &sniRewriteHostname, 5, models.ModificationTag{}, false, "")
+			mapping.Normalize()
+			Expect(*mapping.SniHostname).To(Equal("foo.example.com"))
+			Expect(*mapping.SniRewriteHostname).To(Equal("backend.example.com"))
+		})
+
+		It("normalizes the SNI hostname of tls certificates", func() {
+			certificate := models.NewTlsCertificate("*.Apps.Example.com.", "", "")
+			certificate.Normalize()
+			Expect(certificate.SniHostname).To(Equal("*.apps.example.com"))
+		})
+
+		It("leaves invalid hostnames for the validator", func() {
+			route := models.NewRoute("foo_bar.Example.com", 8080, "1.2.3.4", "", "", 5)
+			route.Normalize()
+			Expect(route.Route).To(Equal("foo_bar.Example.com"))
+		})
+	})
+})
@@ -0,0 +1,168 @@
+package models
+
+import (
+	"fmt"
+	"strings"
+)
+
+type LabelOperator string
+
+const (
+	LabelOperatorEquals       LabelOperator = "="
+	LabelOperatorNotEquals    LabelOperator = "!="
+	LabelOperatorIn           LabelOperator = "in"
+	LabelOperatorNotIn        LabelOperator = "notin"
+	LabelOperatorExists       LabelOperator = "exists"
+	LabelOperatorDoesNotExist LabelOperator = "!"
+)
+
+type LabelRequirement struct {
+	Key      string
+	Operator LabelOperator
+	Values   []string
+}
+
+// LabelSelector is a parsed Kubernetes-style label selector, for example
+// "app_guid=abc,env!=prod,tier in (web,worker),!deprecated". All requirements
+// must match.
+type LabelSelector []LabelRequirement
+
+func ParseLabelSelector(selector string) (LabelSelector, error) {
+	var labelSelector LabelSelector
+
+	for _, term := range splitLabelSelector(selector) {
+		term = strings.TrimSpace(term)
+		if term == "" {
+			return nil, fmt.Errorf("invalid label selector %q: empty requirement", selector)
+		}
+
+		requirement, err := parseLabelRequirement(term)
+		if err != nil {
+			return nil, fmt.Errorf("invalid label selector %q: %s", selector, err)
+		}
+		labelSelector = append(labelSelector, requirement)
+	}
+
+	return labelSelector, nil
+}
+
+func (s LabelSelector) Matches(labels LabelSet) bool {
+	labelMap := labels.Map()
+	for _, r := range s {
+		if !r.matches(labelMap) {
+			return false
+		}
+	}
+	return true
+}
+
+func (r LabelRequirement) matches(labels map[string]string) bool {
+	value, ok := labels[r.Key]
+
+	switch r.Operator {
+	case LabelOperatorExists:
+		return ok
+	case LabelOperatorDoesNotExist:
+		return !ok
+	case LabelOperatorEquals, LabelOperatorIn:
+		return ok && containsString(r.Values, value)
+	case LabelOperatorNotEquals, LabelOperatorNotIn:
+		return !ok || !containsString(r.Values, value)
+	default:
+		return false
+	}
+}
+
+// splitLabelSelector splits on commas that are not inside a set of values,
+// e.g. "a in (x,y),b=c" becomes ["a in (x,y)", "b=c"].
+func splitLabelSelector(selector string) []string {
+	if strings.TrimSpace(selector) == "" {
+		return nil
+	}
+
+	var terms []string
+	depth := 0
+	start := 0
+	for i, c := range selector {
+		switch c {
+		case '(':
+			depth++
+		case ')':
+			depth--
+		case ',':
+			if depth == 0 {
+				terms = append(terms, selector[start:i])
+				start = i + 1
+			}
+		}
+	}
+	return append(terms, selector[start:])
+}
+
+func parseLabelRequirement(term string) (LabelRequirement, error) {
+	if strings.HasPrefix(term, "!") {
+		key := strings.TrimSpace(term[1:])
+		return newLabelRequirement(key, LabelOperatorDoesNotExist, nil)
+	}
+
+	if i := strings.Index(term, "!="); i >= 0 {
+		return newLabelRequirement(strings.TrimSpace(term[:i]), LabelOperatorNotEquals, []string{strings.TrimSpace(term[i+2:])})
+	}
+
+	if i := strings.Index(term, "=="); i >= 0 {
+		return newLabelRequirement(strings.TrimSpace(term[:i]), LabelOperatorEquals, []string{strings.TrimSpace(term[i+2:])})
+	}
+
+	if i := strings.Index(term, "="); i >= 0 {
+		return newLabelRequirement(strings.TrimSpace(term[:i]), LabelOperatorEquals, []string{strings.TrimSpace(term[i+1:])})
+	}
+
+	fields := strings.Fields(term)
+	if len(fields) == 1 {
+		return newLabelRequirement(fields[0], LabelOperatorExists, nil)
+	}
+
+	if len(fields) < 3 {
+		return LabelRequirement{}, fmt.Errorf("unable to parse requirement %q", term)
+	}
+
+	key := fields[0]
+	operator := LabelOperator(fields[1])
+	if operator != LabelOperatorIn && operator != LabelOperatorNotIn {
+		return LabelRequirement{}, fmt.Errorf("unknown operator %q in requirement %q", fields[1], term)
+	}
+
+	set := strings.TrimSpace(strings.Join(fields[2:], " "))
+	if !strings.HasPrefix(set, "(") || !strings.HasSuffix(set, ")") {
+		return LabelRequirement{}, fmt.Errorf("values for %q must be enclosed in parentheses", term)
+	}
+
+	var values []string
+	for _, v := range strings.Split(set[1:len(set)-1], ",") {
+		values = append(values, strings.TrimSpace(v))
+	}
+	return newLabelRequirement(key, operator, values)
+}
+
+func newLabelRequirement(key string, operator LabelOperator, values []string) (LabelRequirement, error) {
+	if err := validateLabelKey(key); err != nil {
+		return LabelRequirement{}, err
+	}
+
+	for _, v := range values {
+		if err := validateLabelValue(v); err != nil {
+			return LabelRequirement{}, fmt.Errorf("invalid value %q for label %s: %s", v, key, err)
+		}
+	}
+
+	return LabelRequirement{Key: key, Operator: operator, Values: values}, nil
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
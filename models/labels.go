@@ -0,0 +1,131 @@
+package models
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+const (
+	maxLabelNameLength   = 63
+	maxLabelPrefixLength = 253
+)
+
+var labelNameRegexp = regexp.MustCompile(`^[A-Za-z0-9]([-A-Za-z0-9_.]*[A-Za-z0-9])?$`)
+var labelPrefixRegexp = regexp.MustCompile(`^[a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*$`)
+
+// LabelSet holds arbitrary key/value labels attached to routes, tcp route
+// mappings and router groups. It is kept as canonical JSON (keys sorted) so
+// that the models embedding it stay comparable and can be stored in a single
+// column, but it is encoded as a JSON object on the wire.
+//
+// An empty LabelSet means no labels were given, while "{}" means the labels were
+// explicitly cleared.
+type LabelSet string
+
+func NewLabelSet(labels map[string]string) (LabelSet, error) {
+	if labels == nil {
+		return "", nil
+	}
+
+	data, err := json.Marshal(labels)
+	if err != nil {
+		return "", err
+	}
+	return LabelSet(data), nil
+}
+
+func (l LabelSet) Map() map[string]string {
+	labels := map[string]string{}
+	if l == "" {
+		return labels
+	}
+
+	// A LabelSet is only ever built from a map, so this cannot fail.
+	_ = json.Unmarshal([]byte(l), &labels)
+	return labels
+}
+
+func (l LabelSet) Get(key string) (string, bool) {
+	value, ok := l.Map()[key]
+	return value, ok
+}
+
+func (l LabelSet) MarshalJSON() ([]byte, error) {
+	if l == "" {
+		return []byte("null"), nil
+	}
+	return []byte(l), nil
+}
+
+func (l *LabelSet) UnmarshalJSON(data []byte) error {
+	var labels map[string]string
+	err := json.Unmarshal(data, &labels)
+	if err != nil {
+		return errors.New("labels must be an object of string keys and string values")
+	}
+
+	*l, err = NewLabelSet(labels)
+	return err
+}
+
+func (l *LabelSet) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var labels map[string]string
+	err := unmarshal(&labels)
+	if err != nil {
+		return errors.New("labels must be a map of string keys and string values")
+	}
+
+	*l, err = NewLabelSet(labels)
+	return err
+}
+
+func (l LabelSet) Validate() error {
+	labels := l.Map()
+
+	keys := make([]string, 0, len(labels))
+	for key := range labels {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		if err := validateLabelKey(key); err != nil {
+			return err
+		}
+		if err := validateLabelValue(labels[key]); err != nil {
+			return fmt.Errorf("invalid value for label %s: %s", key, err)
+		}
+	}
+	return nil
+}
+
+func validateLabelKey(key string) error {
+	name := key
+	if i := strings.LastIndex(key, "/"); i >= 0 {
+		prefix := key[:i]
+		name = key[i+1:]
+		if prefix == "" || len(prefix) > maxLabelPrefixLength || !labelPrefixRegexp.MatchString(prefix) {
+			return fmt.Errorf("invalid label key %s: prefix must be a DNS subdomain", key)
+		}
+	}
+
+	if name == "" || len(name) > maxLabelNameLength || !labelNameRegexp.MatchString(name) {
+		return fmt.Errorf("invalid label key %s: name must be 63 characters or less, begin and end with an alphanumeric character and contain only alphanumerics, '-', '_' or '.'", key)
+	}
+	return nil
+}
+
+func validateLabelValue(value string) error {
+	if value == "" {
+		return nil
+	}
+
+	if len(value) > maxLabelNameLength || !labelNameRegexp.MatchString(value) {
+		return errors.New("value must be 63 characters or less, begin and end with an alphanumeric character and contain only alphanumerics, '-', '_' or '.'")
+	}
+	return nil
+}
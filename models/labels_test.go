@@ -0,0 +1,124 @@
+package models_test
+
+import (
+	"encoding/json"
+
+	"code.cloudfoundry.org/routing-api/models"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Labels", func() {
+	Describe("JSON encoding", func() {
+		It("encodes labels as an object", func() {
+			labels, err := models.NewLabelSet(map[string]string{"env": "prod", "app_guid": "abc"})
+			Expect(err).NotTo(HaveOccurred())
+
+			route := models.NewRoute("example.com", 8080, "1.2.3.4", "", "", 120)
+			route.Labels = labels
+
+			j, err := json.Marshal(route)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(j)).To(ContainSubstring(`"labels":{"app_guid":"abc","env":"prod"}`))
+		})
+
+		It("is omitted when there are no labels", func() {
+			route := models.NewRoute("example.com", 8080, "1.2.3.4", "", "", 120)
+
+			j, err := json.Marshal(route)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(j)).NotTo(ContainSubstring("labels"))
+		})
+
+		It("decodes labels into a canonical form", func() {
+			var mapping models.TcpRouteMapping
+			err := json.Unmarshal([]byte(`{"labels":{"env":"prod","app_guid":"abc"}}`), &mapping)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(mapping.Labels).To(Equal(models.LabelSet(`{"app_guid":"abc","env":"prod"}`)))
+			Expect(mapping.Labels.Map()).To(Equal(map[string]string{"app_guid": "abc", "env": "prod"}))
+		})
+
+		It("distinguishes explicitly cleared labels from missing labels", func() {
+			var mapping models.TcpRouteMapping
+			err := json.Unmarshal([]byte(`{"labels":{}}`), &mapping)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(mapping.Labels).To(Equal(models.LabelSet("{}")))
+
+			err = json.Unmarshal([]byte(`{}`), &mapping)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("returns an error when labels are not strings", func() {
+			var mapping models.TcpRouteMapping
+			err := json.Unmarshal([]byte(`{"labels":{"count":1}}`), &mapping)
+			Expect(err).To(MatchError(ContainSubstring("labels must be an object of string keys and string values")))
+		})
+	})
+
+	Describe("Validate", func() {
+		It("accepts prefixed keys and empty values", func() {
+			labels, err := models.NewLabelSet(map[string]string{"cloudfoundry.org/app_guid": "abc", "canary": ""})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(labels.Validate()).To(Succeed())
+		})
+
+		It("rejects keys with invalid characters", func() {
+			labels, err := models.NewLabelSet(map[string]string{"app guid": "abc"})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(labels.Validate()).To(MatchError(ContainSubstring("invalid label key app guid")))
+		})
+
+		It("rejects values that are too long", func() {
+			long := "a"
+			for len(long) < 64 {
+				long += "a"
+			}
+			labels, err := models.NewLabelSet(map[string]string{"app_guid": long})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(labels.Validate()).To(MatchError(ContainSubstring("invalid value for label app_guid")))
+		})
+	})
+
+	Describe("LabelSelector", func() {
+		var labels models.LabelSet
+
+		BeforeEach(func() {
+			var err error
+			labels, err = models.NewLabelSet(map[string]string{"app_guid": "abc", "env": "staging", "tier": "web"})
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		DescribeTable("matching",
+			func(selector string, expected bool) {
+				s, err := models.ParseLabelSelector(selector)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(s.Matches(labels)).To(Equal(expected))
+			},
+			Entry("empty selector", "", true),
+			Entry("equality", "app_guid=abc", true),
+			Entry("double equals", "app_guid==abc", true),
+			Entry("equality mismatch", "app_guid=def", false),
+			Entry("inequality", "env!=prod", true),
+			Entry("inequality on a missing key", "zone!=a", true),
+			Entry("multiple requirements", "app_guid=abc,env!=prod", true),
+			Entry("one failing requirement", "app_guid=abc,env!=staging", false),
+			Entry("set membership", "tier in (web, worker)", true),
+			Entry("set exclusion", "tier notin (web,worker)", false),
+			Entry("existence", "env", true),
+			Entry("non-existence", "!env", false),
+			Entry("non-existence of a missing key", "!deprecated", true),
+		)
+
+		DescribeTable("parse errors",
+			func(selector string) {
+				_, err := models.ParseLabelSelector(selector)
+				Expect(err).To(HaveOccurred())
+			},
+			Entry("empty requirement", "app_guid=abc,"),
+			Entry("unknown operator", "tier within (web)"),
+			Entry("set without parentheses", "tier in web"),
+			Entry("invalid key", "app guid=abc"),
+		)
+	})
+})
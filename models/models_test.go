@@ -80,7 +80,14 @@ var _ = Describe("Models", func() {
 	})
 
 	Describe("RouterGroup", func() {
-		var rg RouterGroup
+		var (
+			rg     RouterGroup
+			policy PortPolicy
+		)
+
+		BeforeEach(func() {
+			policy = PortPolicy{}
+		})
 
 		Describe("Validate", func() {
 			It("does not allow ReservablePorts for http type", func() {
@@ -89,7 +96,7 @@ var _ = Describe("Models", func() {
 					Type:            "http",
 					ReservablePorts: "1025-2025",
 				}
-				err := rg.Validate()
+				err := rg.Validate(policy)
 				Expect(err).To(HaveOccurred())
 				Expect(err.Error()).To(Equal("reservable ports are not supported for router groups of type http"))
 				By("not having ReservablePorts")
@@ -97,7 +104,7 @@ var _ = Describe("Models", func() {
 					Name: "router-group-1",
 					Type: "http",
 				}
-				err = rg.Validate()
+				err = rg.Validate(policy)
 				Expect(err).ToNot(HaveOccurred())
 			})
 
@@ -107,7 +114,7 @@ var _ = Describe("Models", func() {
 					Type:            "foo",
 					ReservablePorts: "1025-2025",
 				}
-				err := rg.Validate()
+				err := rg.Validate(policy)
 				Expect(err).ToNot(HaveOccurred())
 
 				rg = RouterGroup{
@@ -115,7 +122,7 @@ var _ = Describe("Models", func() {
 					Type:            "foo",
 					ReservablePorts: "",
 				}
-				err = rg.Validate()
+				err = rg.Validate(policy)
 				Expect(err).ToNot(HaveOccurred())
 			})
 
@@ -125,7 +132,7 @@ var _ = Describe("Models", func() {
 					Type:            "tcp",
 					ReservablePorts: "1025-2025",
 				}
-				err := rg.Validate()
+				err := rg.Validate(policy)
 				Expect(err).NotTo(HaveOccurred())
 			})
 
@@ -134,7 +141,7 @@ var _ = Describe("Models", func() {
 					Name:            "router-group-1",
 					ReservablePorts: "10-20",
 				}
-				err := rg.Validate()
+				err := rg.Validate(policy)
 				Expect(err).To(HaveOccurred())
 				Expect(err.Error()).To(Equal("missing type in router group"))
 			})
@@ -143,7 +150,7 @@ var _ = Describe("Models", func() {
 					Type:            "tcp",
 					ReservablePorts: "10-20",
 				}
-				err := rg.Validate()
+				err := rg.Validate(policy)
 				Expect(err).To(HaveOccurred())
 				Expect(err.Error()).To(Equal("missing name in router group"))
 			})
@@ -153,19 +160,78 @@ var _ = Describe("Models", func() {
 					Type: "tcp",
 					Name: "router-group-1",
 				}
-				err := rg.Validate()
+				err := rg.Validate(policy)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(Equal("missing reservable_ports in router group: router-group-1"))
+			})
+
+			It("fails for udp router group with missing ReservablePorts", func() {
+				rg = RouterGroup{
+					Type: "udp",
+					Name: "router-group-1",
+				}
+				err := rg.Validate(policy)
 				Expect(err).To(HaveOccurred())
 				Expect(err.Error()).To(Equal("missing reservable_ports in router group: router-group-1"))
 			})
 
+			It("fails for negative quotas", func() {
+				rg = RouterGroup{Name: "router-group-1", Type: "tcp", ReservablePorts: "1025-2025", MaxTcpRoutes: -1}
+				Expect(rg.Validate(policy)).To(MatchError("max_tcp_routes and max_tcp_routes_per_isolation_segment must not be negative in router group: router-group-1"))
+			})
+
+			It("does not allow quotas for http type", func() {
+				rg = RouterGroup{Name: "router-group-1", Type: "http", MaxTcpRoutes: 10}
+				Expect(rg.Validate(policy)).To(MatchError("tcp route quotas are not supported for router groups of type http"))
+			})
+
+			It("does not allow quotas for udp type", func() {
+				rg = RouterGroup{Name: "router-group-1", Type: "udp", ReservablePorts: "1025-2025", MaxTcpRoutes: 10}
+				Expect(rg.Validate(policy)).To(MatchError("tcp route quotas are not supported for router groups of type udp"))
+			})
+
+			Context("when the router group has a ttl policy", func() {
+				BeforeEach(func() {
+					rg = RouterGroup{
+						Name:       "router-group-1",
+						Type:       "http",
+						MinTTL:     10,
+						MaxTTL:     300,
+						DefaultTTL: 60,
+					}
+				})
+
+				It("succeeds for a valid policy", func() {
+					Expect(rg.Validate(policy)).To(Succeed())
+				})
+
+				It("fails for negative ttls", func() {
+					rg.MinTTL = -1
+					Expect(rg.Validate(policy)).To(MatchError("min_ttl, max_ttl and default_ttl must not be negative in router group: router-group-1"))
+				})
+
+				It("fails when min_ttl is greater than max_ttl", func() {
+					rg.MinTTL = 400
+					Expect(rg.Validate(policy)).To(MatchError("min_ttl 400 is greater than max_ttl 300 in router group: router-group-1"))
+				})
+
+				It("fails when default_ttl is outside min_ttl and max_ttl", func() {
+					rg.DefaultTTL = 5
+					Expect(rg.Validate(policy)).To(MatchError("default_ttl 5 must be between min_ttl and max_ttl in router group: router-group-1"))
+
+					rg.DefaultTTL = 301
+					Expect(rg.Validate(policy)).To(MatchError("default_ttl 301 must be between min_ttl and max_ttl in router group: router-group-1"))
+				})
+			})
+
 			Context("when there are reserved system component ports", func() {
 				BeforeEach(func() {
-					ReservedSystemComponentPorts = []uint16{5555, 6666, 7777}
+					policy.SystemComponentPorts = []uint16{5555, 6666, 7777}
 				})
 
 				Context("when failOnRouterPortConflicts is true", func() {
 					BeforeEach(func() {
-						FailOnRouterPortConflicts = true
+						policy.FailOnConflicts = true
 					})
 
 					It("succeeds when the ports don't overlap", func() {
@@ -174,7 +240,7 @@ var _ = Describe("Models", func() {
 							Type:            "tcp",
 							ReservablePorts: "1025-2025",
 						}
-						err := rg.Validate()
+						err := rg.Validate(policy)
 						Expect(err).ToNot(HaveOccurred())
 					})
 
@@ -184,7 +250,7 @@ var _ = Describe("Models", func() {
 							Type:            "tcp",
 							ReservablePorts: "5000-6000",
 						}
-						err := rg.Validate()
+						err := rg.Validate(policy)
 						Expect(err).To(HaveOccurred())
 						Expect(err).To(MatchError("Invalid ports. Reservable ports must not include the following reserved system component ports: [5555 6666 7777]."))
 					})
@@ -192,7 +258,7 @@ var _ = Describe("Models", func() {
 					Context("when failOnRouterPortConflicts is false", func() {
 
 						BeforeEach(func() {
-							FailOnRouterPortConflicts = false
+							policy.FailOnConflicts = false
 						})
 
 						It("succeeds when the ports don't overlap", func() {
@@ -201,7 +267,7 @@ var _ = Describe("Models", func() {
 								Type:            "tcp",
 								ReservablePorts: "1025-2025",
 							}
-							err := rg.Validate()
+							err := rg.Validate(policy)
 							Expect(err).ToNot(HaveOccurred())
 						})
 
@@ -211,12 +277,188 @@ var _ = Describe("Models", func() {
 								Type:            "tcp",
 								ReservablePorts: "5000-6000",
 							}
-							err := rg.Validate()
+							err := rg.Validate(policy)
 							Expect(err).ToNot(HaveOccurred())
 						})
 					})
 				})
 			})
+
+			Context("when the router group has excluded ports", func() {
+				BeforeEach(func() {
+					policy.SystemComponentPorts = []uint16{5555}
+					policy.FailOnConflicts = true
+					rg = RouterGroup{
+						Name:            "router-group-1",
+						Type:            "tcp",
+						ReservablePorts: "5000-6000",
+						ExcludedPorts:   "7000-7100",
+					}
+				})
+
+				It("uses them instead of the reserved system component ports", func() {
+					Expect(rg.Validate(policy)).To(Succeed())
+				})
+
+				It("fails when the reservable ports include them", func() {
+					rg.ExcludedPorts = "5900-6100"
+					Expect(rg.Validate(policy)).To(MatchError("Invalid ports. Reservable ports must not include the following excluded ports: 5900-6100."))
+				})
+
+				It("fails for invalid excluded ports", func() {
+					rg.ExcludedPorts = "foo!"
+					Expect(rg.Validate(policy)).To(MatchError(HavePrefix("invalid excluded_ports in router group router-group-1:")))
+				})
+
+				It("does not allow them for http type", func() {
+					rg = RouterGroup{Name: "router-group-1", Type: "http", ExcludedPorts: "7000"}
+					Expect(rg.Validate(policy)).To(MatchError("excluded ports are not supported for router groups of type http"))
+				})
+			})
+
+			Context("when the router group has backend networks", func() {
+				BeforeEach(func() {
+					rg = RouterGroup{
+						Name:                "router-group-1",
+						Type:                "http",
+						AllowedBackendCIDRs: "10.0.0.0/8,fd00::/8",
+						DeniedBackendCIDRs:  "10.255.0.0/16",
+					}
+				})
+
+				It("succeeds for valid networks", func() {
+					Expect(rg.Validate(policy)).To(Succeed())
+				})
+
+				It("fails for invalid allowed networks", func() {
+					rg.AllowedBackendCIDRs = "10.0.0.0/33"
+					Expect(rg.Validate(policy)).To(MatchError("invalid allowed_backend_cidrs in router group router-group-1: invalid cidr '10.0.0.0/33'"))
+				})
+
+				It("fails for invalid denied networks", func() {
+					rg.DeniedBackendCIDRs = "10.255.0.1"
+					Expect(rg.Validate(policy)).To(MatchError("invalid denied_backend_cidrs in router group router-group-1: invalid cidr '10.255.0.1'"))
+				})
+			})
+		})
+
+		Describe("StrandedTcpRouteMappings", func() {
+			It("returns the mappings of the router group outside its reservable ports", func() {
+				rg = RouterGroup{
+					Guid:            "rg-guid",
+					Name:            "router-group-1",
+					Type:            "tcp",
+					ReservablePorts: "5000-6000",
+				}
+				inside := NewTcpRouteMapping("rg-guid", 5000, "10.0.0.1", 8080, 0, "", nil, nil, 60, ModificationTag{}, false, "")
+				outside := NewTcpRouteMapping("rg-guid", 6001, "10.0.0.2", 8080, 0, "", nil, nil, 60, ModificationTag{}, false, "")
+				otherGroup := NewTcpRouteMapping("other-guid", 7000, "10.0.0.3", 8080, 0, "", nil, nil, 60, ModificationTag{}, false, "")
+
+				stranded, err := rg.StrandedTcpRouteMappings([]TcpRouteMapping{inside, outside, otherGroup})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(stranded).To(Equal([]TcpRouteMapping{outside}))
+			})
+
+			It("returns port range mappings that are not entirely within its reservable ports", func() {
+				rg = RouterGroup{
+					Guid:            "rg-guid",
+					Name:            "router-group-1",
+					Type:            "tcp",
+					ReservablePorts: "5000-6000",
+				}
+				inside := NewTcpRouteMapping("rg-guid", 5000, "10.0.0.1", 8080, 0, "", nil, nil, 60, ModificationTag{}, false, "")
+				inside.ExternalPortEnd = 5099
+				partiallyOutside := NewTcpRouteMapping("rg-guid", 5950, "10.0.0.2", 8080, 0, "", nil, nil, 60, ModificationTag{}, false, "")
+				partiallyOutside.ExternalPortEnd = 6049
+
+				stranded, err := rg.StrandedTcpRouteMappings([]TcpRouteMapping{inside, partiallyOutside})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(stranded).To(Equal([]TcpRouteMapping{partiallyOutside}))
+			})
+		})
+
+		Describe("TTLPolicy", func() {
+			It("falls back to the global max ttl", func() {
+				rg = RouterGroup{Name: "router-group-1", Type: "http"}
+				Expect(rg.TTLPolicy(120)).To(Equal(TTLPolicy{Min: 1, Max: 120, Default: 120}))
+			})
+
+			It("uses the ttls of the router group", func() {
+				rg = RouterGroup{Name: "router-group-1", Type: "http", MinTTL: 10, MaxTTL: 300, DefaultTTL: 60}
+				Expect(rg.TTLPolicy(120)).To(Equal(TTLPolicy{Min: 10, Max: 300, Default: 60}))
+			})
+
+			It("defaults to the max ttl of the router group", func() {
+				rg = RouterGroup{Name: "router-group-1", Type: "http", MaxTTL: 30}
+				Expect(rg.TTLPolicy(120)).To(Equal(TTLPolicy{Min: 1, Max: 30, Default: 30}))
+			})
+		})
+
+		Describe("ValidateExternalPort", func() {
+			BeforeEach(func() {
+				policy.SystemComponentPorts = []uint16{5555}
+				rg = RouterGroup{
+					Name:            "router-group-1",
+					Type:            "tcp",
+					ReservablePorts: "5000-6000,7000",
+				}
+			})
+
+			It("succeeds when the port is within the reservable ports", func() {
+				Expect(rg.ValidateExternalPort(5001, policy)).To(Succeed())
+				Expect(rg.ValidateExternalPort(7000, policy)).To(Succeed())
+			})
+
+			It("fails when the port is outside the reservable ports", func() {
+				err := rg.ValidateExternalPort(6500, policy)
+				Expect(err).To(MatchError("external port 6500 is not within the reservable ports (5000-6000,7000) of router group router-group-1"))
+			})
+
+			It("fails when the port is a reserved system component port", func() {
+				err := rg.ValidateExternalPort(5555, policy)
+				Expect(err).To(MatchError("external port 5555 is a reserved system component port"))
+			})
+
+			It("fails when the port is excluded by the router group", func() {
+				rg.ExcludedPorts = "5500-5550"
+				err := rg.ValidateExternalPort(5501, policy)
+				Expect(err).To(MatchError("external port 5501 is excluded by router group router-group-1"))
+				Expect(rg.ValidateExternalPort(5001, policy)).To(Succeed())
+			})
+
+			It("fails when the router group has no reservable ports", func() {
+				rg.ReservablePorts = ""
+				err := rg.ValidateExternalPort(5001, policy)
+				Expect(err).To(MatchError("router group router-group-1 has no reservable ports"))
+			})
+		})
+
+		Describe("ValidateExternalPortRange", func() {
+			BeforeEach(func() {
+				policy.SystemComponentPorts = []uint16{5555}
+				rg = RouterGroup{
+					Name:            "router-group-1",
+					Type:            "tcp",
+					ReservablePorts: "5000-5100,5101-6000",
+				}
+			})
+
+			It("succeeds when every port is within the reservable ports", func() {
+				r, _ := NewRange(5050, 5150)
+				Expect(rg.ValidateExternalPortRange(r, policy)).To(Succeed())
+			})
+
+			It("fails for the first port outside the reservable ports", func() {
+				r, _ := NewRange(5950, 6050)
+				err := rg.ValidateExternalPortRange(r, policy)
+				Expect(err).To(MatchError("external port 6001 is not within the reservable ports (5000-5100,5101-6000) of router group router-group-1"))
+			})
+
+			It("fails when the range contains an excluded port", func() {
+				r, _ := NewRange(5500, 5600)
+				err := rg.ValidateExternalPortRange(r, policy)
+				Expect(err).To(MatchError("external port 5555 is a reserved system component port"))
+			})
 		})
 	})
 
@@ -381,6 +623,33 @@ var _ = Describe("Models", func() {
 				Expect(testRange.Overlaps(r)).To(BeTrue())
 			})
 		})
+
+		Describe("Contains", func() {
+			It("includes both endpoints", func() {
+				r, _ := NewRange(6010, 6020)
+				Expect(r.Contains(6010)).To(BeTrue())
+				Expect(r.Contains(6020)).To(BeTrue())
+				Expect(r.Contains(6009)).To(BeFalse())
+				Expect(r.Contains(6021)).To(BeFalse())
+			})
+
+			It("checks every range of reservable ports", func() {
+				ranges, err := ReservablePorts("6000,6010-6020").Parse()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(ranges.Contains(6000)).To(BeTrue())
+				Expect(ranges.Contains(6015)).To(BeTrue())
+				Expect(ranges.Contains(6005)).To(BeFalse())
+			})
+
+			It("contains a range only when it contains every port", func() {
+				ranges, err := ReservablePorts("6000-6009,6010-6020").Parse()
+				Expect(err).ToNot(HaveOccurred())
+				inside, _ := NewRange(6005, 6015)
+				outside, _ := NewRange(6015, 6025)
+				Expect(ranges.ContainsRange(inside)).To(BeTrue())
+				Expect(ranges.ContainsRange(outside)).To(BeFalse())
+			})
+		})
 	})
 
 	Describe("Route", func() {
@@ -429,6 +698,28 @@ var _ = Describe("Models", func() {
 			route = NewTcpRouteMapping("router-group-1", 60000, "2.2.2.2", 64000, 64001, "instance-id", pointertoString("sni-hostname"), pointertoString("sni-rewrite-hostname"), 66, tag, false, "")
 		})
 
+		Describe("SameRoute", func() {
+			It("ignores the fields outside of the unique index", func() {
+				other := NewTcpRouteMapping("router-group-1", 60000, "2.2.2.2", 64000, 64001, "other-instance-id", pointertoString("sni-hostname"), nil, 120, ModificationTag{}, false, "")
+				Expect(route.SameRoute(other)).To(BeTrue())
+			})
+
+			It("compares the sni hostnames by value", func() {
+				other := route
+				other.SniHostname = nil
+				Expect(route.SameRoute(other)).To(BeFalse())
+
+				other.SniHostname = pointertoString("other-sni-hostname")
+				Expect(route.SameRoute(other)).To(BeFalse())
+			})
+
+			It("compares backend mtls", func() {
+				other := route
+				other.EnableBackendMTLS = true
+				Expect(route.SameRoute(other)).To(BeFalse())
+			})
+		})
+
 		Describe("SetDefaults", func() {
 			JustBeforeEach(func() {
 				route.SetDefaults(120)
@@ -0,0 +1,121 @@
+package models
+
+import "sort"
+
+type PortState string
+
+const (
+	PortStateFree           PortState = "free"
+	PortStateMapped         PortState = "mapped"
+	PortStateReserved       PortState = "reserved"
+	PortStateSystemReserved PortState = "system-reserved"
+)
+
+// PortBackend is a backend that a tcp route mapping forwards an external port to.
+type PortBackend struct {
+	HostIP      string  `json:"backend_ip"`
+	HostPort    uint16  `json:"backend_port"`
+	HostTLSPort int     `json:"backend_tls_port,omitempty"`
+	SniHostname *string `json:"backend_sni_hostname,omitempty"`
+	InstanceId  string  `json:"instance_id,omitempty"`
+}
+
+type PortUsage struct {
+	Port     uint16        `json:"port"`
+	State    PortState     `json:"state"`
+	Owner    string        `json:"owner,omitempty"`
+	Backends []PortBackend `json:"backends,omitempty"`
+}
+
+type PortOccupancySummary struct {
+	Total          int `json:"total"`
+	Free           int `json:"free"`
+	Mapped         int `json:"mapped"`
+	Reserved       int `json:"reserved"`
+	SystemReserved int `json:"system_reserved"`
+}
+
+// PortOccupancy describes how the reservable ports of a router group are used.
+type PortOccupancy struct {
+	RouterGroupGuid string               `json:"router_group_guid"`
+	RouterGroupName string               `json:"router_group_name"`
+	Summary         PortOccupancySummary `json:"summary"`
+	Ports           []PortUsage          `json:"ports"`
+}
+
+// NewPortOccupancy reports the state of every reservable port of the router
+// group. A port used by a mapping is reported as mapped even when it is also
+// reserved, so that conflicts show their backends. Each port of a port range
+// mapping is mapped to the backend port of the same offset. Mappings and
+// reservations of other router groups are ignored. Ports excluded by the
+// router group or the policy are reported as system-reserved.
+func NewPortOccupancy(routerGroup RouterGroup, mappings []TcpRouteMapping, reservations []PortReservation, policy PortPolicy) (PortOccupancy, error) {
+	occupancy := PortOccupancy{
+		RouterGroupGuid: routerGroup.Guid,
+		RouterGroupName: routerGroup.Name,
+		Ports:           []PortUsage{},
+	}
+	if routerGroup.ReservablePorts == "" {
+		return occupancy, nil
+	}
+
+	ranges, err := routerGroup.ReservablePorts.Parse()
+	if err != nil {
+		return PortOccupancy{}, err
+	}
+
+	backends := map[uint16][]PortBackend{}
+	for _, mapping := range mappings {
+		if mapping.RouterGroupGuid != routerGroup.Guid {
+			continue
+		}
+		r := mapping.ExternalPortRange()
+		for port := uint32(r.start); port <= uint32(r.end); port++ {
+			backends[uint16(port)] = append(backends[uint16(port)], PortBackend{
+				HostIP:      mapping.HostIP,
+				HostPort:    mapping.HostPortFor(uint16(port)),
+				HostTLSPort: mapping.HostTLSPort,
+				SniHostname: mapping.SniHostname,
+				InstanceId:  mapping.InstanceId,
+			})
+		}
+	}
+
+	owners := map[uint16]string{}
+	for _, reservation := range reservations {
+		if reservation.RouterGroupGuid == routerGroup.Guid {
+			owners[reservation.Port] = reservation.Owner
+		}
+	}
+
+	excluded, err := policy.ExcludedPorts(routerGroup)
+	if err != nil {
+		return PortOccupancy{}, err
+	}
+
+	sort.Slice(ranges, func(i, j int) bool { return ranges[i].start < ranges[j].start })
+	for _, r := range ranges {
+		for port := uint32(r.start); port <= uint32(r.end); port++ {
+			usage := PortUsage{Port: uint16(port)}
+			if portBackends, ok := backends[usage.Port]; ok {
+				usage.State = PortStateMapped
+				usage.Backends = portBackends
+				usage.Owner = owners[usage.Port]
+				occupancy.Summary.Mapped++
+			} else if owner, ok := owners[usage.Port]; ok {
+				usage.State = PortStateReserved
+				usage.Owner = owner
+				occupancy.Summary.Reserved++
+			} else if excluded.Contains(usage.Port) {
+				usage.State = PortStateSystemReserved
+				occupancy.Summary.SystemReserved++
+			} else {
+				usage.State = PortStateFree
+				occupancy.Summary.Free++
+			}
+			occupancy.Summary.Total++
+			occupancy.Ports = append(occupancy.Ports, usage)
+		}
+	}
+	return occupancy, nil
+}
@@ -0,0 +1,106 @@
+package models_test
+
+import (
+	. "code.cloudfoundry.org/routing-api/models"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("PortOccupancy", func() {
+	var (
+		routerGroup  RouterGroup
+		mappings     []TcpRouteMapping
+		reservations []PortReservation
+		policy       PortPolicy
+	)
+
+	BeforeEach(func() {
+		policy = PortPolicy{SystemComponentPorts: []uint16{1028}}
+		routerGroup = RouterGroup{
+			Guid:            "rg-guid",
+			Name:            "default-tcp",
+			Type:            RouterGroup_TCP,
+			ReservablePorts: "1030,1024-1028",
+		}
+		mappings = []TcpRouteMapping{
+			NewTcpRouteMapping("rg-guid", 1024, "10.0.0.1", 8080, 0, "instance-1", nil, nil, 60, ModificationTag{}, false, ""),
+			NewTcpRouteMapping("rg-guid", 1024, "10.0.0.2", 8081, 0, "instance-2", nil, nil, 60, ModificationTag{}, false, ""),
+			NewTcpRouteMapping("other-guid", 1025, "10.0.0.3", 8080, 0, "", nil, nil, 60, ModificationTag{}, false, ""),
+		}
+		reservations = []PortReservation{
+			NewPortReservation("rg-guid", 1026, "some-owner", nil),
+			NewPortReservation("other-guid", 1027, "other-owner", nil),
+		}
+	})
+
+	It("reports the state of every reservable port in order", func() {
+		occupancy, err := NewPortOccupancy(routerGroup, mappings, reservations, policy)
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(occupancy.RouterGroupGuid).To(Equal("rg-guid"))
+		Expect(occupancy.RouterGroupName).To(Equal("default-tcp"))
+		Expect(occupancy.Ports).To(Equal([]PortUsage{
+			{Port: 1024, State: PortStateMapped, Backends: []PortBackend{
+				{HostIP: "10.0.0.1", HostPort: 8080, InstanceId: "instance-1"},
+				{HostIP: "10.0.0.2", HostPort: 8081, InstanceId: "instance-2"},
+			}},
+			{Port: 1025, State: PortStateFree},
+			{Port: 1026, State: PortStateReserved, Owner: "some-owner"},
+			{Port: 1027, State: PortStateFree},
+			{Port: 1028, State: PortStateSystemReserved},
+			{Port: 1030, State: PortStateFree},
+		}))
+		Expect(occupancy.Summary).To(Equal(PortOccupancySummary{
+			Total:          6,
+			Free:           3,
+			Mapped:         1,
+			Reserved:       1,
+			SystemReserved: 1,
+		}))
+	})
+
+	Context("when a mapping forwards a port range", func() {
+		It("reports every port of the range as mapped to the backend port of its offset", func() {
+			rangeMapping := NewTcpRouteMapping("rg-guid", 1025, "10.0.0.4", 9000, 0, "", nil, nil, 60, ModificationTag{}, false, "")
+			rangeMapping.ExternalPortEnd = 1027
+			occupancy, err := NewPortOccupancy(routerGroup, []TcpRouteMapping{rangeMapping}, nil, policy)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(occupancy.Ports[1:4]).To(Equal([]PortUsage{
+				{Port: 1025, State: PortStateMapped, Backends: []PortBackend{{HostIP: "10.0.0.4", HostPort: 9000}}},
+				{Port: 1026, State: PortStateMapped, Backends: []PortBackend{{HostIP: "10.0.0.4", HostPort: 9001}}},
+				{Port: 1027, State: PortStateMapped, Backends: []PortBackend{{HostIP: "10.0.0.4", HostPort: 9002}}},
+			}))
+			Expect(occupancy.Summary.Mapped).To(Equal(3))
+		})
+	})
+
+	Context("when the router group has excluded ports", func() {
+		It("reports them as system reserved instead of the system component ports", func() {
+			routerGroup.ExcludedPorts = "1025"
+			occupancy, err := NewPortOccupancy(routerGroup, mappings, reservations, policy)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(occupancy.Ports[1]).To(Equal(PortUsage{Port: 1025, State: PortStateSystemReserved}))
+			Expect(occupancy.Ports[4]).To(Equal(PortUsage{Port: 1028, State: PortStateFree}))
+		})
+	})
+
+	Context("when the router group has no reservable ports", func() {
+		It("reports no ports", func() {
+			routerGroup.ReservablePorts = ""
+			occupancy, err := NewPortOccupancy(routerGroup, mappings, reservations, policy)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(occupancy.Ports).To(BeEmpty())
+			Expect(occupancy.Summary).To(Equal(PortOccupancySummary{}))
+		})
+	})
+
+	Context("when the reservable ports are invalid", func() {
+		It("returns an error", func() {
+			routerGroup.ReservablePorts = "abc"
+			_, err := NewPortOccupancy(routerGroup, mappings, reservations, policy)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
@@ -0,0 +1,77 @@
+package models
+
+import (
+	"fmt"
+	"time"
+
+	uuid "github.com/nu7hatch/gouuid"
+)
+
+// PortReservation holds an external port of a tcp router group for an owner,
+// so that it is not handed out when ports are allocated for tcp route
+// mappings. A reservation without a TTL never expires.
+type PortReservation struct {
+	Guid      string     `gorm:"primary_key" json:"guid"`
+	CreatedAt time.Time  `json:"-"`
+	UpdatedAt time.Time  `json:"-"`
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+	PortReservationEntity
+}
+
+type PortReservationEntity struct {
+	RouterGroupGuid string `gorm:"not null" json:"router_group_guid"`
+	Port            uint16 `gorm:"not null; type:int" json:"port"`
+	Owner           string `gorm:"not null" json:"owner"`
+	TTL             *int   `json:"ttl,omitempty"`
+	ModificationTag `json:"modification_tag"`
+}
+
+func (PortReservation) TableName() string {
+	return "port_reservations"
+}
+
+func NewPortReservation(routerGroupGuid string, port uint16, owner string, ttl *int) PortReservation {
+	return PortReservation{
+		PortReservationEntity: PortReservationEntity{
+			RouterGroupGuid: routerGroupGuid,
+			Port:            port,
+			Owner:           owner,
+			TTL:             ttl,
+		},
+	}
+}
+
+func NewPortReservationWithModel(reservation PortReservation) (PortReservation, error) {
+	guid, err := uuid.NewV4()
+	if err != nil {
+		return PortReservation{}, err
+	}
+
+	tag, err := NewModificationTag()
+	if err != nil {
+		return PortReservation{}, err
+	}
+
+	newReservation := PortReservation{
+		Guid:                  guid.String(),
+		PortReservationEntity: reservation.PortReservationEntity,
+	}
+	newReservation.ModificationTag = tag
+	newReservation.SetExpiry()
+	return newReservation, nil
+}
+
+// SetExpiry sets ExpiresAt from the TTL, counting from now.
+func (r *PortReservation) SetExpiry() {
+	if r.TTL == nil {
+		r.ExpiresAt = nil
+		return
+	}
+
+	expiresAt := time.Now().Add(time.Duration(*r.TTL) * time.Second)
+	r.ExpiresAt = &expiresAt
+}
+
+func (r PortReservation) String() string {
+	return fmt.Sprintf("%s:%d owner=%s", r.RouterGroupGuid, r.Port, r.Owner)
+}
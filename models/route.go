@@ -6,6 +6,11 @@ import (
 	uuid "github.com/nu7hatch/gouuid"
 )
 
+const (
+	RouteProtocolHTTP1 = "http1"
+	RouteProtocolHTTP2 = "http2"
+)
+
 type Route struct {
 	Model
 	ExpiresAt time.Time `json:"-"`
@@ -19,6 +24,17 @@ type RouteEntity struct {
 	TTL             *int   `json:"ttl"`
 	LogGuid         string `json:"log_guid"`
 	RouteServiceUrl string `gorm:"not null; unique_index:idx_route" json:"route_service_url,omitempty"`
+	// TLSPort and ServerCertDomainSAN mirror the gorouter registration fields
+	// used to enable TLS to the backend. A TLSPort of 0 means plain HTTP.
+	TLSPort             int    `gorm:"default:0; unique_index:idx_route; type:int" json:"tls_port,omitempty"`
+	ServerCertDomainSAN string `json:"server_cert_domain_san,omitempty"`
+	// Protocol is not part of the unique index so that a backend can switch
+	// protocols with an update.
+	Protocol string `json:"protocol,omitempty"`
+	// RouterGroupGuid optionally associates the route with an http router
+	// group, whose TTL policy then applies to it.
+	RouterGroupGuid string   `json:"router_group_guid,omitempty"`
+	Labels          LabelSet `json:"labels,omitempty"`
 	ModificationTag `json:"modification_tag"`
 }
 
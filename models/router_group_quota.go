@@ -0,0 +1,61 @@
+package models
+
+import "fmt"
+
+// RouterGroupQuotaUsage counts the live tcp route mappings of a router group
+// that are limited by its quotas.
+type RouterGroupQuotaUsage struct {
+	TcpRoutes                   int            `json:"tcp_routes"`
+	TcpRoutesByIsolationSegment map[string]int `json:"tcp_routes_by_isolation_segment"`
+}
+
+// HasTcpRouteQuotas reports whether the number of tcp route mappings of the
+// router group is limited.
+func (g RouterGroup) HasTcpRouteQuotas() bool {
+	return g.MaxTcpRoutes > 0 || g.MaxTcpRoutesPerIsolationSegment > 0
+}
+
+// NewRouterGroupQuotaUsage counts the mappings of the router group. Mappings
+// without an isolation segment are counted under "".
+func NewRouterGroupQuotaUsage(routerGroup RouterGroup, mappings []TcpRouteMapping) RouterGroupQuotaUsage {
+	usage := RouterGroupQuotaUsage{TcpRoutesByIsolationSegment: map[string]int{}}
+	for _, mapping := range mappings {
+		if mapping.RouterGroupGuid == routerGroup.Guid {
+			usage.TcpRoutes++
+			usage.TcpRoutesByIsolationSegment[mapping.IsolationSegment]++
+		}
+	}
+	return usage
+}
+
+// MaxTcpRoutesPerIsolationSegment returns the number of mappings of the
+// isolation segment with the most mappings.
+func (u RouterGroupQuotaUsage) MaxTcpRoutesPerIsolationSegment() int {
+	max := 0
+	for _, count := range u.TcpRoutesByIsolationSegment {
+		if count > max {
+			max = count
+		}
+	}
+	return max
+}
+
+// Add counts a new mapping of the router group, or returns an error without
+// counting it when it would exceed a quota of the router group.
+func (u *RouterGroupQuotaUsage) Add(routerGroup RouterGroup, mapping TcpRouteMapping) error {
+	if routerGroup.MaxTcpRoutes > 0 && u.TcpRoutes >= routerGroup.MaxTcpRoutes {
+		return fmt.Errorf("router group %s has reached its quota of %d tcp routes", routerGroup.Name, routerGroup.MaxTcpRoutes)
+	}
+
+	segment := mapping.IsolationSegment
+	if routerGroup.MaxTcpRoutesPerIsolationSegment > 0 && u.TcpRoutesByIsolationSegment[segment] >= routerGroup.MaxTcpRoutesPerIsolationSegment {
+		return fmt.Errorf("isolation segment '%s' of router group %s has reached its quota of %d tcp routes", segment, routerGroup.Name, routerGroup.MaxTcpRoutesPerIsolationSegment)
+	}
+
+	if u.TcpRoutesByIsolationSegment == nil {
+		u.TcpRoutesByIsolationSegment = map[string]int{}
+	}
+	u.TcpRoutes++
+	u.TcpRoutesByIsolationSegment[segment]++
+	return nil
+}
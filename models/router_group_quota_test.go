@@ -0,0 +1,73 @@
+package models_test
+
+import (
+	. "code.cloudfoundry.org/routing-api/models"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("RouterGroupQuotaUsage", func() {
+	var (
+		routerGroup RouterGroup
+		mappings    []TcpRouteMapping
+	)
+
+	newMapping := func(routerGroupGuid, hostIP, isolationSegment string) TcpRouteMapping {
+		mapping := NewTcpRouteMapping(routerGroupGuid, 1024, hostIP, 8080, 0, "", nil, nil, 60, ModificationTag{}, false, "")
+		mapping.IsolationSegment = isolationSegment
+		return mapping
+	}
+
+	BeforeEach(func() {
+		routerGroup = RouterGroup{
+			Guid:            "rg-guid",
+			Name:            "default-tcp",
+			Type:            RouterGroup_TCP,
+			ReservablePorts: "1024-2048",
+		}
+		mappings = []TcpRouteMapping{
+			newMapping("rg-guid", "10.0.0.1", ""),
+			newMapping("rg-guid", "10.0.0.2", "is1"),
+			newMapping("rg-guid", "10.0.0.3", "is1"),
+			newMapping("other-guid", "10.0.0.4", "is1"),
+		}
+	})
+
+	It("counts the mappings of the router group by isolation segment", func() {
+		usage := NewRouterGroupQuotaUsage(routerGroup, mappings)
+		Expect(usage.TcpRoutes).To(Equal(3))
+		Expect(usage.TcpRoutesByIsolationSegment).To(Equal(map[string]int{"": 1, "is1": 2}))
+		Expect(usage.MaxTcpRoutesPerIsolationSegment()).To(Equal(2))
+	})
+
+	Describe("Add", func() {
+		It("counts mappings within the quotas", func() {
+			routerGroup.MaxTcpRoutes = 4
+			routerGroup.MaxTcpRoutesPerIsolationSegment = 2
+			usage := NewRouterGroupQuotaUsage(routerGroup, mappings)
+
+			Expect(usage.Add(routerGroup, newMapping("rg-guid", "10.0.0.5", "is2"))).To(Succeed())
+			Expect(usage.TcpRoutes).To(Equal(4))
+			Expect(usage.TcpRoutesByIsolationSegment["is2"]).To(Equal(1))
+		})
+
+		It("fails when the router group has reached its quota", func() {
+			routerGroup.MaxTcpRoutes = 3
+			usage := NewRouterGroupQuotaUsage(routerGroup, mappings)
+
+			err := usage.Add(routerGroup, newMapping("rg-guid", "10.0.0.5", "is2"))
+			Expect(err).To(MatchError("router group default-tcp has reached its quota of 3 tcp routes"))
+			Expect(usage.TcpRoutes).To(Equal(3))
+		})
+
+		It("fails when the isolation segment has reached its quota", func() {
+			routerGroup.MaxTcpRoutesPerIsolationSegment = 2
+			usage := NewRouterGroupQuotaUsage(routerGroup, mappings)
+
+			err := usage.Add(routerGroup, newMapping("rg-guid", "10.0.0.5", "is1"))
+			Expect(err).To(MatchError("isolation segment 'is1' of router group default-tcp has reached its quota of 2 tcp routes"))
+			Expect(usage.Add(routerGroup, newMapping("rg-guid", "10.0.0.5", ""))).To(Succeed())
+		})
+	})
+})
@@ -11,21 +11,29 @@ var ErrInvalidPort = errors.New("port must be between 1024 and 65535")
 
 type RouterGroupType string
 
-var ReservedSystemComponentPorts = []uint16{}
-var FailOnRouterPortConflicts = false
-
 const (
 	RouterGroup_TCP  RouterGroupType = "tcp"
 	RouterGroup_HTTP RouterGroupType = "http"
+	RouterGroup_UDP  RouterGroupType = "udp"
 )
 
 type RouterGroupsDB []RouterGroupDB
 
 type RouterGroupDB struct {
 	Model
-	Name            string
-	Type            string
-	ReservablePorts string
+	Name                            string
+	Type                            string
+	ReservablePorts                 string
+	Labels                          string
+	Description                     string
+	MinTTL                          int
+	MaxTTL                          int
+	DefaultTTL                      int
+	MaxTcpRoutes                    int
+	MaxTcpRoutesPerIsolationSegment int
+	ExcludedPorts                   string
+	AllowedBackendCIDRs             string
+	DeniedBackendCIDRs              string
 }
 
 type RouterGroup struct {
@@ -34,6 +42,25 @@ type RouterGroup struct {
 	Name            string          `json:"name"`
 	Type            RouterGroupType `json:"type"`
 	ReservablePorts ReservablePorts `json:"reservable_ports" yaml:"reservable_ports"`
+	ExcludedPorts   ReservablePorts `json:"excluded_ports,omitempty" yaml:"excluded_ports"`
+	Labels          LabelSet        `json:"labels,omitempty" yaml:"labels"`
+	Description     string          `json:"description,omitempty" yaml:"description"`
+	// MinTTL, MaxTTL and DefaultTTL override the TTL policy for the routes of
+	// the router group. Zero means the global setting applies.
+	MinTTL     int `json:"min_ttl,omitempty" yaml:"min_ttl"`
+	MaxTTL     int `json:"max_ttl,omitempty" yaml:"max_ttl"`
+	DefaultTTL int `json:"default_ttl,omitempty" yaml:"default_ttl"`
+	// MaxTcpRoutes and MaxTcpRoutesPerIsolationSegment limit the number of
+	// tcp route mappings of the router group. Zero means no limit.
+	MaxTcpRoutes                    int `json:"max_tcp_routes,omitempty" yaml:"max_tcp_routes"`
+	MaxTcpRoutesPerIsolationSegment int `json:"max_tcp_routes_per_isolation_segment,omitempty" yaml:"max_tcp_routes_per_isolation_segment"`
+	// AllowedBackendCIDRs replaces the globally allowed backend networks for
+	// the routes of the router group. DeniedBackendCIDRs are denied in
+	// addition to the globally denied backend networks.
+	AllowedBackendCIDRs CIDRs `json:"allowed_backend_cidrs,omitempty" yaml:"allowed_backend_cidrs"`
+	DeniedBackendCIDRs  CIDRs `json:"denied_backend_cidrs,omitempty" yaml:"denied_backend_cidrs"`
+	// QuotaUsage is only set in responses for router groups with quotas.
+	QuotaUsage *RouterGroupQuotaUsage `json:"quota_usage,omitempty" yaml:"-"`
 }
 
 func NewRouterGroupDB(routerGroup RouterGroup) RouterGroupDB {
@@ -43,10 +70,20 @@ func NewRouterGroupDB(routerGroup RouterGroup) RouterGroupDB {
 		}
 	}
 	return RouterGroupDB{
-		Model:           routerGroup.Model,
-		Name:            routerGroup.Name,
-		Type:            string(routerGroup.Type),
-		ReservablePorts: string(routerGroup.ReservablePorts),
+		Model:                           routerGroup.Model,
+		Name:                            routerGroup.Name,
+		Type:                            string(routerGroup.Type),
+		ReservablePorts:                 string(routerGroup.ReservablePorts),
+		Labels:                          string(routerGroup.Labels),
+		Description:                     routerGroup.Description,
+		MinTTL:                          routerGroup.MinTTL,
+		MaxTTL:                          routerGroup.MaxTTL,
+		DefaultTTL:                      routerGroup.DefaultTTL,
+		MaxTcpRoutes:                    routerGroup.MaxTcpRoutes,
+		MaxTcpRoutesPerIsolationSegment: routerGroup.MaxTcpRoutesPerIsolationSegment,
+		ExcludedPorts:                   string(routerGroup.ExcludedPorts),
+		AllowedBackendCIDRs:             string(routerGroup.AllowedBackendCIDRs),
+		DeniedBackendCIDRs:              string(routerGroup.DeniedBackendCIDRs),
 	}
 }
 
@@ -56,11 +93,21 @@ func (RouterGroupDB) TableName() string {
 
 func (rg *RouterGroupDB) ToRouterGroup() RouterGroup {
 	return RouterGroup{
-		Model:           rg.Model,
-		Guid:            rg.Guid,
-		Name:            rg.Name,
-		Type:            RouterGroupType(rg.Type),
-		ReservablePorts: ReservablePorts(rg.ReservablePorts),
+		Model:                           rg.Model,
+		Guid:                            rg.Guid,
+		Name:                            rg.Name,
+		Type:                            RouterGroupType(rg.Type),
+		ReservablePorts:                 ReservablePorts(rg.ReservablePorts),
+		Labels:                          LabelSet(rg.Labels),
+		Description:                     rg.Description,
+		MinTTL:                          rg.MinTTL,
+		MaxTTL:                          rg.MaxTTL,
+		DefaultTTL:                      rg.DefaultTTL,
+		MaxTcpRoutes:                    rg.MaxTcpRoutes,
+		MaxTcpRoutesPerIsolationSegment: rg.MaxTcpRoutesPerIsolationSegment,
+		ExcludedPorts:                   ReservablePorts(rg.ExcludedPorts),
+		AllowedBackendCIDRs:             CIDRs(rg.AllowedBackendCIDRs),
+		DeniedBackendCIDRs:              CIDRs(rg.DeniedBackendCIDRs),
 	}
 }
 
@@ -74,16 +121,68 @@ func (rgs RouterGroupsDB) ToRouterGroups() RouterGroups {
 
 type RouterGroups []RouterGroup
 
-func (g RouterGroups) Validate() error {
+// RouterGroupDependents are the live routes and port reservations of a router
+// group.
+type RouterGroupDependents struct {
+	Routes           []Route           `json:"routes"`
+	TcpRouteMappings []TcpRouteMapping `json:"tcp_routes"`
+	UdpRouteMappings []UdpRouteMapping `json:"udp_routes"`
+	PortReservations []PortReservation `json:"port_reservations"`
+}
+
+func (d RouterGroupDependents) Empty() bool {
+	return len(d.Routes) == 0 && len(d.TcpRouteMappings) == 0 && len(d.UdpRouteMappings) == 0 && len(d.PortReservations) == 0
+}
+
+func (d RouterGroupDependents) String() string {
+	return fmt.Sprintf("%d http routes, %d tcp routes, %d udp routes and %d port reservations",
+		len(d.Routes), len(d.TcpRouteMappings), len(d.UdpRouteMappings), len(d.PortReservations))
+}
+
+// PortPolicy holds the port settings that apply to every router group.
+type PortPolicy struct {
+	// SystemComponentPorts are excluded from router groups without their own
+	// excluded ports, since system components listen on them.
+	SystemComponentPorts []uint16
+	// FailOnConflicts rejects router groups whose reservable ports include
+	// excluded ports.
+	FailOnConflicts bool
+}
+
+// ExcludedPorts returns the ports that the router group must not route: its
+// own excluded ports, or the system component ports when it has none.
+func (p PortPolicy) ExcludedPorts(g RouterGroup) (Ranges, error) {
+	if g.ExcludedPorts != "" {
+		return g.ExcludedPorts.Parse()
+	}
+
+	ranges := Ranges{}
+	for _, port := range p.SystemComponentPorts {
+		ranges = append(ranges, Range{start: port, end: port})
+	}
+	return ranges, nil
+}
+
+func (g RouterGroups) Validate(policy PortPolicy) error {
 	for _, r := range g {
-		if err := r.Validate(); err != nil {
+		if err := r.Validate(policy); err != nil {
 			return err
 		}
 	}
 	return nil
 }
 
-func (g RouterGroup) Validate() error {
+// FindByGuid returns the router group with the guid and whether it was found.
+func (g RouterGroups) FindByGuid(guid string) (RouterGroup, bool) {
+	for _, r := range g {
+		if r.Guid == guid {
+			return r, true
+		}
+	}
+	return RouterGroup{}, false
+}
+
+func (g RouterGroup) Validate(policy PortPolicy) error {
 	if g.Name == "" {
 		return errors.New("missing name in router group")
 	}
@@ -92,8 +191,38 @@ func (g RouterGroup) Validate() error {
 		return errors.New("missing type in router group")
 	}
 
+	if err := g.Labels.Validate(); err != nil {
+		return err
+	}
+
+	if err := g.validateTTLs(); err != nil {
+		return err
+	}
+
+	if err := g.validateQuotas(); err != nil {
+		return err
+	}
+
+	if _, err := g.AllowedBackendCIDRs.Parse(); err != nil {
+		return fmt.Errorf("invalid allowed_backend_cidrs in router group %s: %s", g.Name, err)
+	}
+
+	if _, err := g.DeniedBackendCIDRs.Parse(); err != nil {
+		return fmt.Errorf("invalid denied_backend_cidrs in router group %s: %s", g.Name, err)
+	}
+
+	if g.ExcludedPorts != "" {
+		if g.Type == RouterGroup_HTTP {
+			return errors.New("excluded ports are not supported for router groups of type http")
+		}
+
+		if _, err := g.ExcludedPorts.Parse(); err != nil {
+			return fmt.Errorf("invalid excluded_ports in router group %s: %s", g.Name, err)
+		}
+	}
+
 	if g.ReservablePorts == "" {
-		if g.Type == RouterGroup_TCP {
+		if g.Type == RouterGroup_TCP || g.Type == RouterGroup_UDP {
 			return fmt.Errorf("missing reservable_ports in router group: %s", g.Name)
 		}
 
@@ -104,8 +233,159 @@ func (g RouterGroup) Validate() error {
 		return errors.New("reservable ports are not supported for router groups of type http")
 	}
 
-	return g.ReservablePorts.Validate()
+	if err := g.ReservablePorts.Validate(); err != nil {
+		return err
+	}
+
+	if policy.FailOnConflicts {
+		return g.validateNoExcludedPorts(policy)
+	}
+	return nil
+}
+
+// validateNoExcludedPorts returns an error when the reservable ports include
+// excluded ports.
+func (g RouterGroup) validateNoExcludedPorts(policy PortPolicy) error {
+	portRanges, err := g.ReservablePorts.Parse()
+	if err != nil {
+		return err
+	}
+	excluded, err := policy.ExcludedPorts(g)
+	if err != nil {
+		return err
+	}
+
+	for _, r1 := range portRanges {
+		for _, r2 := range excluded {
+			if r1.Overlaps(r2) {
+				if g.ExcludedPorts != "" {
+					return fmt.Errorf("Invalid ports. Reservable ports must not include the following excluded ports: %s.", g.ExcludedPorts)
+				}
+				return fmt.Errorf("Invalid ports. Reservable ports must not include the following reserved system component ports: %v.", policy.SystemComponentPorts)
+			}
+		}
+	}
+	return nil
+}
+
+func (g RouterGroup) validateTTLs() error {
+	if g.MinTTL < 0 || g.MaxTTL < 0 || g.DefaultTTL < 0 {
+		return fmt.Errorf("min_ttl, max_ttl and default_ttl must not be negative in router group: %s", g.Name)
+	}
+
+	if g.MinTTL > 0 && g.MaxTTL > 0 && g.MinTTL > g.MaxTTL {
+		return fmt.Errorf("min_ttl %d is greater than max_ttl %d in router group: %s", g.MinTTL, g.MaxTTL, g.Name)
+	}
+
+	if g.DefaultTTL > 0 && (g.DefaultTTL < g.MinTTL || (g.MaxTTL > 0 && g.DefaultTTL > g.MaxTTL)) {
+		return fmt.Errorf("default_ttl %d must be between min_ttl and max_ttl in router group: %s", g.DefaultTTL, g.Name)
+	}
+
+	return nil
+}
+
+func (g RouterGroup) validateQuotas() error {
+	if g.MaxTcpRoutes < 0 || g.MaxTcpRoutesPerIsolationSegment < 0 {
+		return fmt.Errorf("max_tcp_routes and max_tcp_routes_per_isolation_segment must not be negative in router group: %s", g.Name)
+	}
+
+	if g.HasTcpRouteQuotas() && (g.Type == RouterGroup_HTTP || g.Type == RouterGroup_UDP) {
+		return fmt.Errorf("tcp route quotas are not supported for router groups of type %s", g.Type)
+	}
+
+	return nil
+}
+
+// TTLPolicy bounds the TTLs of routes. Routes registered without a TTL get
+// Default.
+type TTLPolicy struct {
+	Min     int
+	Max     int
+	Default int
+}
+
+// DefaultTTLPolicy is the policy for routes outside of a router group with its
+// own TTL settings: any TTL up to maxTTL, defaulting to maxTTL.
+func DefaultTTLPolicy(maxTTL int) TTLPolicy {
+	return TTLPolicy{Min: 1, Max: maxTTL, Default: maxTTL}
+}
+
+// TTLPolicy returns the TTL policy for the routes of the router group. Each
+// TTL the router group does not set falls back to DefaultTTLPolicy, except
+// that the default follows the router group's max_ttl when only that is set.
+func (g RouterGroup) TTLPolicy(maxTTL int) TTLPolicy {
+	policy := DefaultTTLPolicy(maxTTL)
+	if g.MinTTL > 0 {
+		policy.Min = g.MinTTL
+	}
+	if g.MaxTTL > 0 {
+		policy.Max = g.MaxTTL
+		policy.Default = g.MaxTTL
+	}
+	if g.DefaultTTL > 0 {
+		policy.Default = g.DefaultTTL
+	}
+	return policy
+}
 
+// ValidateExternalPort returns an error when traffic for the port would not be
+// forwarded to the router group, because the port is outside its reservable
+// ports or is excluded by the router group or the policy.
+func (g RouterGroup) ValidateExternalPort(port uint16, policy PortPolicy) error {
+	return g.ValidateExternalPortRange(Range{start: port, end: port}, policy)
+}
+
+// ValidateExternalPortRange is ValidateExternalPort for every port of the
+// range.
+func (g RouterGroup) ValidateExternalPortRange(r Range, policy PortPolicy) error {
+	excluded, err := policy.ExcludedPorts(g)
+	if err != nil {
+		return err
+	}
+	for port := uint32(r.start); port <= uint32(r.end); port++ {
+		if excluded.Contains(uint16(port)) {
+			if g.ExcludedPorts != "" {
+				return fmt.Errorf("external port %d is excluded by router group %s", port, g.Name)
+			}
+			return fmt.Errorf("external port %d is a reserved system component port", port)
+		}
+	}
+
+	if g.ReservablePorts == "" {
+		return fmt.Errorf("router group %s has no reservable ports", g.Name)
+	}
+
+	ranges, err := g.ReservablePorts.Parse()
+	if err != nil {
+		return err
+	}
+	for port := uint32(r.start); port <= uint32(r.end); port++ {
+		if !ranges.Contains(uint16(port)) {
+			return fmt.Errorf("external port %d is not within the reservable ports (%s) of router group %s", port, g.ReservablePorts, g.Name)
+		}
+	}
+	return nil
+}
+
+// StrandedTcpRouteMappings returns the mappings of the router group with an
+// external port that is not within its reservable ports.
+func (g RouterGroup) StrandedTcpRouteMappings(mappings []TcpRouteMapping) ([]TcpRouteMapping, error) {
+	var ranges Ranges
+	if g.ReservablePorts != "" {
+		var err error
+		ranges, err = g.ReservablePorts.Parse()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var stranded []TcpRouteMapping
+	for _, mapping := range mappings {
+		if mapping.RouterGroupGuid == g.Guid && !ranges.ContainsRange(mapping.ExternalPortRange()) {
+			stranded = append(stranded, mapping)
+		}
+	}
+	return stranded, nil
 }
 
 type ReservablePorts string
@@ -161,18 +441,6 @@ func (p ReservablePorts) Validate() error {
 			}
 		}
 	}
-	// check if ports overlap with reservedSystemComponentPorts
-	if FailOnRouterPortConflicts {
-		for _, r1 := range portRanges {
-			for _, reservedPort := range ReservedSystemComponentPorts {
-
-				if reservedPort >= r1.start && reservedPort <= r1.end {
-					errMsg := fmt.Sprintf("Invalid ports. Reservable ports must not include the following reserved system component ports: %v.", ReservedSystemComponentPorts)
-					return errors.New(errMsg)
-				}
-			}
-		}
-	}
 	return nil
 }
 
@@ -251,6 +519,30 @@ func (r Range) Endpoints() (uint16, uint16) {
 	return r.start, r.end
 }
 
+func (r Range) Contains(port uint16) bool {
+	return port >= r.start && port <= r.end
+}
+
+func (rs Ranges) Contains(port uint16) bool {
+	for _, r := range rs {
+		if r.Contains(port) {
+			return true
+		}
+	}
+	return false
+}
+
+// ContainsRange reports whether every port of the range is contained in one
+// of the ranges.
+func (rs Ranges) ContainsRange(r Range) bool {
+	for port := uint32(r.start); port <= uint32(r.end); port++ {
+		if !rs.Contains(uint16(port)) {
+			return false
+		}
+	}
+	return true
+}
+
 func parseRange(r string) (Range, error) {
 	endpoints := strings.Split(r, "-")
 
@@ -0,0 +1,57 @@
+package models
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+const (
+	TcpHealthCheckTCPConnect   = "tcp-connect"
+	TcpHealthCheckTLSHandshake = "tls-handshake"
+	TcpHealthCheckHTTP         = "http"
+)
+
+var tcpHealthCheckTypes = []string{TcpHealthCheckTCPConnect, TcpHealthCheckTLSHandshake, TcpHealthCheckHTTP}
+
+// TcpHealthCheck tells routers how to probe the backend of a tcp route
+// mapping. Interval and Timeout are in seconds. Fields left at 0 use the
+// defaults of the router.
+type TcpHealthCheck struct {
+	Type               string `json:"type"`
+	Interval           int    `json:"interval,omitempty"`
+	Timeout            int    `json:"timeout,omitempty"`
+	HealthyThreshold   int    `json:"healthy_threshold,omitempty"`
+	UnhealthyThreshold int    `json:"unhealthy_threshold,omitempty"`
+	Path               string `json:"path,omitempty"`
+}
+
+// ValidateHealthCheck returns an error when the health check of the mapping
+// cannot be used to probe its backend. Mappings without a health check are
+// valid.
+func (m TcpRouteMapping) ValidateHealthCheck() error {
+	hc := m.HealthCheck
+	if hc == nil {
+		return nil
+	}
+
+	if !containsString(tcpHealthCheckTypes, hc.Type) {
+		return fmt.Errorf("health_check type '%s' must be one of %s", hc.Type, strings.Join(tcpHealthCheckTypes, ", "))
+	}
+	if hc.Interval < 0 || hc.Timeout < 0 || hc.HealthyThreshold < 0 || hc.UnhealthyThreshold < 0 {
+		return errors.New("health_check interval, timeout and thresholds must not be negative")
+	}
+	if hc.Interval > 0 && hc.Timeout > hc.Interval {
+		return fmt.Errorf("health_check timeout %d must not be greater than its interval %d", hc.Timeout, hc.Interval)
+	}
+	if hc.Type == TcpHealthCheckTLSHandshake && m.HostTLSPort <= 0 {
+		return errors.New("tls-handshake health checks require a backend TLS port")
+	}
+	if hc.Path != "" && hc.Type != TcpHealthCheckHTTP {
+		return errors.New("health_check path is only supported by http health checks")
+	}
+	if hc.Path != "" && !strings.HasPrefix(hc.Path, "/") {
+		return fmt.Errorf("health_check path '%s' must start with /", hc.Path)
+	}
+	return nil
+}
@@ -0,0 +1,110 @@
+package models_test
+
+import (
+	"encoding/json"
+
+	"code.cloudfoundry.org/routing-api/models"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("TcpHealthCheck", func() {
+	var tcpRouteMapping models.TcpRouteMapping
+
+	BeforeEach(func() {
+		tcpRouteMapping = models.NewTcpRouteMapping("a-guid", 1234, "1.2.3.4", 5678, 5679, "instance-id", nil, nil, 5, models.ModificationTag{}, false, "")
+		tcpRouteMapping.HealthCheck = &models.TcpHealthCheck{
+			Type:               models.TcpHealthCheckHTTP,
+			Interval:           10,
+			Timeout:            2,
+			HealthyThreshold:   2,
+			UnhealthyThreshold: 3,
+			Path:               "/health",
+		}
+	})
+
+	Describe("JSON", func() {
+		It("is marshaled with the api field names", func() {
+			data, err := json.Marshal(tcpRouteMapping.HealthCheck)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(data).To(MatchJSON(`{
+				"type": "http",
+				"interval": 10,
+				"timeout": 2,
+				"healthy_threshold": 2,
+				"unhealthy_threshold": 3,
+				"path": "/health"
+			}`))
+		})
+
+		It("is omitted from mappings without a health check", func() {
+			tcpRouteMapping.HealthCheck = nil
+			data, err := json.Marshal(tcpRouteMapping)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(data)).NotTo(ContainSubstring("health_check"))
+		})
+	})
+
+	Describe("ValidateHealthCheck()", func() {
+		It("accepts mappings without a health check", func() {
+			tcpRouteMapping.HealthCheck = nil
+			Expect(tcpRouteMapping.ValidateHealthCheck()).To(Succeed())
+		})
+
+		It("accepts a valid health check", func() {
+			Expect(tcpRouteMapping.ValidateHealthCheck()).To(Succeed())
+		})
+
+		It("accepts a health check that only sets a type", func() {
+			tcpRouteMapping.HealthCheck = &models.TcpHealthCheck{Type: models.TcpHealthCheckTCPConnect}
+			Expect(tcpRouteMapping.ValidateHealthCheck()).To(Succeed())
+		})
+
+		It("rejects unknown types", func() {
+			tcpRouteMapping.HealthCheck.Type = ""
+			Expect(tcpRouteMapping.ValidateHealthCheck()).To(MatchError("health_check type '' must be one of tcp-connect, tls-handshake, http"))
+		})
+
+		It("rejects negative values", func() {
+			tcpRouteMapping.HealthCheck.UnhealthyThreshold = -1
+			Expect(tcpRouteMapping.ValidateHealthCheck()).To(MatchError("health_check interval, timeout and thresholds must not be negative"))
+		})
+
+		It("rejects a timeout greater than the interval", func() {
+			tcpRouteMapping.HealthCheck.Timeout = 20
+			Expect(tcpRouteMapping.ValidateHealthCheck()).To(MatchError("health_check timeout 20 must not be greater than its interval 10"))
+		})
+
+		It("accepts any timeout when the interval is left to the router", func() {
+			tcpRouteMapping.HealthCheck.Interval = 0
+			tcpRouteMapping.HealthCheck.Timeout = 20
+			Expect(tcpRouteMapping.ValidateHealthCheck()).To(Succeed())
+		})
+
+		Context("tls-handshake", func() {
+			BeforeEach(func() {
+				tcpRouteMapping.HealthCheck = &models.TcpHealthCheck{Type: models.TcpHealthCheckTLSHandshake}
+			})
+
+			It("accepts mappings with a backend TLS port", func() {
+				Expect(tcpRouteMapping.ValidateHealthCheck()).To(Succeed())
+			})
+
+			It("rejects mappings without a backend TLS port", func() {
+				tcpRouteMapping.HostTLSPort = 0
+				Expect(tcpRouteMapping.ValidateHealthCheck()).To(MatchError("tls-handshake health checks require a backend TLS port"))
+			})
+		})
+
+		It("rejects a path for other types than http", func() {
+			tcpRouteMapping.HealthCheck.Type = models.TcpHealthCheckTCPConnect
+			Expect(tcpRouteMapping.ValidateHealthCheck()).To(MatchError("health_check path is only supported by http health checks"))
+		})
+
+		It("rejects a relative path", func() {
+			tcpRouteMapping.HealthCheck.Path = "health"
+			Expect(tcpRouteMapping.ValidateHealthCheck()).To(MatchError("health_check path 'health' must start with /"))
+		})
+	})
+})
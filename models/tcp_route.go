@@ -1,12 +1,19 @@
 package models
 
 import (
+	"errors"
 	"fmt"
 	"time"
 
 	uuid "github.com/nu7hatch/gouuid"
 )
 
+const (
+	ProxyProtocolNone = "none"
+	ProxyProtocolV1   = "v1"
+	ProxyProtocolV2   = "v2"
+)
+
 type TcpRouteMapping struct {
 	Model
 	ExpiresAt time.Time `json:"-"`
@@ -15,9 +22,9 @@ type TcpRouteMapping struct {
 
 // IMPORTANT!! when adding a new field here that is part of the unique index for
 //
-//	a tcp route, make sure to update not only the logic for Matches(),
-//	but also the SqlDb.FindExistingTcpRouteMapping() function's custom
-//	WHERE filter to include the new field
+//	a tcp route, make sure to update not only the logic for Matches() and
+//	SameRoute(), but also the SqlDb.FindExistingTcpRouteMapping() function's
+//	custom WHERE filter to include the new field
 type TcpMappingEntity struct {
 	RouterGroupGuid    string  `gorm:"not null; unique_index:idx_tcp_route" json:"router_group_guid"`
 	HostPort           uint16  `gorm:"not null; unique_index:idx_tcp_route; type:int; size:32" json:"backend_port"`
@@ -30,13 +37,24 @@ type TcpMappingEntity struct {
 	// TTL on the old record should expire + allow the new route to be created eventually.
 	InstanceId           string `gorm:"null; default:null;" json:"instance_id"`
 	ExternalPort         uint16 `gorm:"not null; unique_index:idx_tcp_route; type:int; size:32" json:"port"`
+	ExternalPortEnd      uint16 `gorm:"default:0; unique_index:idx_tcp_route; type:int" json:"port_end,omitempty"`
 	ModificationTag      `json:"modification_tag"`
 	TTL                  *int   `json:"ttl,omitempty"`
 	IsolationSegment     string `json:"isolation_segment"`
 	TerminateFrontendTLS bool   `gorm:"default:false" json:"terminate_frontend_tls,omitempty"`
+	TlsCertificateGuid   string `json:"tls_certificate_guid,omitempty"`
+	ProxyProtocol        string `json:"proxy_protocol,omitempty"`
+	MaxConnections       int    `json:"max_connections,omitempty"`
+	ConnectTimeout       int    `json:"connect_timeout,omitempty"`
+	IdleTimeout          int    `json:"idle_timeout,omitempty"`
 	// alpns is a csv value
-	ALPNs             string `json:"alpns,omitempty"`
-	EnableBackendMTLS bool   `gorm:"default:false; unique_index:idx_tcp_route" json:"enable_backend_mtls,omitempty"`
+	ALPNs             ALPNs `json:"alpns,omitempty"`
+	EnableBackendMTLS bool  `gorm:"default:false; unique_index:idx_tcp_route" json:"enable_backend_mtls,omitempty"`
+	// BackendTlsProfile is the name of the backend tls profile that routers
+	// use for backend mTLS.
+	BackendTlsProfile string          `json:"backend_tls_profile,omitempty"`
+	Labels            LabelSet        `json:"labels,omitempty"`
+	HealthCheck       *TcpHealthCheck `gorm:"serializer:json" json:"health_check,omitempty"`
 }
 
 func (TcpRouteMapping) TableName() string {
@@ -84,19 +102,84 @@ func NewTcpRouteMapping(
 			TTL:                  &ttl,
 			ModificationTag:      modTag,
 			TerminateFrontendTLS: terminateFrontendTLS,
-			ALPNs:                alpns,
+			ALPNs:                ALPNs(alpns),
 		},
 	}
 	return mapping
 }
 
 func (m TcpRouteMapping) String() string {
+	if m.IsPortRange() {
+		return fmt.Sprintf("%s:%d-%d<->%s:%d-%d", m.RouterGroupGuid, m.ExternalPort, m.ExternalPortEnd, m.HostIP, m.HostPort, m.HostPortFor(m.ExternalPortEnd))
+	}
 	return fmt.Sprintf("%s:%d<->%s:%d", m.RouterGroupGuid, m.ExternalPort, m.HostIP, m.HostPort)
 }
 
+// IsPortRange reports whether the mapping forwards the external ports
+// ExternalPort to ExternalPortEnd (inclusive) instead of a single one. Each
+// port is forwarded to the backend port of the same offset from HostPort.
+func (m TcpRouteMapping) IsPortRange() bool {
+	return m.ExternalPortEnd != 0
+}
+
+// ExternalPortRange returns the external ports of the mapping.
+func (m TcpRouteMapping) ExternalPortRange() Range {
+	if !m.IsPortRange() {
+		return Range{start: m.ExternalPort, end: m.ExternalPort}
+	}
+	return Range{start: m.ExternalPort, end: m.ExternalPortEnd}
+}
+
+// HostPortFor returns the backend port that the external port of the mapping
+// is forwarded to.
+func (m TcpRouteMapping) HostPortFor(externalPort uint16) uint16 {
+	return m.HostPort + (externalPort - m.ExternalPort)
+}
+
+// ValidatePortRange returns an error when the port range of the mapping is
+// empty, descending or does not fit into the backend ports.
+func (m TcpRouteMapping) ValidatePortRange() error {
+	if !m.IsPortRange() {
+		return nil
+	}
+	if m.ExternalPort == 0 {
+		return fmt.Errorf("port_end %d requires a port", m.ExternalPortEnd)
+	}
+	if m.ExternalPortEnd <= m.ExternalPort {
+		return fmt.Errorf("port_end %d must be greater than port %d", m.ExternalPortEnd, m.ExternalPort)
+	}
+	if m.HostTLSPort > 0 {
+		return errors.New("port ranges do not support a backend TLS port")
+	}
+	if uint32(m.HostPort)+uint32(m.ExternalPortEnd-m.ExternalPort) > 65535 {
+		return fmt.Errorf("backend ports starting at %d cannot hold the %d ports of the range", m.HostPort, uint32(m.ExternalPortEnd-m.ExternalPort)+1)
+	}
+	return nil
+}
+
+// ProxyProtocolVersion returns the version of the PROXY protocol header that
+// routers send to the backend, or ProxyProtocolNone when none is sent.
+func (m TcpRouteMapping) ProxyProtocolVersion() string {
+	if m.ProxyProtocol == "" {
+		return ProxyProtocolNone
+	}
+	return m.ProxyProtocol
+}
+
+// ConflictsWith reports whether the external ports of the mappings overlap
+// without being the same, so that traffic for a port would be forwarded by
+// both. Mappings for the same external ports may route to different backends.
+func (m TcpRouteMapping) ConflictsWith(other TcpRouteMapping) bool {
+	if m.RouterGroupGuid != other.RouterGroupGuid {
+		return false
+	}
+	r, otherRange := m.ExternalPortRange(), other.ExternalPortRange()
+	return r.Overlaps(otherRange) && r != otherRange
+}
+
 func (m TcpRouteMapping) Matches(other TcpRouteMapping) bool {
 	sameRouterGroupGuid := m.RouterGroupGuid == other.RouterGroupGuid
-	sameExternalPort := m.ExternalPort == other.ExternalPort
+	sameExternalPort := m.ExternalPort == other.ExternalPort && m.ExternalPortEnd == other.ExternalPortEnd
 	sameHostIP := m.HostIP == other.HostIP
 	sameHostPort := m.HostPort == other.HostPort
 	sameInstanceId := m.InstanceId == other.InstanceId
@@ -122,11 +205,29 @@ func (m TcpRouteMapping) Matches(other TcpRouteMapping) bool {
 		sameSniHostname
 }
 
-func (t *TcpRouteMapping) SetDefaults(maxTTL int) {
+// SameRoute reports whether the mappings agree on the fields of the unique
+// index, so that saving one updates the other. EnableBackendMTLS is part of
+// the index, so a backend registered with and without backend mTLS is stored
+// as two mappings.
+func (m TcpRouteMapping) SameRoute(other TcpRouteMapping) bool {
+	sameSniHostname := (m.SniHostname == nil && other.SniHostname == nil) ||
+		(m.SniHostname != nil && other.SniHostname != nil && *m.SniHostname == *other.SniHostname)
+
+	return m.RouterGroupGuid == other.RouterGroupGuid &&
+		m.ExternalPort == other.ExternalPort &&
+		m.ExternalPortEnd == other.ExternalPortEnd &&
+		m.HostIP == other.HostIP &&
+		m.HostPort == other.HostPort &&
+		m.HostTLSPort == other.HostTLSPort &&
+		m.EnableBackendMTLS == other.EnableBackendMTLS &&
+		sameSniHostname
+}
+
+func (t *TcpRouteMapping) SetDefaults(defaultTTL int) {
 	// default ttl if not present
 	// TTL is a pointer to a uint16 so that we can
 	// detect if it's present or not (i.e. nil or 0)
 	if t.TTL == nil {
-		t.TTL = &maxTTL
+		t.TTL = &defaultTTL
 	}
 }
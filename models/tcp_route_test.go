@@ -188,7 +188,122 @@ var _ = Describe("TCP Route", func() {
 				It("matches()", func() {
 					Expect(tcpRouteMapping.Matches(tcpRouteMapping2)).To(BeTrue())
 				})
+
+				It("is not the same route", func() {
+					Expect(tcpRouteMapping.SameRoute(tcpRouteMapping2)).To(BeFalse())
+				})
+			})
+
+			Context("when two routes are equal and ExternalPortEnd are different", func() {
+				JustBeforeEach(func() {
+					tcpRouteMapping2.SniHostname = tcpRouteMapping.SniHostname
+					Expect(tcpRouteMapping.Matches(tcpRouteMapping2)).To(BeTrue())
+
+					tcpRouteMapping2.ExternalPortEnd = 1240
+				})
+
+				It("doesn't match", func() {
+					Expect(tcpRouteMapping.Matches(tcpRouteMapping2)).To(BeFalse())
+					Expect(tcpRouteMapping.SameRoute(tcpRouteMapping2)).To(BeFalse())
+				})
+			})
+		})
+	})
+
+	Describe("Port ranges", func() {
+		var tcpRouteMapping models.TcpRouteMapping
+
+		BeforeEach(func() {
+			tcpRouteMapping = models.NewTcpRouteMapping("a-guid", 40000, "1.2.3.4", 50000, 0, "", nil, nil, 5, models.ModificationTag{}, false, "")
+			tcpRouteMapping.ExternalPortEnd = 40099
+		})
+
+		It("describes the external and backend ports of the range", func() {
+			Expect(tcpRouteMapping.IsPortRange()).To(BeTrue())
+			Expect(tcpRouteMapping.ExternalPortRange().String()).To(Equal("[40000-40099]"))
+			Expect(tcpRouteMapping.HostPortFor(40042)).To(Equal(uint16(50042)))
+			Expect(tcpRouteMapping.String()).To(Equal("a-guid:40000-40099<->1.2.3.4:50000-50099"))
+		})
+
+		It("is omitted from JSON marshaling for a single port", func() {
+			tcpRouteMapping.ExternalPortEnd = 0
+			Expect(tcpRouteMapping.IsPortRange()).To(BeFalse())
+			data, err := json.Marshal(tcpRouteMapping)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(data)).NotTo(ContainSubstring("port_end"))
+		})
+
+		Describe("ValidatePortRange", func() {
+			It("accepts a valid range", func() {
+				Expect(tcpRouteMapping.ValidatePortRange()).To(Succeed())
+			})
+
+			It("rejects a range without a port", func() {
+				tcpRouteMapping.ExternalPort = 0
+				Expect(tcpRouteMapping.ValidatePortRange()).To(MatchError("port_end 40099 requires a port"))
 			})
+
+			It("rejects a range that does not ascend", func() {
+				tcpRouteMapping.ExternalPortEnd = 40000
+				Expect(tcpRouteMapping.ValidatePortRange()).To(MatchError("port_end 40000 must be greater than port 40000"))
+			})
+
+			It("rejects a backend TLS port", func() {
+				tcpRouteMapping.HostTLSPort = 60000
+				Expect(tcpRouteMapping.ValidatePortRange()).To(MatchError("port ranges do not support a backend TLS port"))
+			})
+
+			It("rejects a range that does not fit into the backend ports", func() {
+				tcpRouteMapping.HostPort = 65500
+				Expect(tcpRouteMapping.ValidatePortRange()).To(MatchError("backend ports starting at 65500 cannot hold the 100 ports of the range"))
+			})
+		})
+
+		Describe("ConflictsWith", func() {
+			var other models.TcpRouteMapping
+
+			BeforeEach(func() {
+				other = models.NewTcpRouteMapping("a-guid", 40050, "1.2.3.5", 50050, 0, "", nil, nil, 5, models.ModificationTag{}, false, "")
+			})
+
+			It("conflicts with a single port inside the range", func() {
+				Expect(tcpRouteMapping.ConflictsWith(other)).To(BeTrue())
+				Expect(other.ConflictsWith(tcpRouteMapping)).To(BeTrue())
+			})
+
+			It("conflicts with a partially overlapping range", func() {
+				other.ExternalPortEnd = 40149
+				Expect(tcpRouteMapping.ConflictsWith(other)).To(BeTrue())
+			})
+
+			It("does not conflict with the same range", func() {
+				other.ExternalPort = 40000
+				other.ExternalPortEnd = 40099
+				Expect(tcpRouteMapping.ConflictsWith(other)).To(BeFalse())
+			})
+
+			It("does not conflict with a port outside the range", func() {
+				other.ExternalPort = 40100
+				Expect(tcpRouteMapping.ConflictsWith(other)).To(BeFalse())
+			})
+
+			It("does not conflict with another router group", func() {
+				other.RouterGroupGuid = "b-guid"
+				Expect(tcpRouteMapping.ConflictsWith(other)).To(BeFalse())
+			})
+		})
+	})
+
+	Describe("ProxyProtocolVersion()", func() {
+		It("returns none when the proxy protocol is omitted", func() {
+			tcpRouteMapping := models.NewTcpRouteMapping("a-guid", 1234, "1.2.3.4", 5678, 0, "", nil, nil, 5, models.ModificationTag{}, false, "")
+			Expect(tcpRouteMapping.ProxyProtocolVersion()).To(Equal(models.ProxyProtocolNone))
+		})
+
+		It("returns the proxy protocol of the mapping", func() {
+			tcpRouteMapping := models.NewTcpRouteMapping("a-guid", 1234, "1.2.3.4", 5678, 0, "", nil, nil, 5, models.ModificationTag{}, false, "")
+			tcpRouteMapping.ProxyProtocol = models.ProxyProtocolV1
+			Expect(tcpRouteMapping.ProxyProtocolVersion()).To(Equal(models.ProxyProtocolV1))
 		})
 	})
 })
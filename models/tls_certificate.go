@@ -0,0 +1,143 @@
+package models
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	uuid "github.com/nu7hatch/gouuid"
+)
+
+// TlsCertificate is a certificate chain and private key that routers present
+// when they terminate TLS for connections to its SNI hostname. The private key
+// is only stored encrypted.
+type TlsCertificate struct {
+	Guid      string    `gorm:"primary_key" json:"guid"`
+	CreatedAt time.Time `json:"-"`
+	UpdatedAt time.Time `json:"-"`
+	TlsCertificateEntity
+}
+
+type TlsCertificateEntity struct {
+	SniHostname         string    `gorm:"not null" json:"sni_hostname"`
+	CertChain           string    `gorm:"not null" json:"cert_chain"`
+	PrivateKey          string    `gorm:"-" json:"private_key,omitempty"`
+	EncryptedPrivateKey string    `gorm:"not null" json:"-"`
+	NotAfter            time.Time `json:"not_after"`
+	ModificationTag     `json:"modification_tag"`
+}
+
+func (TlsCertificate) TableName() string {
+	return "tls_certificates"
+}
+
+func NewTlsCertificate(sniHostname, certChain, privateKey string) TlsCertificate {
+	return TlsCertificate{
+		TlsCertificateEntity: TlsCertificateEntity{
+			SniHostname: sniHostname,
+			CertChain:   certChain,
+			PrivateKey:  privateKey,
+		},
+	}
+}
+
+func NewTlsCertificateWithModel(certificate TlsCertificate) (TlsCertificate, error) {
+	guid, err := uuid.NewV4()
+	if err != nil {
+		return TlsCertificate{}, err
+	}
+
+	tag, err := NewModificationTag()
+	if err != nil {
+		return TlsCertificate{}, err
+	}
+
+	newCertificate := TlsCertificate{
+		Guid:                 guid.String(),
+		TlsCertificateEntity: certificate.TlsCertificateEntity,
+	}
+	newCertificate.ModificationTag = tag
+	return newCertificate, nil
+}
+
+// Leaf returns the first certificate of the chain after checking that it
+// belongs to the private key.
+func (c TlsCertificate) Leaf() (*x509.Certificate, error) {
+	leaf, err := parseKeyPair(c.CertChain, c.PrivateKey)
+	if err != nil {
+		return nil, fmt.Errorf("cert_chain and private_key are not a valid key pair: %s", err)
+	}
+	return leaf, nil
+}
+
+// parseKeyPair returns the first certificate of the PEM encoded chain after
+// checking that it belongs to the PEM encoded private key.
+func parseKeyPair(certChain, privateKey string) (*x509.Certificate, error) {
+	pair, err := tls.X509KeyPair([]byte(certChain), []byte(privateKey))
+	if err != nil {
+		return nil, errors.New(strings.TrimPrefix(err.Error(), "tls: "))
+	}
+	return x509.ParseCertificate(pair.Certificate[0])
+}
+
+// Validate returns an error when routers cannot present the certificate for
+// its SNI hostname: the hostname is invalid, the certificate chain does not
+// belong to the private key, does not cover the hostname or has expired.
+func (c TlsCertificate) Validate(now time.Time) error {
+	if c.SniHostname == "" {
+		return errors.New("each tls certificate requires a non empty sni_hostname")
+	}
+	if _, err := NormalizeHostname(c.SniHostname); err != nil {
+		return fmt.Errorf("sni_hostname: %s", err)
+	}
+	if c.CertChain == "" || c.PrivateKey == "" {
+		return errors.New("each tls certificate requires a cert_chain and a private_key")
+	}
+
+	leaf, err := c.Leaf()
+	if err != nil {
+		return err
+	}
+	if !certificateCovers(leaf, c.SniHostname) {
+		return fmt.Errorf("cert_chain is not valid for sni_hostname '%s'", c.SniHostname)
+	}
+	if now.After(leaf.NotAfter) {
+		return fmt.Errorf("cert_chain expired at %s", leaf.NotAfter.UTC().Format(time.RFC3339))
+	}
+	return nil
+}
+
+// certificateCovers reports whether the certificate is valid for the hostname.
+// A wildcard hostname must be a subject alternative name of the certificate.
+func certificateCovers(leaf *x509.Certificate, hostname string) bool {
+	if !strings.HasPrefix(hostname, "*.") {
+		return leaf.VerifyHostname(hostname) == nil
+	}
+	for _, name := range leaf.DNSNames {
+		if strings.EqualFold(name, hostname) {
+			return true
+		}
+	}
+	return false
+}
+
+// Covers reports whether routers may present the certificate for connections
+// to the hostname: it is the SNI hostname of the certificate, or the SNI
+// hostname is a wildcard for a parent domain of the hostname.
+func (c TlsCertificate) Covers(hostname string) bool {
+	if c.SniHostname == hostname {
+		return true
+	}
+	if !strings.HasPrefix(c.SniHostname, "*.") {
+		return false
+	}
+	_, parent, found := strings.Cut(hostname, ".")
+	return found && "*."+parent == c.SniHostname
+}
+
+func (c TlsCertificate) String() string {
+	return fmt.Sprintf("%s sni_hostname=%s", c.Guid, c.SniHostname)
+}
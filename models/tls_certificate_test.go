@@ -0,0 +1,105 @@
+package models_test
+
+import (
+	"time"
+
+	"code.cloudfoundry.org/routing-api/models"
+	"code.cloudfoundry.org/routing-api/test_helpers"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("TlsCertificate", func() {
+	var (
+		certChain, privateKey string
+		notAfter              time.Time
+	)
+
+	BeforeEach(func() {
+		var err error
+		notAfter = time.Now().Add(24 * time.Hour).Truncate(time.Second)
+		certChain, privateKey, err = test_helpers.CreateHostnameCertificate([]string{"app.example.com", "*.apps.example.com"}, notAfter)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	Describe("NewTlsCertificateWithModel", func() {
+		It("sets a guid and a modification tag", func() {
+			certificate, err := models.NewTlsCertificateWithModel(models.NewTlsCertificate("app.example.com", certChain, privateKey))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(certificate.Guid).NotTo(BeEmpty())
+			Expect(certificate.ModificationTag.Guid).NotTo(BeEmpty())
+			Expect(certificate.SniHostname).To(Equal("app.example.com"))
+			Expect(certificate.PrivateKey).To(Equal(privateKey))
+		})
+	})
+
+	Describe("Leaf", func() {
+		It("returns the first certificate of the chain", func() {
+			leaf, err := models.NewTlsCertificate("app.example.com", certChain, privateKey).Leaf()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(leaf.NotAfter).To(BeTemporally("==", notAfter))
+		})
+	})
+
+	Describe("Validate", func() {
+		It("accepts a certificate for the sni hostname", func() {
+			Expect(models.NewTlsCertificate("app.example.com", certChain, privateKey).Validate(time.Now())).To(Succeed())
+		})
+
+		It("accepts a wildcard sni hostname of the certificate", func() {
+			Expect(models.NewTlsCertificate("*.apps.example.com", certChain, privateKey).Validate(time.Now())).To(Succeed())
+		})
+
+		It("requires an sni hostname", func() {
+			err := models.NewTlsCertificate("", certChain, privateKey).Validate(time.Now())
+			Expect(err).To(MatchError("each tls certificate requires a non empty sni_hostname"))
+		})
+
+		It("rejects an invalid sni hostname", func() {
+			err := models.NewTlsCertificate("app..example.com", certChain, privateKey).Validate(time.Now())
+			Expect(err).To(MatchError(HavePrefix("sni_hostname: ")))
+		})
+
+		It("requires a cert chain and a private key", func() {
+			err := models.NewTlsCertificate("app.example.com", certChain, "").Validate(time.Now())
+			Expect(err).To(MatchError("each tls certificate requires a cert_chain and a private_key"))
+		})
+
+		It("rejects a private key of another certificate", func() {
+			_, otherKey, err := test_helpers.CreateHostnameCertificate([]string{"app.example.com"}, notAfter)
+			Expect(err).NotTo(HaveOccurred())
+
+			err = models.NewTlsCertificate("app.example.com", certChain, otherKey).Validate(time.Now())
+			Expect(err).To(MatchError(HavePrefix("cert_chain and private_key are not a valid key pair: ")))
+		})
+
+		It("rejects a certificate for another hostname", func() {
+			err := models.NewTlsCertificate("other.example.com", certChain, privateKey).Validate(time.Now())
+			Expect(err).To(MatchError("cert_chain is not valid for sni_hostname 'other.example.com'"))
+		})
+
+		It("rejects a wildcard sni hostname that is not a name of the certificate", func() {
+			err := models.NewTlsCertificate("*.example.com", certChain, privateKey).Validate(time.Now())
+			Expect(err).To(MatchError("cert_chain is not valid for sni_hostname '*.example.com'"))
+		})
+
+		It("rejects an expired certificate", func() {
+			err := models.NewTlsCertificate("app.example.com", certChain, privateKey).Validate(notAfter.Add(time.Second))
+			Expect(err).To(MatchError("cert_chain expired at " + notAfter.UTC().Format(time.RFC3339)))
+		})
+	})
+
+	Describe("Covers", func() {
+		DescribeTable("reports whether the certificate may be presented for the hostname",
+			func(sniHostname, hostname string, covers bool) {
+				Expect(models.NewTlsCertificate(sniHostname, "", "").Covers(hostname)).To(Equal(covers))
+			},
+			Entry("the sni hostname", "app.example.com", "app.example.com", true),
+			Entry("another hostname", "app.example.com", "other.example.com", false),
+			Entry("a hostname below a wildcard", "*.example.com", "app.example.com", true),
+			Entry("a hostname two labels below a wildcard", "*.example.com", "a.app.example.com", false),
+			Entry("the parent of a wildcard", "*.example.com", "example.com", false),
+		)
+	})
+})
@@ -0,0 +1,97 @@
+package models
+
+import (
+	"fmt"
+	"time"
+
+	uuid "github.com/nu7hatch/gouuid"
+)
+
+// UdpRouteMapping forwards the datagrams that a router of a udp router group
+// receives on the external port to a backend.
+type UdpRouteMapping struct {
+	Model
+	ExpiresAt time.Time `json:"-"`
+	UdpMappingEntity
+}
+
+// IMPORTANT!! when adding a new field here that is part of the unique index for
+//
+//	a udp route, make sure to update not only the logic for Matches(), but also
+//	the SqlDb.FindExistingUdpRouteMapping() function's custom WHERE filter to
+//	include the new field
+type UdpMappingEntity struct {
+	RouterGroupGuid  string `gorm:"not null; unique_index:idx_udp_route" json:"router_group_guid"`
+	HostPort         uint16 `gorm:"not null; unique_index:idx_udp_route; type:int; size:32" json:"backend_port"`
+	HostIP           string `gorm:"not null; unique_index:idx_udp_route" json:"backend_ip"`
+	InstanceId       string `gorm:"null; default:null;" json:"instance_id"`
+	ExternalPort     uint16 `gorm:"not null; unique_index:idx_udp_route; type:int; size:32" json:"port"`
+	ModificationTag  `json:"modification_tag"`
+	TTL              *int     `json:"ttl,omitempty"`
+	IsolationSegment string   `json:"isolation_segment"`
+	Labels           LabelSet `json:"labels,omitempty"`
+}
+
+func (UdpRouteMapping) TableName() string {
+	return "udp_routes"
+}
+
+func NewUdpRouteMappingWithModel(udpMapping UdpRouteMapping) (UdpRouteMapping, error) {
+	guid, err := uuid.NewV4()
+	if err != nil {
+		return UdpRouteMapping{}, err
+	}
+
+	m := Model{Guid: guid.String()}
+	return UdpRouteMapping{
+		ExpiresAt:        time.Now().Add(time.Duration(*udpMapping.TTL) * time.Second),
+		Model:            m,
+		UdpMappingEntity: udpMapping.UdpMappingEntity,
+	}, nil
+}
+
+func NewUdpRouteMapping(
+	routerGroupGuid string,
+	externalPort uint16,
+	hostIP string,
+	hostPort uint16,
+	instanceId string,
+	ttl int,
+	modTag ModificationTag,
+) UdpRouteMapping {
+	return UdpRouteMapping{
+		UdpMappingEntity: UdpMappingEntity{
+			RouterGroupGuid: routerGroupGuid,
+			ExternalPort:    externalPort,
+			HostIP:          hostIP,
+			HostPort:        hostPort,
+			InstanceId:      instanceId,
+			TTL:             &ttl,
+			ModificationTag: modTag,
+		},
+	}
+}
+
+func (m UdpRouteMapping) String() string {
+	return fmt.Sprintf("%s:%d<->%s:%d", m.RouterGroupGuid, m.ExternalPort, m.HostIP, m.HostPort)
+}
+
+func (m UdpRouteMapping) Matches(other UdpRouteMapping) bool {
+	nilTTL := m.TTL == nil && other.TTL == nil
+	sameTTLPointer := m.TTL == other.TTL
+	sameTTLValue := m.TTL != nil && other.TTL != nil && *m.TTL == *other.TTL
+	sameTTL := nilTTL || sameTTLPointer || sameTTLValue
+
+	return m.RouterGroupGuid == other.RouterGroupGuid &&
+		m.ExternalPort == other.ExternalPort &&
+		m.HostIP == other.HostIP &&
+		m.HostPort == other.HostPort &&
+		m.InstanceId == other.InstanceId &&
+		sameTTL
+}
+
+func (u *UdpRouteMapping) SetDefaults(defaultTTL int) {
+	if u.TTL == nil {
+		u.TTL = &defaultTTL
+	}
+}
@@ -0,0 +1,77 @@
+package models_test
+
+import (
+	"encoding/json"
+
+	"code.cloudfoundry.org/routing-api/models"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("UDP Route", func() {
+	var udpRouteMapping models.UdpRouteMapping
+
+	BeforeEach(func() {
+		udpRouteMapping = models.NewUdpRouteMapping("a-guid", 5300, "1.2.3.4", 53, "instance-id", 5, models.ModificationTag{})
+	})
+
+	Describe("UdpMappingEntity", func() {
+		It("is marshaled with the api field names", func() {
+			data, err := json.Marshal(udpRouteMapping)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(data).To(MatchJSON(`{
+				"router_group_guid": "a-guid",
+				"port": 5300,
+				"backend_ip": "1.2.3.4",
+				"backend_port": 53,
+				"instance_id": "instance-id",
+				"modification_tag": {"guid": "", "index": 0},
+				"ttl": 5,
+				"isolation_segment": ""
+			}`))
+		})
+	})
+
+	Describe("Matches()", func() {
+		var udpRouteMapping2 models.UdpRouteMapping
+
+		BeforeEach(func() {
+			udpRouteMapping2 = models.NewUdpRouteMapping("a-guid", 5300, "1.2.3.4", 53, "instance-id", 5, models.ModificationTag{})
+		})
+
+		It("matches when the routes have equal values", func() {
+			Expect(udpRouteMapping.Matches(udpRouteMapping2)).To(BeTrue())
+		})
+
+		It("doesn't match when the ttls are not equal", func() {
+			ttl := 10
+			udpRouteMapping2.TTL = &ttl
+			Expect(udpRouteMapping.Matches(udpRouteMapping2)).To(BeFalse())
+		})
+
+		It("doesn't match when one of the routes has a nil TTL", func() {
+			udpRouteMapping2.TTL = nil
+			Expect(udpRouteMapping.Matches(udpRouteMapping2)).To(BeFalse())
+			Expect(udpRouteMapping2.Matches(udpRouteMapping)).To(BeFalse())
+		})
+
+		It("doesn't match when the backends differ", func() {
+			udpRouteMapping2.HostPort = 54
+			Expect(udpRouteMapping.Matches(udpRouteMapping2)).To(BeFalse())
+		})
+	})
+
+	Describe("SetDefaults()", func() {
+		It("sets the default ttl when ttl is not present", func() {
+			udpRouteMapping.TTL = nil
+			udpRouteMapping.SetDefaults(120)
+			Expect(*udpRouteMapping.TTL).To(Equal(120))
+		})
+
+		It("keeps the ttl when present", func() {
+			udpRouteMapping.SetDefaults(120)
+			Expect(*udpRouteMapping.TTL).To(Equal(5))
+		})
+	})
+})
@@ -1,6 +1,11 @@
 package routing_api
 
-import "github.com/tedsuo/rata"
+import (
+	"sort"
+	"strings"
+
+	"github.com/tedsuo/rata"
+)
 
 const (
 	UpsertRoute           = "UpsertRoute"
@@ -8,13 +13,41 @@ const (
 	ListRoute             = "List"
 	EventStreamRoute      = "EventStream"
 	ListRouterGroups      = "ListRouterGroups"
+	GetRouterGroup        = "GetRouterGroup"
 	UpdateRouterGroup     = "UpdateRouterGroup"
 	CreateRouterGroup     = "CreateRouterGroup"
 	DeleteRouterGroup     = "DeleteRouterGroup"
+	RouterGroupPorts      = "RouterGroupPorts"
 	UpsertTcpRouteMapping = "UpsertTcpRouteMapping"
 	DeleteTcpRouteMapping = "DeleteTcpRouteMapping"
 	ListTcpRouteMapping   = "ListTcpRouteMapping"
 	EventStreamTcpRoute   = "TcpRouteEventStream"
+	UpsertUdpRouteMapping = "UpsertUdpRouteMapping"
+	DeleteUdpRouteMapping = "DeleteUdpRouteMapping"
+	ListUdpRouteMapping   = "ListUdpRouteMapping"
+	EventStreamUdpRoute   = "UdpRouteEventStream"
+
+	CreatePortReservation       = "CreatePortReservation"
+	ListPortReservations        = "ListPortReservations"
+	GetPortReservation          = "GetPortReservation"
+	DeletePortReservation       = "DeletePortReservation"
+	EventStreamPortReservations = "PortReservationEventStream"
+
+	EventStreamRouterGroups = "RouterGroupEventStream"
+
+	CreateTlsCertificate       = "CreateTlsCertificate"
+	ListTlsCertificates        = "ListTlsCertificates"
+	GetTlsCertificate          = "GetTlsCertificate"
+	UpdateTlsCertificate       = "UpdateTlsCertificate"
+	DeleteTlsCertificate       = "DeleteTlsCertificate"
+	EventStreamTlsCertificates = "TlsCertificateEventStream"
+
+	CreateBackendTlsProfile       = "CreateBackendTlsProfile"
+	ListBackendTlsProfiles        = "ListBackendTlsProfiles"
+	GetBackendTlsProfile          = "GetBackendTlsProfile"
+	UpdateBackendTlsProfile       = "UpdateBackendTlsProfile"
+	DeleteBackendTlsProfile       = "DeleteBackendTlsProfile"
+	EventStreamBackendTlsProfiles = "BackendTlsProfileEventStream"
 )
 
 var RoutesMap = map[string]rata.Route{UpsertRoute: {Path: "/routing/v1/routes", Method: "POST", Name: UpsertRoute},
@@ -24,11 +57,39 @@ var RoutesMap = map[string]rata.Route{UpsertRoute: {Path: "/routing/v1/routes",
 	CreateRouterGroup:     {Path: "/routing/v1/router_groups", Method: "POST", Name: CreateRouterGroup},
 	DeleteRouterGroup:     {Path: "/routing/v1/router_groups/:guid", Method: "DELETE", Name: DeleteRouterGroup},
 	ListRouterGroups:      {Path: "/routing/v1/router_groups", Method: "GET", Name: ListRouterGroups},
+	GetRouterGroup:        {Path: "/routing/v1/router_groups/:guid", Method: "GET", Name: GetRouterGroup},
 	UpdateRouterGroup:     {Path: "/routing/v1/router_groups/:guid", Method: "PUT", Name: UpdateRouterGroup},
+	RouterGroupPorts:      {Path: "/routing/v1/router_groups/:guid/ports", Method: "GET", Name: RouterGroupPorts},
 	UpsertTcpRouteMapping: {Path: "/routing/v1/tcp_routes/create", Method: "POST", Name: UpsertTcpRouteMapping},
 	DeleteTcpRouteMapping: {Path: "/routing/v1/tcp_routes/delete", Method: "POST", Name: DeleteTcpRouteMapping},
 	ListTcpRouteMapping:   {Path: "/routing/v1/tcp_routes", Method: "GET", Name: ListTcpRouteMapping},
 	EventStreamTcpRoute:   {Path: "/routing/v1/tcp_routes/events", Method: "GET", Name: EventStreamTcpRoute},
+	UpsertUdpRouteMapping: {Path: "/routing/v1/udp_routes/create", Method: "POST", Name: UpsertUdpRouteMapping},
+	DeleteUdpRouteMapping: {Path: "/routing/v1/udp_routes/delete", Method: "POST", Name: DeleteUdpRouteMapping},
+	ListUdpRouteMapping:   {Path: "/routing/v1/udp_routes", Method: "GET", Name: ListUdpRouteMapping},
+	EventStreamUdpRoute:   {Path: "/routing/v1/udp_routes/events", Method: "GET", Name: EventStreamUdpRoute},
+
+	CreatePortReservation:       {Path: "/routing/v1/port_reservations", Method: "POST", Name: CreatePortReservation},
+	ListPortReservations:        {Path: "/routing/v1/port_reservations", Method: "GET", Name: ListPortReservations},
+	GetPortReservation:          {Path: "/routing/v1/port_reservations/:guid", Method: "GET", Name: GetPortReservation},
+	DeletePortReservation:       {Path: "/routing/v1/port_reservations/:guid", Method: "DELETE", Name: DeletePortReservation},
+	EventStreamPortReservations: {Path: "/routing/v1/port_reservations/events", Method: "GET", Name: EventStreamPortReservations},
+
+	EventStreamRouterGroups: {Path: "/routing/v1/router_groups/events", Method: "GET", Name: EventStreamRouterGroups},
+
+	CreateTlsCertificate:       {Path: "/routing/v1/tls_certificates", Method: "POST", Name: CreateTlsCertificate},
+	ListTlsCertificates:        {Path: "/routing/v1/tls_certificates", Method: "GET", Name: ListTlsCertificates},
+	GetTlsCertificate:          {Path: "/routing/v1/tls_certificates/:guid", Method: "GET", Name: GetTlsCertificate},
+	UpdateTlsCertificate:       {Path: "/routing/v1/tls_certificates/:guid", Method: "PUT", Name: UpdateTlsCertificate},
+	DeleteTlsCertificate:       {Path: "/routing/v1/tls_certificates/:guid", Method: "DELETE", Name: DeleteTlsCertificate},
+	EventStreamTlsCertificates: {Path: "/routing/v1/tls_certificates/events", Method: "GET", Name: EventStreamTlsCertificates},
+
+	CreateBackendTlsProfile:       {Path: "/routing/v1/backend_tls_profiles", Method: "POST", Name: CreateBackendTlsProfile},
+	ListBackendTlsProfiles:        {Path: "/routing/v1/backend_tls_profiles", Method: "GET", Name: ListBackendTlsProfiles},
+	GetBackendTlsProfile:          {Path: "/routing/v1/backend_tls_profiles/:guid", Method: "GET", Name: GetBackendTlsProfile},
+	UpdateBackendTlsProfile:       {Path: "/routing/v1/backend_tls_profiles/:guid", Method: "PUT", Name: UpdateBackendTlsProfile},
+	DeleteBackendTlsProfile:       {Path: "/routing/v1/backend_tls_profiles/:guid", Method: "DELETE", Name: DeleteBackendTlsProfile},
+	EventStreamBackendTlsProfiles: {Path: "/routing/v1/backend_tls_profiles/events", Method: "GET", Name: EventStreamBackendTlsProfiles},
 }
 
 func Routes() rata.Routes {
@@ -37,5 +98,17 @@ func Routes() rata.Routes {
 		routes = append(routes, r)
 	}
 
+	// The router matches routes in order, so paths without parameters must
+	// come first for e.g. /port_reservations/events not to match
+	// /port_reservations/:guid.
+	sort.Slice(routes, func(i, j int) bool {
+		iParam := strings.Contains(routes[i].Path, ":")
+		jParam := strings.Contains(routes[j].Path, ":")
+		if iParam != jParam {
+			return jParam
+		}
+		return routes[i].Name < routes[j].Name
+	})
+
 	return routes
 }
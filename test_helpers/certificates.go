@@ -125,3 +125,44 @@ func createCertTemplateWithTime(certType CertType, notBefore, notAfter time.Time
 
 	return tmpl, err
 }
+
+// CreateHostnameCertificate returns the PEM encoded certificate and private key
+// of a self-signed server certificate for the DNS names that expires at
+// notAfter.
+func CreateHostnameCertificate(dnsNames []string, notAfter time.Time) (string, string, error) {
+	return createSelfSignedCertificate(IsServer, dnsNames, notAfter)
+}
+
+// CreateClientCertificate returns the PEM encoded certificate and private key
+// of a self-signed client certificate that expires at notAfter.
+func CreateClientCertificate(notAfter time.Time) (string, string, error) {
+	return createSelfSignedCertificate(IsClient, nil, notAfter)
+}
+
+func createSelfSignedCertificate(certType CertType, dnsNames []string, notAfter time.Time) (string, string, error) {
+	priv, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return "", "", fmt.Errorf("generate key: %s", err)
+	}
+
+	tmpl, err := createCertTemplateWithTime(certType, notAfter.AddDate(-1, 0, 0), notAfter)
+	if err != nil {
+		return "", "", fmt.Errorf("create cert template: %s", err)
+	}
+	tmpl.DNSNames = dnsNames
+	tmpl.IPAddresses = nil
+
+	certDER, err := x509.CreateCertificate(rand.Reader, &tmpl, &tmpl, &priv.PublicKey, priv)
+	if err != nil {
+		return "", "", fmt.Errorf("x509 create certificate: %s", err)
+	}
+
+	privBytes, err := x509.MarshalECPrivateKey(priv)
+	if err != nil {
+		return "", "", fmt.Errorf("marshal ec private key: %s", err)
+	}
+
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certDER})
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: privBytes})
+	return string(certPEM), string(keyPEM), nil
+}